@@ -11,6 +11,8 @@ import (
 
 	"github.com/sariya23/tender/internal/app"
 	"github.com/sariya23/tender/internal/config"
+	"github.com/sariya23/tender/internal/lib/jwt"
+	tendersrv "github.com/sariya23/tender/internal/service/tender"
 )
 
 func main() {
@@ -19,7 +21,18 @@ func main() {
 	cfg := config.MustLoad()
 	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
 	logger.Info("starting app at", slog.String("addr", cfg.ServerAddress), slog.String("port", cfg.ServerPort))
-	app := app.New(ctx, cfg.PostgresConn, logger, cfg.ServerAddress, cfg.ServerPort, cfg.Timeout)
+	app := app.New(
+		ctx,
+		cfg.PostgresConn,
+		logger,
+		cfg.ServerAddress,
+		cfg.ServerPort,
+		cfg.Timeout,
+		cfg.TenderSchedulerInterval,
+		jwt.MustNewVerifier(cfg.JWTHMACSecret, cfg.JWTRSAPublicKeyPath),
+		tendersrv.WithCloseQuorum(cfg.TenderCloseQuorum),
+		tendersrv.WithRollbackMode(cfg.TenderRollbackMode),
+	)
 	logger.Info("app init success")
 	go app.Server.MustRun()
 
@@ -27,6 +40,8 @@ func main() {
 	signal.Notify(quitSignal, syscall.SIGINT, syscall.SIGTERM)
 	<-quitSignal
 	logger.Info("Shutdown Server ...")
+	app.Scheduler.Stop()
+	logger.Info("tender scheduler stopped")
 
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
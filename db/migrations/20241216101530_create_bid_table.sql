@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+create sequence if not exists bid_id_seq;
+
+create table if not exists bid (
+    bid_id bigint not null check(bid_id > 0),
+    tender_id bigint not null check(tender_id > 0),
+    name text not null,
+    description text,
+    status varchar(9) not null check (status in ('CREATED', 'PUBLISHED', 'CANCELED')) default 'CREATED',
+    organization_id bigint not null references organization(organization_id),
+    creator_username text not null,
+    version int not null default 1 check(version > 0),
+    is_active_version bool default true,
+    primary key (bid_id, version)
+);
+
+create unique index unique_active_version_per_bid
+on bid (bid_id)
+where is_active_version = true;
+
+create index bid_tender_id_idx on bid (tender_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists bid;
+drop sequence if exists bid_id_seq;
+-- +goose StatementEnd
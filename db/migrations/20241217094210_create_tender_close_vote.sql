@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists tender_close_vote (
+    tender_close_vote_id bigint generated always as identity primary key,
+    tender_id bigint not null check(tender_id > 0),
+    tender_version int not null check(tender_version > 0),
+    employee_id bigint not null references employee(employee_id) on delete cascade,
+    decision varchar(7) not null check (decision in ('APPROVE', 'REJECT')),
+    created_at timestamp default CURRENT_TIMESTAMP,
+    constraint unique_tender_close_vote unique (tender_id, tender_version, employee_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists tender_close_vote;
+-- +goose StatementEnd
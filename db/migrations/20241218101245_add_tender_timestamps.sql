@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table tender
+add column created_at timestamp not null default CURRENT_TIMESTAMP,
+add column updated_at timestamp not null default CURRENT_TIMESTAMP;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table tender
+drop column created_at,
+drop column updated_at;
+-- +goose StatementEnd
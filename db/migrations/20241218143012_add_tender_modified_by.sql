@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table tender
+add column modified_by text;
+
+update tender set modified_by = creator_username;
+
+alter table tender
+alter column modified_by set not null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table tender
+drop column modified_by;
+-- +goose StatementEnd
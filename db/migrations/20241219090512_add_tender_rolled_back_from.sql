@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table tender
+add column rolled_back_from int check(rolled_back_from > 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table tender
+drop column rolled_back_from;
+-- +goose StatementEnd
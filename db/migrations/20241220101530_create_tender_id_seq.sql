@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+create sequence if not exists tender_id_seq as bigint owned by tender.tender_id;
+select setval('tender_id_seq', coalesce((select max(tender_id) from tender), 0) + 1, false);
+alter table tender
+alter column tender_id set default nextval('tender_id_seq');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table tender
+alter column tender_id drop default;
+drop sequence if exists tender_id_seq;
+-- +goose StatementEnd
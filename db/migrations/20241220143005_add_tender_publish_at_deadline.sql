@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table tender
+add column publish_at timestamptz,
+add column deadline timestamptz,
+add constraint tender_deadline_after_publish_at check (deadline is null or publish_at is null or deadline > publish_at);
+
+create index if not exists tender_scheduled_idx on tender (status, publish_at, deadline)
+where is_active_version = true;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists tender_scheduled_idx;
+
+alter table tender
+drop constraint tender_deadline_after_publish_at,
+drop column deadline,
+drop column publish_at;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table tender
+add column search_vector tsvector generated always as (
+    setweight(to_tsvector('russian', coalesce(name, '')), 'A') ||
+    setweight(to_tsvector('russian', coalesce(description, '')), 'B')
+) stored;
+
+create index if not exists tender_search_vector_idx on tender using gin (search_vector)
+where is_active_version = true;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists tender_search_vector_idx;
+
+alter table tender
+drop column search_vector;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table organization
+add column deleted_at timestamp;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table organization
+drop column deleted_at;
+-- +goose StatementEnd
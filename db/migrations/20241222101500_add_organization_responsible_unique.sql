@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+delete from organization_responsible r
+using organization_responsible d
+where r.organization_id = d.organization_id
+    and r.employee_id = d.employee_id
+    and r.organization_responsible_id > d.organization_responsible_id;
+
+create unique index organization_responsible_org_employee_uniq
+    on organization_responsible (organization_id, employee_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists organization_responsible_org_employee_uniq;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists api_key (
+    api_key_id bigint generated always as identity primary key,
+    employee_id bigint not null references employee(employee_id) on delete cascade,
+    name varchar(100) not null,
+    key_prefix varchar(16) not null,
+    key_hash char(64) unique not null,
+    scopes text[] not null,
+    created_at timestamp default CURRENT_TIMESTAMP,
+    revoked_at timestamp
+);
+
+create index api_key_employee_idx on api_key (employee_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists api_key;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table organization_responsible
+    add column role varchar(6) not null default 'MEMBER' check (role in ('ADMIN', 'MEMBER'));
+
+create table if not exists employee_role (
+    employee_role_id bigint generated always as identity primary key,
+    employee_id bigint not null references employee(employee_id) on delete cascade,
+    role varchar(20) not null check (role in ('SYSTEM_ADMIN', 'AUDITOR')),
+    created_at timestamp default CURRENT_TIMESTAMP,
+    constraint unique_employee_role unique (employee_id, role)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists employee_role;
+
+alter table organization_responsible drop column if exists role;
+-- +goose StatementEnd
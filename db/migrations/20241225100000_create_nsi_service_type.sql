@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists nsi_service_type (
+    nsi_service_type_id smallint generated by default as identity primary key,
+    type varchar(50) not null unique
+);
+
+create unique index if not exists nsi_service_type_lower_type_idx on nsi_service_type (lower(type));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists nsi_service_type;
+-- +goose StatementEnd
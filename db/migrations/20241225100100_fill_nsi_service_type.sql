@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+insert into nsi_service_type (type) values
+('Construction'),
+('Delivery'),
+('Manufacture');
+
+-- Типы услуг, которые уже есть у тендеров, тоже попадают в справочник.
+-- Из вариантов, отличающихся только регистром, берется один.
+insert into nsi_service_type (type)
+select distinct on (lower(service_type)) service_type from tender
+where lower(service_type) not in (select lower(type) from nsi_service_type)
+order by lower(service_type), service_type;
+
+update tender t set service_type = st.type
+from nsi_service_type st
+where lower(t.service_type) = lower(st.type) and t.service_type <> st.type;
+
+alter table tender
+add constraint tender_service_type_fkey foreign key (service_type)
+references nsi_service_type(type) on update cascade;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table tender drop constraint if exists tender_service_type_fkey;
+
+delete from nsi_service_type;
+-- +goose StatementEnd
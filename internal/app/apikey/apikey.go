@@ -0,0 +1,19 @@
+package apikeyapp
+
+import (
+	"log/slog"
+
+	apikeyapi "github.com/sariya23/tender/internal/hanlders/apikey"
+	"github.com/sariya23/tender/internal/repository"
+	apikeysrv "github.com/sariya23/tender/internal/service/apikey"
+)
+
+type APIKeyApp struct {
+	APIKeyHandlers *apikeyapi.APIKeyService
+}
+
+func New(logger *slog.Logger, apiKeyRepo repository.APIKeyManager) *APIKeyApp {
+	apiKeyService := apikeysrv.New(logger, apiKeyRepo)
+	apiKeyHandlers := apikeyapi.New(logger, apiKeyService)
+	return &APIKeyApp{APIKeyHandlers: apiKeyHandlers}
+}
@@ -6,14 +6,24 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	apikeyapp "github.com/sariya23/tender/internal/app/apikey"
+	bidapp "github.com/sariya23/tender/internal/app/bid"
 	dbapp "github.com/sariya23/tender/internal/app/db"
+	employeeapp "github.com/sariya23/tender/internal/app/employee"
+	organizationapp "github.com/sariya23/tender/internal/app/organization"
+	schedulerapp "github.com/sariya23/tender/internal/app/scheduler"
 	serverapp "github.com/sariya23/tender/internal/app/server"
+	servicetypeapp "github.com/sariya23/tender/internal/app/servicetype"
 	tenderapp "github.com/sariya23/tender/internal/app/tender"
+	"github.com/sariya23/tender/internal/middleware"
+	outerror "github.com/sariya23/tender/internal/out_error"
 	"github.com/sariya23/tender/internal/route"
+	tendersrv "github.com/sariya23/tender/internal/service/tender"
 )
 
 type App struct {
-	Server *serverapp.ServerApp
+	Server    *serverapp.ServerApp
+	Scheduler *schedulerapp.SchedulerApp
 }
 
 func New(
@@ -23,19 +33,44 @@ func New(
 	serverAddr string,
 	serverPort string,
 	timeout int,
+	schedulerInterval int,
+	tokenVerifier middleware.TokenVerifier,
+	tenderOpts ...tendersrv.Option,
 ) *App {
 	db := dbapp.New(ctx, dbURL)
 	logger.Info("DB init success")
-	tender := tenderapp.New(logger, db.Storage, db.Storage, db.Storage, db.Storage)
+	tender := tenderapp.New(logger, db.Storage, db.Storage, db.Storage, db.Storage, db.Storage, db.Storage, db.Storage, tenderOpts...)
 	logger.Info("tender service init success")
+	bid := bidapp.New(logger, db.Storage, db.Storage, db.Storage, db.Storage, db.Storage)
+	logger.Info("bid service init success")
+	organization := organizationapp.New(logger, db.Storage, db.Storage, db.Storage)
+	logger.Info("organization service init success")
+	employee := employeeapp.New(logger, db.Storage)
+	logger.Info("employee service init success")
+	apiKey := apikeyapp.New(logger, db.Storage)
+	logger.Info("api key service init success")
+	serviceType := servicetypeapp.New(logger, db.Storage, db.Storage)
+	logger.Info("service type service init success")
+
+	authenticator := middleware.NewAuthenticator(logger, tokenVerifier, db.Storage, db.Storage)
 
 	router := gin.Default()
+	router.Use(middleware.Problems(logger, outerror.Catalog))
 	apiRouterGroup := router.Group("/api")
-	route.AddTenderRoutes(ctx, tender.TenderHandlers, apiRouterGroup)
+	route.AddTenderRoutes(ctx, tender.TenderHandlers, authenticator, apiRouterGroup)
+	route.AddBidRoutes(ctx, bid.BidHandlers, authenticator, apiRouterGroup)
+	route.AddOrganizationRoutes(ctx, organization.OrganizationHandlers, authenticator, apiRouterGroup)
+	route.AddEmployeeRoutes(ctx, employee.EmployeeHandlers, authenticator, apiRouterGroup)
+	route.AddAPIKeyRoutes(ctx, apiKey.APIKeyHandlers, authenticator, apiRouterGroup)
+	route.AddServiceTypeRoutes(ctx, serviceType.ServiceTypeHandlers, authenticator, apiRouterGroup)
 	route.AddPingRoute(apiRouterGroup)
 
 	serverTimeout := time.Duration(timeout) * time.Second
 	serverApp := serverapp.New(serverAddr, serverPort, serverTimeout, router)
 
-	return &App{serverApp}
+	scheduler := schedulerapp.New(logger, time.Duration(schedulerInterval)*time.Second, tender.TenderService)
+	scheduler.Start(ctx)
+	logger.Info("tender scheduler started", slog.Int("interval seconds", schedulerInterval))
+
+	return &App{Server: serverApp, Scheduler: scheduler}
 }
@@ -0,0 +1,26 @@
+package bidapp
+
+import (
+	"log/slog"
+
+	bidapi "github.com/sariya23/tender/internal/hanlders/bid"
+	"github.com/sariya23/tender/internal/repository"
+	bidsrv "github.com/sariya23/tender/internal/service/bid"
+)
+
+type BidApp struct {
+	BidHandlers *bidapi.BidService
+}
+
+func New(
+	logger *slog.Logger,
+	bidRepo repository.BidRepository,
+	tenderRepo repository.TenderRepository,
+	employeeRepo repository.EmployeeRepository,
+	orgRepo repository.OrganizationRepository,
+	responsibler repository.EmployeeResponsibler,
+) *BidApp {
+	bidService := bidsrv.New(logger, bidRepo, tenderRepo, employeeRepo, orgRepo, responsibler)
+	bidHandlers := bidapi.New(logger, bidService)
+	return &BidApp{bidHandlers}
+}
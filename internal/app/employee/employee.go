@@ -0,0 +1,19 @@
+package employeeapp
+
+import (
+	"log/slog"
+
+	employeeapi "github.com/sariya23/tender/internal/hanlders/employee"
+	"github.com/sariya23/tender/internal/repository"
+	employeesrv "github.com/sariya23/tender/internal/service/employee"
+)
+
+type EmployeeApp struct {
+	EmployeeHandlers *employeeapi.EmployeeService
+}
+
+func New(logger *slog.Logger, employeeRepo repository.EmployeeManager) *EmployeeApp {
+	employeeService := employeesrv.New(logger, employeeRepo)
+	employeeHandlers := employeeapi.New(logger, employeeService)
+	return &EmployeeApp{EmployeeHandlers: employeeHandlers}
+}
@@ -0,0 +1,24 @@
+package organizationapp
+
+import (
+	"log/slog"
+
+	organizationapi "github.com/sariya23/tender/internal/hanlders/organization"
+	"github.com/sariya23/tender/internal/repository"
+	organizationsrv "github.com/sariya23/tender/internal/service/organization"
+)
+
+type OrganizationApp struct {
+	OrganizationHandlers *organizationapi.OrganizationService
+}
+
+func New(
+	logger *slog.Logger,
+	orgRepo repository.OrganizationManager,
+	employeeRepo repository.EmployeeRepository,
+	roleRepo repository.RoleRepository,
+) *OrganizationApp {
+	organizationService := organizationsrv.New(logger, orgRepo, employeeRepo, roleRepo)
+	organizationHandlers := organizationapi.New(logger, organizationService)
+	return &OrganizationApp{OrganizationHandlers: organizationHandlers}
+}
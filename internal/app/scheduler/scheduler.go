@@ -0,0 +1,70 @@
+package schedulerapp
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// ScheduledTransitionRunner переводит тендеры, у которых наступил
+// publish_at или прошел deadline.
+type ScheduledTransitionRunner interface {
+	RunScheduledTransitions(ctx context.Context, now time.Time) (int, error)
+}
+
+// SchedulerApp раз в interval запускает переходы тендеров по расписанию.
+type SchedulerApp struct {
+	logger   *slog.Logger
+	interval time.Duration
+	runner   ScheduledTransitionRunner
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+func New(logger *slog.Logger, interval time.Duration, runner ScheduledTransitionRunner) *SchedulerApp {
+	return &SchedulerApp{
+		logger:   logger,
+		interval: interval,
+		runner:   runner,
+		done:     make(chan struct{}),
+	}
+}
+
+// Start запускает планировщик в отдельной горутине.
+func (scheduler *SchedulerApp) Start(ctx context.Context) {
+	ctx, scheduler.cancel = context.WithCancel(ctx)
+	go scheduler.run(ctx)
+}
+
+// Stop останавливает планировщик и ждет, пока завершится текущий запуск.
+func (scheduler *SchedulerApp) Stop() {
+	if scheduler.cancel == nil {
+		return
+	}
+	scheduler.cancel()
+	<-scheduler.done
+}
+
+func (scheduler *SchedulerApp) run(ctx context.Context) {
+	const operationPlace = "internal.app.scheduler.run"
+	logger := scheduler.logger.With("op", operationPlace)
+	defer close(scheduler.done)
+
+	ticker := time.NewTicker(scheduler.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("scheduler stopped")
+			return
+		case now := <-ticker.C:
+			transited, err := scheduler.runner.RunScheduledTransitions(ctx, now)
+			if err != nil {
+				logger.Error("scheduled transitions failed", slog.String("err", err.Error()))
+			}
+			if transited > 0 {
+				logger.Info("scheduled transitions done", slog.Int("tenders", transited))
+			}
+		}
+	}
+}
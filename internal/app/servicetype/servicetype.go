@@ -0,0 +1,19 @@
+package servicetypeapp
+
+import (
+	"log/slog"
+
+	servicetypeapi "github.com/sariya23/tender/internal/hanlders/servicetype"
+	"github.com/sariya23/tender/internal/repository"
+	servicetypesrv "github.com/sariya23/tender/internal/service/servicetype"
+)
+
+type ServiceTypeApp struct {
+	ServiceTypeHandlers *servicetypeapi.ServiceTypeService
+}
+
+func New(logger *slog.Logger, serviceTypeRepo repository.ServiceTypeManager, roleRepo repository.RoleRepository) *ServiceTypeApp {
+	serviceTypeService := servicetypesrv.New(logger, serviceTypeRepo, roleRepo)
+	serviceTypeHandlers := servicetypeapi.New(logger, serviceTypeService)
+	return &ServiceTypeApp{ServiceTypeHandlers: serviceTypeHandlers}
+}
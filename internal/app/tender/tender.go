@@ -3,6 +3,7 @@ package tenderapp
 import (
 	"log/slog"
 
+	"github.com/sariya23/tender/internal/domain/tenderpolicy"
 	tenderapi "github.com/sariya23/tender/internal/hanlders/tender"
 	"github.com/sariya23/tender/internal/repository"
 	tendersrv "github.com/sariya23/tender/internal/service/tender"
@@ -10,6 +11,7 @@ import (
 
 type TenderApp struct {
 	TenderHandlers *tenderapi.TenderService
+	TenderService  *tendersrv.TenderService
 }
 
 func New(
@@ -18,8 +20,20 @@ func New(
 	employeeRepo repository.EmployeeRepository,
 	orgRepo repository.OrganizationRepository,
 	responsibler repository.EmployeeResponsibler,
+	searcher repository.TenderSearcher,
+	roleRepo repository.RoleRepository,
+	serviceTypeRepo repository.ServiceTypeRepository,
+	opts ...tendersrv.Option,
 ) *TenderApp {
-	tenderService := tendersrv.New(logger, tenderRepo, employeeRepo, orgRepo, responsibler)
+	opts = append(
+		[]tendersrv.Option{
+			tendersrv.WithSearcher(searcher),
+			tendersrv.WithPolicy(tenderpolicy.NewRolePolicy(employeeRepo, roleRepo)),
+			tendersrv.WithServiceTypes(serviceTypeRepo),
+		},
+		opts...,
+	)
+	tenderService := tendersrv.New(logger, tenderRepo, employeeRepo, orgRepo, responsibler, opts...)
 	tenderHandlers := tenderapi.New(logger, tenderService)
-	return &TenderApp{tenderHandlers}
+	return &TenderApp{TenderHandlers: tenderHandlers, TenderService: tenderService}
 }
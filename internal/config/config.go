@@ -22,6 +22,11 @@ type AppConfig struct {
 	PostgresHost            string `env:"POSTGRES_HOST"`
 	PostgresPort            int    `env:"POSTGRES_PORT"`
 	PostgresDatabase        string `env:"POSTGRES_DB"`
+	TenderCloseQuorum       int    `env:"TENDER_CLOSE_QUORUM" env-default:"3"`
+	TenderRollbackMode      string `env:"TENDER_ROLLBACK_MODE" env-default:"append"`
+	TenderSchedulerInterval int    `env:"TENDER_SCHEDULER_INTERVAL" env-default:"60"`
+	JWTHMACSecret           string `env:"JWT_HMAC_SECRET"`
+	JWTRSAPublicKeyPath     string `env:"JWT_RSA_PUBLIC_KEY_PATH"`
 }
 
 func MustLoad() *AppConfig {
@@ -53,5 +58,14 @@ func MustLoadByPath(path string) *AppConfig {
 	if err := cleanenv.ReadConfig(path, &cfg); err != nil {
 		panic("cannot read config: " + err.Error())
 	}
+	if cfg.TenderRollbackMode != "append" && cfg.TenderRollbackMode != "reactivate" {
+		panic("unknown tender rollback mode: " + cfg.TenderRollbackMode)
+	}
+	if cfg.TenderSchedulerInterval <= 0 {
+		panic("tender scheduler interval must be positive")
+	}
+	if cfg.JWTHMACSecret == "" && cfg.JWTRSAPublicKeyPath == "" {
+		panic("jwt key is not configured: set JWT_HMAC_SECRET or JWT_RSA_PUBLIC_KEY_PATH")
+	}
 	return &cfg
 }
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"slices"
+	"time"
+)
+
+// Права API ключей.
+const (
+	ScopeTendersRead     = "tenders:read"
+	ScopeTendersWrite    = "tenders:write"
+	ScopeTendersRollback = "tenders:rollback"
+)
+
+// IsKnownScope проверяет, что право API ключа существует.
+func IsKnownScope(scope string) bool {
+	switch scope {
+	case ScopeTendersRead, ScopeTendersWrite, ScopeTendersRollback:
+		return true
+	default:
+		return false
+	}
+}
+
+// APIKey ключ для интеграций, который действует от имени сотрудника.
+//
+// Сам ключ не хранится, только его хеш. Prefix - первые символы ключа,
+// по которым ключ можно узнать в списке. RevokedAt заполнен у отозванных ключей.
+type APIKey struct {
+	ID         int        `json:"id"`
+	EmployeeId int        `json:"employee_id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	CreatedAt  time.Time  `json:"created_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+}
+
+func (apiKey APIKey) HasScope(scope string) bool {
+	return slices.Contains(apiKey.Scopes, scope)
+}
@@ -0,0 +1,59 @@
+package models
+
+type Bid struct {
+	ID              int    `json:"id"`
+	TenderId        int    `json:"tender_id" validate:"required,gte=0"`
+	BidName         string `json:"name" validate:"required"`
+	Description     string `json:"description" validate:"required"`
+	Status          string `json:"status" validate:"required"`
+	OrganizationId  int    `json:"organization_id" validate:"required,gte=0"`
+	CreatorUsername string `json:"creator_username" validate:"required"`
+	Version         int    `json:"version"`
+}
+
+func (bid *Bid) IsNewBidHasStatusCreated() bool {
+	return bid.Status == BidCreatedStatus
+}
+
+var (
+	BidCreatedStatus   = "CREATED"
+	BidPublishedStatus = "PUBLISHED"
+	BidCanceledStatus  = "CANCELED"
+)
+
+type BidToUpdate struct {
+	BidName     *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Status      *string `json:"status,omitempty"`
+}
+
+func (bid *BidToUpdate) IsBidStatusKnown() bool {
+	if bid.Status != nil {
+		return *bid.Status == BidCreatedStatus || *bid.Status == BidPublishedStatus || *bid.Status == BidCanceledStatus
+	}
+	return true
+}
+
+// CanSetThisBidStatus проверяет, может ли
+// новый статус предложения быть установлен.
+//
+// - нельзя перевести предложение из статуса PUBLISHED в CREATED;
+//
+// - нельзя перевести предложение из статуса CANCELED ни в какой другой.
+//
+// Если передать также же статус, то его установить можно.
+func (bid *BidToUpdate) CanSetThisBidStatus(currBidStatus string) bool {
+	if newStatus := bid.Status; newStatus != nil {
+		if currBidStatus == BidPublishedStatus && *newStatus == BidCreatedStatus {
+			return false
+		} else if currBidStatus == BidCanceledStatus && *newStatus != BidCanceledStatus {
+			return false
+		}
+	}
+	return true
+}
+
+// IsEmpty сообщает, что в запросе на обновление нет ни одного поля.
+func (bid *BidToUpdate) IsEmpty() bool {
+	return bid.BidName == nil && bid.Description == nil && bid.Status == nil
+}
@@ -0,0 +1,81 @@
+package models
+
+import (
+	"reflect"
+	"strings"
+	"time"
+)
+
+// TenderFieldDiff изменение одного поля тендера между двумя версиями.
+type TenderFieldDiff struct {
+	Field string `json:"field"`
+	From  any    `json:"from"`
+	To    any    `json:"to"`
+}
+
+// TenderDiff разница между версиями FromVersion и ToVersion тендера.
+// В Changes попадают только изменившиеся поля.
+type TenderDiff struct {
+	TenderId    int               `json:"tender_id"`
+	FromVersion int               `json:"from_version"`
+	ToVersion   int               `json:"to_version"`
+	Changes     []TenderFieldDiff `json:"changes"`
+}
+
+// DiffTenders сравнивает две версии тендера по полям,
+// которые можно изменить через TenderToUpdate. Имя поля в
+// диффе берется из json тега TenderToUpdate.
+func DiffTenders(from Tender, to Tender) []TenderFieldDiff {
+	changes := []TenderFieldDiff{}
+
+	updateType := reflect.TypeOf(TenderToUpdate{})
+	fromValue := reflect.ValueOf(from)
+	toValue := reflect.ValueOf(to)
+	for i := 0; i < updateType.NumField(); i++ {
+		field := updateType.Field(i)
+		fromField := fromValue.FieldByName(field.Name)
+		toField := toValue.FieldByName(field.Name)
+		if !fromField.IsValid() || !toField.IsValid() {
+			continue
+		}
+		fromInterface, toInterface := fieldInterface(fromField), fieldInterface(toField)
+		if isEqualFields(fromInterface, toInterface) {
+			continue
+		}
+		changes = append(changes, TenderFieldDiff{
+			Field: jsonFieldName(field),
+			From:  fromInterface,
+			To:    toInterface,
+		})
+	}
+	return changes
+}
+
+// fieldInterface возвращает значение поля, а для указателя -
+// значение, на которое он указывает, или nil.
+func fieldInterface(value reflect.Value) any {
+	if value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return nil
+		}
+		return value.Elem().Interface()
+	}
+	return value.Interface()
+}
+
+func isEqualFields(from any, to any) bool {
+	fromTime, fromIsTime := from.(time.Time)
+	toTime, toIsTime := to.(time.Time)
+	if fromIsTime && toIsTime {
+		return fromTime.Equal(toTime)
+	}
+	return from == to
+}
+
+func jsonFieldName(field reflect.StructField) string {
+	tag, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if tag == "" {
+		return field.Name
+	}
+	return tag
+}
@@ -1,8 +1,38 @@
 package models
 
+import "time"
+
+// Employee сотрудник.
+//
+// ID, CreatedAt и UpdatedAt заполняются хранилищем и игнорируются
+// при создании сотрудника. Username уникален.
 type Employee struct {
-	ID        int
-	Username  string
-	FirstName string
-	LastName  string
+	ID        int       `json:"id"`
+	Username  string    `json:"username" validate:"required,max=50"`
+	FirstName string    `json:"first_name" validate:"max=50"`
+	LastName  string    `json:"last_name" validate:"max=50"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// EmployeeToUpdate поля сотрудника, которые можно обновить.
+// Поля со значением nil не меняются.
+type EmployeeToUpdate struct {
+	Username  *string `json:"username,omitempty" validate:"omitempty,min=1,max=50"`
+	FirstName *string `json:"first_name,omitempty" validate:"omitempty,max=50"`
+	LastName  *string `json:"last_name,omitempty" validate:"omitempty,max=50"`
+}
+
+func (employee *EmployeeToUpdate) IsEmpty() bool {
+	return employee.Username == nil && employee.FirstName == nil && employee.LastName == nil
+}
+
+// EmployeePage страница списка сотрудников.
+//
+// Total - сколько всего сотрудников без учета страницы,
+// NextCursor - значение after_id для следующей страницы, nil если страница последняя.
+type EmployeePage struct {
+	Employees  []Employee
+	Total      int
+	NextCursor *int
 }
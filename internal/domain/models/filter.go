@@ -0,0 +1,78 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// Поля, по которым можно отсортировать список тендеров.
+const (
+	TenderSortById          = "id"
+	TenderSortByName        = "name"
+	TenderSortByCreatedAt   = "created_at"
+	TenderSortByServiceType = "service_type"
+)
+
+// TenderSort сортировка списка тендеров.
+//
+// Пустой Field означает сортировку по id.
+type TenderSort struct {
+	Field string
+	Desc  bool
+}
+
+// ParseTenderSort разбирает сортировку вида name или -created_at.
+// Минус в начале означает сортировку по убыванию. Если поле неизвестно,
+// то возвращается false.
+func ParseTenderSort(value string) (TenderSort, bool) {
+	sort := TenderSort{}
+	if strings.HasPrefix(value, "-") {
+		sort.Desc = true
+		value = value[1:]
+	}
+	switch value {
+	case TenderSortById, TenderSortByName, TenderSortByCreatedAt, TenderSortByServiceType:
+		sort.Field = value
+		return sort, true
+	default:
+		return TenderSort{}, false
+	}
+}
+
+// IsById проверяет, что тендеры отсортированы по возрастанию id.
+// Только при такой сортировке работает пагинация по after_id.
+func (sort TenderSort) IsById() bool {
+	return (sort.Field == "" || sort.Field == TenderSortById) && !sort.Desc
+}
+
+// TenderFilter фильтр списка тендеров. Пустые поля не ограничивают выборку.
+//
+// Statuses - статусы тендеров. Без Viewer видны только опубликованные тендеры,
+// а если Viewer задан, то он видит еще и свои тендеры в любом статусе.
+// Если ViewAll true, то видны тендеры всех сотрудников в любом статусе.
+//
+// NameContains ищет подстроку в названии без учета регистра,
+// CreatedFrom и CreatedTo ограничивают время создания тендера включительно.
+type TenderFilter struct {
+	ServiceTypes    []string
+	OrganizationId  int
+	CreatorUsername string
+	Statuses        []string
+	NameContains    string
+	CreatedFrom     *time.Time
+	CreatedTo       *time.Time
+	Viewer          string
+	ViewAll         bool
+	Sort            TenderSort
+}
+
+// HasOnlyPublishedStatus проверяет, что фильтр не запрашивает
+// тендеры в статусах, отличных от PUBLISHED.
+func (filter TenderFilter) HasOnlyPublishedStatus() bool {
+	for _, status := range filter.Statuses {
+		if status != TenderPublishedStatus {
+			return false
+		}
+	}
+	return true
+}
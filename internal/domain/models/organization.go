@@ -1,8 +1,39 @@
 package models
 
+import "time"
+
+// Organization организация.
+//
+// ID, CreatedAt и UpdatedAt заполняются хранилищем и игнорируются
+// при создании организации. Type - тип организации из справочника
+// nsi_organization_type.
 type Organization struct {
-	ID          int
-	Name        string
-	Description string
-	Type        string
+	ID          int       `json:"id"`
+	Name        string    `json:"name" validate:"required,max=100"`
+	Description string    `json:"description"`
+	Type        string    `json:"type" validate:"required"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// OrganizationToUpdate поля организации, которые можно обновить.
+// Поля со значением nil не меняются.
+type OrganizationToUpdate struct {
+	Name        *string `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
+	Description *string `json:"description,omitempty"`
+	Type        *string `json:"type,omitempty"`
+}
+
+func (organization *OrganizationToUpdate) IsEmpty() bool {
+	return organization.Name == nil && organization.Description == nil && organization.Type == nil
+}
+
+// OrganizationPage страница списка организаций.
+//
+// Total - сколько всего организаций без учета страницы,
+// NextCursor - значение after_id для следующей страницы, nil если страница последняя.
+type OrganizationPage struct {
+	Organizations []Organization
+	Total         int
+	NextCursor    *int
 }
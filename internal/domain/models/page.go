@@ -0,0 +1,26 @@
+package models
+
+const (
+	DefaultPageLimit = 20
+	MaxPageLimit     = 100
+)
+
+// Page параметры страницы списка.
+//
+// Если AfterId больше 0, то используется keyset пагинация: вернутся
+// элементы с id больше AfterId, а Offset игнорируется.
+type Page struct {
+	Limit   int
+	Offset  int
+	AfterId int
+}
+
+// TenderPage страница списка тендеров.
+//
+// Total - сколько всего тендеров подходит под запрос без учета страницы,
+// NextCursor - значение after_id для следующей страницы, nil если страница последняя.
+type TenderPage struct {
+	Tenders    []Tender
+	Total      int
+	NextCursor *int
+}
@@ -0,0 +1,21 @@
+package models
+
+// Глобальные роли сотрудника.
+//
+// - RoleSystemAdmin может выполнять любые действия с любыми тендерами;
+//
+// - RoleAuditor может читать любые тендеры, в том числе неопубликованные.
+const (
+	RoleSystemAdmin = "SYSTEM_ADMIN"
+	RoleAuditor     = "AUDITOR"
+)
+
+// Роли сотрудника в организации, за которую он ответственный.
+//
+// - OrganizationRoleMember может читать, редактировать и откатывать тендеры организации;
+//
+// - OrganizationRoleAdmin еще может передавать тендеры другому сотруднику или организации.
+const (
+	OrganizationRoleAdmin  = "ADMIN"
+	OrganizationRoleMember = "MEMBER"
+)
@@ -0,0 +1,20 @@
+package models
+
+// TenderSearchResult тендер, найденный полнотекстовым поиском.
+//
+// Rank - релевантность тендера запросу, Snippet - фрагмент названия
+// и описания в HTML: текст экранирован, найденные слова выделены тегами <mark>.
+type TenderSearchResult struct {
+	Tender  Tender  `json:"tender"`
+	Rank    float32 `json:"rank"`
+	Snippet string  `json:"snippet"`
+}
+
+// TenderSearchPage страница результатов поиска тендеров.
+//
+// Results отсортированы по убыванию Rank, Total - сколько всего
+// тендеров нашлось без учета страницы.
+type TenderSearchPage struct {
+	Results []TenderSearchResult
+	Total   int
+}
@@ -0,0 +1,12 @@
+package models
+
+// ServiceType тип услуг тендера из справочника nsi_service_type.
+//
+// Названия типов уникальны без учета регистра. PublishedTenders -
+// количество опубликованных тендеров с этим типом услуг, заполняется
+// только в списке типов.
+type ServiceType struct {
+	ID               int    `json:"id"`
+	Name             string `json:"name"`
+	PublishedTenders int    `json:"published_tenders"`
+}
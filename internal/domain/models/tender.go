@@ -1,18 +1,86 @@
 package models
 
+import (
+	"time"
+)
+
+// Tender версия тендера.
+//
+// ID, Version, CreatedAt и UpdatedAt заполняются хранилищем и
+// игнорируются при создании тендера. CreatedAt - время создания
+// первой версии тендера, UpdatedAt - время появления этой версии.
+//
+// PublishAt и Deadline необязательны: в PublishAt тендер в статусе CREATED
+// будет опубликован, а после Deadline опубликованный тендер будет закрыт.
 type Tender struct {
-	TenderName      string `json:"name" validate:"required"`
-	Description     string `json:"description" validate:"required"`
-	ServiceType     string `json:"service_type" validate:"required"`
-	Status          string `json:"status" validate:"required"`
-	OrganizationId  int    `json:"organization_id" validate:"required,gte=0"`
-	CreatorUsername string `json:"creator_username" validate:"required"`
+	ID              int        `json:"id"`
+	Version         int        `json:"version"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+	TenderName      string     `json:"name" validate:"required,max=100"`
+	Description     string     `json:"description" validate:"required,max=500"`
+	ServiceType     string     `json:"service_type" validate:"required,max=50"`
+	Status          string     `json:"status" validate:"required"`
+	OrganizationId  int        `json:"organization_id" validate:"required,gte=0"`
+	CreatorUsername string     `json:"creator_username" validate:"required"`
+	PublishAt       *time.Time `json:"publish_at,omitempty"`
+	Deadline        *time.Time `json:"deadline,omitempty"`
+}
+
+// TenderVersion сохраненная версия тендера.
+//
+// ModifiedBy - username сотрудника, который создал эту версию,
+// время создания версии лежит в UpdatedAt. Если версия создана
+// откатом, то в RolledBackFrom лежит номер версии, на которую откатили.
+type TenderVersion struct {
+	Tender
+	IsActiveVersion bool   `json:"is_active_version"`
+	ModifiedBy      string `json:"modified_by"`
+	RolledBackFrom  *int   `json:"rolled_back_from,omitempty"`
 }
 
 func (tender *Tender) IsNewTenderHasStatusCreated() bool {
 	return tender.Status == TenderCreatedStatus
 }
 
+// IsDeadlineAfterPublishAt проверяет, что если заданы и PublishAt, и
+// Deadline, то дедлайн наступает позже публикации.
+func (tender *Tender) IsDeadlineAfterPublishAt() bool {
+	if tender.PublishAt == nil || tender.Deadline == nil {
+		return true
+	}
+	return tender.Deadline.After(*tender.PublishAt)
+}
+
+// Apply возвращает тендер, к которому применены изменения из updateTender.
+func (tender Tender) Apply(updateTender TenderToUpdate) Tender {
+	if updateTender.TenderName != nil {
+		tender.TenderName = *updateTender.TenderName
+	}
+	if updateTender.Description != nil {
+		tender.Description = *updateTender.Description
+	}
+	if updateTender.ServiceType != nil {
+		tender.ServiceType = *updateTender.ServiceType
+	}
+	if updateTender.Status != nil {
+		tender.Status = *updateTender.Status
+	}
+	if updateTender.OrganizationId != nil {
+		tender.OrganizationId = *updateTender.OrganizationId
+	}
+	if updateTender.CreatorUsername != nil {
+		tender.CreatorUsername = *updateTender.CreatorUsername
+	}
+	if updateTender.PublishAt != nil {
+		tender.PublishAt = updateTender.PublishAt
+	}
+	if updateTender.Deadline != nil {
+		tender.Deadline = updateTender.Deadline
+	}
+	return tender
+}
+
 var (
 	TenderCreatedStatus   = "CREATED"
 	TenderPublishedStatus = "PUBLISHED"
@@ -20,36 +88,12 @@ var (
 )
 
 type TenderToUpdate struct {
-	TenderName      *string `json:"name,omitempty"`
-	Description     *string `json:"description,omitempty"`
-	ServiceType     *string `json:"service_type,omitempty"`
-	Status          *string `json:"status,omitempty"`
-	OrganizationId  *int    `json:"organization_id,omitempty" validate:"omitempty,gte=0"`
-	CreatorUsername *string `json:"creator_username,omitempty"`
-}
-
-func (tender *TenderToUpdate) IsTenderStatusKnown() bool {
-	if tender.Status != nil {
-		return *tender.Status == TenderCreatedStatus || *tender.Status == TenderPublishedStatus || *tender.Status == TenderClosedStatus
-	}
-	return true
-}
-
-// CanSetThisTenderStatus проверяет, может ли
-// новый статус тендера быть установлен.
-//
-// - нельзя перевести тендер из статуса PUBLISED в CREATED;
-//
-// - нельзя перевести тендер из статуса CLOSED в CREATED;
-//
-// Если передать также же статус, то его установить можно.
-func (tender *TenderToUpdate) CanSetThisTenderStatus(currTenderStatus string) bool {
-	if newStatus := tender.Status; newStatus != nil {
-		if currTenderStatus == TenderPublishedStatus && *newStatus == TenderCreatedStatus {
-			return false
-		} else if currTenderStatus == TenderClosedStatus && *newStatus == TenderCreatedStatus {
-			return false
-		}
-	}
-	return true
+	TenderName      *string    `json:"name,omitempty" validate:"omitnil,min=1,max=100"`
+	Description     *string    `json:"description,omitempty" validate:"omitnil,min=1,max=500"`
+	ServiceType     *string    `json:"service_type,omitempty" validate:"omitnil,min=1,max=50"`
+	Status          *string    `json:"status,omitempty" validate:"omitnil,min=1"`
+	OrganizationId  *int       `json:"organization_id,omitempty" validate:"omitnil,gte=0"`
+	CreatorUsername *string    `json:"creator_username,omitempty" validate:"omitnil,min=1"`
+	PublishAt       *time.Time `json:"publish_at,omitempty"`
+	Deadline        *time.Time `json:"deadline,omitempty"`
 }
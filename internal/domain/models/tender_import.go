@@ -0,0 +1,11 @@
+package models
+
+// TenderImportRow строка файла импорта тендеров.
+//
+// Line - номер строки в файле, Err - ошибка разбора или проверки
+// строки. Строка с ошибкой не импортируется.
+type TenderImportRow struct {
+	Line   int
+	Tender Tender
+	Err    error
+}
@@ -0,0 +1,37 @@
+package models
+
+var (
+	CloseVoteApprove = "APPROVE"
+	CloseVoteReject  = "REJECT"
+)
+
+var (
+	CloseVotingPending  = "PENDING"
+	CloseVotingClosed   = "CLOSED"
+	CloseVotingRejected = "REJECTED"
+)
+
+// TenderCloseVote голос ответственного сотрудника за закрытие тендера.
+type TenderCloseVote struct {
+	EmployeeId int
+	Decision   string
+}
+
+func (vote *TenderCloseVote) IsDecisionKnown() bool {
+	return vote.Decision == CloseVoteApprove || vote.Decision == CloseVoteReject
+}
+
+// TenderCloseVoting состояние голосования за закрытие тендера
+// после учета очередного голоса.
+//
+// - PENDING - кворум еще не набран;
+//
+// - CLOSED - кворум набран, тендер закрыт, в Tender лежит новая версия тендера;
+//
+// - REJECTED - голосование отменено вето, все голоса сброшены.
+type TenderCloseVoting struct {
+	Status    string  `json:"status"`
+	Approvals int     `json:"approvals"`
+	Quorum    int     `json:"quorum"`
+	Tender    *Tender `json:"tender,omitempty"`
+}
@@ -0,0 +1,144 @@
+package tenderpolicy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+// Action действие сотрудника с тендером.
+type Action string
+
+// Действия с тендером, которые проверяет политика.
+//
+// - ActionRead - просмотр неопубликованного тендера, его статуса и версий;
+//
+// - ActionEdit - изменение полей и статуса тендера;
+//
+// - ActionRollback - откат тендера на старую версию;
+//
+// - ActionReassign - передача тендера другому сотруднику или организации.
+const (
+	ActionRead     Action = "read"
+	ActionEdit     Action = "edit"
+	ActionRollback Action = "rollback"
+	ActionReassign Action = "reassign"
+)
+
+// Policy решает, может ли сотрудник выполнить действие с тендером.
+//
+// Authorize возвращает ErrEmployeeNotResponsibleForTender, если действие запрещено.
+// CanReadAll проверяет, может ли сотрудник видеть в списках тендеры
+// других сотрудников в любом статусе.
+type Policy interface {
+	Authorize(ctx context.Context, username string, action Action, tender models.Tender) error
+	CanReadAll(ctx context.Context, username string) (bool, error)
+}
+
+// CreatorPolicy разрешает любые действия с тендером только его создателю.
+type CreatorPolicy struct{}
+
+func (CreatorPolicy) Authorize(_ context.Context, username string, _ Action, tender models.Tender) error {
+	if tender.CreatorUsername != username {
+		return outerror.ErrEmployeeNotResponsibleForTender
+	}
+	return nil
+}
+
+func (CreatorPolicy) CanReadAll(context.Context, string) (bool, error) {
+	return false, nil
+}
+
+// EmployeeGetter ищет сотрудника по username.
+type EmployeeGetter interface {
+	GetEmployeeByUsername(ctx context.Context, username string) (models.Employee, error)
+}
+
+// RoleGetter возвращает глобальные роли сотрудника и его роль в организации.
+// Если сотрудник не ответственный за организацию, то GetOrganizationRole
+// возвращает ErrEmployeeNotResponsibleForOrganization.
+type RoleGetter interface {
+	GetEmployeeRoles(ctx context.Context, emplId int) ([]string, error)
+	GetOrganizationRole(ctx context.Context, emplId int, orgId int) (string, error)
+}
+
+// RolePolicy проверяет действия по ролям сотрудника:
+//
+// - системный администратор может все;
+//
+// - аудитор может читать любые тендеры;
+//
+// - администратор организации может все с тендерами своей организации;
+//
+// - участник организации может читать, редактировать и откатывать
+// тендеры своей организации, но не передавать их.
+type RolePolicy struct {
+	employeeRepo EmployeeGetter
+	roleRepo     RoleGetter
+}
+
+func NewRolePolicy(employeeRepo EmployeeGetter, roleRepo RoleGetter) *RolePolicy {
+	return &RolePolicy{
+		employeeRepo: employeeRepo,
+		roleRepo:     roleRepo,
+	}
+}
+
+func (policy *RolePolicy) Authorize(ctx context.Context, username string, action Action, tender models.Tender) error {
+	empl, roles, err := policy.employeeRoles(ctx, username)
+	if err != nil {
+		if errors.Is(err, outerror.ErrEmployeeNotFound) {
+			return outerror.ErrEmployeeNotResponsibleForTender
+		}
+		return err
+	}
+	if slices.Contains(roles, models.RoleSystemAdmin) {
+		return nil
+	}
+	if action == ActionRead && slices.Contains(roles, models.RoleAuditor) {
+		return nil
+	}
+
+	orgRole, err := policy.roleRepo.GetOrganizationRole(ctx, empl.ID, tender.OrganizationId)
+	if err != nil {
+		if errors.Is(err, outerror.ErrEmployeeNotResponsibleForOrganization) {
+			return outerror.ErrEmployeeNotResponsibleForTender
+		}
+		return fmt.Errorf("cannot get organization role: %w", err)
+	}
+	if orgRole == models.OrganizationRoleAdmin || action != ActionReassign {
+		return nil
+	}
+	return outerror.ErrEmployeeNotResponsibleForTender
+}
+
+func (policy *RolePolicy) CanReadAll(ctx context.Context, username string) (bool, error) {
+	_, roles, err := policy.employeeRoles(ctx, username)
+	if err != nil {
+		if errors.Is(err, outerror.ErrEmployeeNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return slices.Contains(roles, models.RoleSystemAdmin) || slices.Contains(roles, models.RoleAuditor), nil
+}
+
+// employeeRoles возвращает сотрудника с username и его глобальные роли.
+func (policy *RolePolicy) employeeRoles(ctx context.Context, username string) (models.Employee, []string, error) {
+	empl, err := policy.employeeRepo.GetEmployeeByUsername(ctx, username)
+	if err != nil {
+		if errors.Is(err, outerror.ErrEmployeeNotFound) {
+			return models.Employee{}, nil, outerror.ErrEmployeeNotFound
+		}
+		return models.Employee{}, nil, fmt.Errorf("cannot get employee: %w", err)
+	}
+	roles, err := policy.roleRepo.GetEmployeeRoles(ctx, empl.ID)
+	if err != nil {
+		return models.Employee{}, nil, fmt.Errorf("cannot get employee roles: %w", err)
+	}
+	return empl, roles, nil
+}
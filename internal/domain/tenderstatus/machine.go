@@ -0,0 +1,159 @@
+package tenderstatus
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sariya23/tender/internal/domain/models"
+)
+
+// Transition разрешенный переход между статусами тендера.
+//
+// Если RequiresReason true, то для перехода нужно указать причину.
+type Transition struct {
+	From           string `json:"from"`
+	To             string `json:"to"`
+	RequiresReason bool   `json:"requires_reason"`
+}
+
+// DefaultTransitions переходы статусов тендера по умолчанию:
+//
+// - CREATED -> PUBLISHED - публикация;
+//
+// - CREATED -> CLOSED - отмена неопубликованного тендера;
+//
+// - PUBLISHED -> CLOSED - закрытие (кворумом ответственных, см. VoteCloseTender);
+//
+// - CLOSED -> PUBLISHED - повторное открытие, нужна причина.
+//
+// Вернуть тендер в CREATED нельзя.
+var DefaultTransitions = []Transition{
+	{From: models.TenderCreatedStatus, To: models.TenderPublishedStatus},
+	{From: models.TenderCreatedStatus, To: models.TenderClosedStatus},
+	{From: models.TenderPublishedStatus, To: models.TenderClosedStatus},
+	{From: models.TenderClosedStatus, To: models.TenderPublishedStatus, RequiresReason: true},
+}
+
+// Event переход статуса тендера, который уже сохранен.
+// Tender - новая активная версия тендера.
+type Event struct {
+	From     string
+	To       string
+	Reason   string
+	Username string
+	Tender   models.Tender
+}
+
+// Hook вызывается после каждого перехода статуса тендера.
+type Hook func(ctx context.Context, event Event)
+
+// Machine конечный автомат статусов тендера.
+type Machine struct {
+	statuses    map[string]struct{}
+	declared    []Transition
+	transitions map[string]map[string]Transition
+
+	mu    sync.RWMutex
+	hooks []Hook
+}
+
+// New создает автомат с переходами transitions.
+// Известными считаются статусы, которые встречаются в переходах.
+func New(transitions []Transition) *Machine {
+	machine := &Machine{
+		statuses:    make(map[string]struct{}),
+		declared:    transitions,
+		transitions: make(map[string]map[string]Transition),
+	}
+	for _, transition := range transitions {
+		machine.statuses[transition.From] = struct{}{}
+		machine.statuses[transition.To] = struct{}{}
+		if machine.transitions[transition.From] == nil {
+			machine.transitions[transition.From] = make(map[string]Transition)
+		}
+		machine.transitions[transition.From][transition.To] = transition
+	}
+	return machine
+}
+
+// NewDefault создает автомат с переходами DefaultTransitions.
+func NewDefault() *Machine {
+	return New(DefaultTransitions)
+}
+
+// IsKnown проверяет, что статус известен автомату.
+func (machine *Machine) IsKnown(status string) bool {
+	_, ok := machine.statuses[status]
+	return ok
+}
+
+// Statuses возвращает известные автомату статусы в порядке,
+// в котором они впервые встречаются в переходах.
+func (machine *Machine) Statuses() []string {
+	statuses := []string{}
+	seen := make(map[string]struct{}, len(machine.statuses))
+	for _, transition := range machine.declared {
+		for _, status := range []string{transition.From, transition.To} {
+			if _, ok := seen[status]; !ok {
+				seen[status] = struct{}{}
+				statuses = append(statuses, status)
+			}
+		}
+	}
+	return statuses
+}
+
+// Transition возвращает переход из from в to. Если перехода
+// нет, то второе значение false. Переход в тот же статус
+// разрешен всегда и не требует причины.
+func (machine *Machine) Transition(from string, to string) (Transition, bool) {
+	if from == to && machine.IsKnown(from) {
+		return Transition{From: from, To: to}, true
+	}
+	transition, ok := machine.transitions[from][to]
+	return transition, ok
+}
+
+// Allowed возвращает переходы, доступные из статуса from,
+// в порядке, в котором они были объявлены.
+func (machine *Machine) Allowed(from string) []Transition {
+	allowed := []Transition{}
+	for _, transition := range machine.declared {
+		if transition.From == from {
+			allowed = append(allowed, transition)
+		}
+	}
+	return allowed
+}
+
+// OnTransition регистрирует хук, который вызовется после перехода статуса.
+func (machine *Machine) OnTransition(hook Hook) {
+	machine.mu.Lock()
+	defer machine.mu.Unlock()
+	machine.hooks = append(machine.hooks, hook)
+}
+
+// Fire вызывает зарегистрированные хуки в порядке регистрации.
+// Если статус не поменялся, то хуки не вызываются.
+func (machine *Machine) Fire(ctx context.Context, event Event) {
+	if event.From == event.To {
+		return
+	}
+	machine.mu.RLock()
+	hooks := machine.hooks
+	machine.mu.RUnlock()
+	for _, hook := range hooks {
+		hook(ctx, event)
+	}
+}
+
+// State текущий статус тендера и доступные из него переходы.
+// В Allowed переходы, которые можно сделать сменой статуса,
+// в QuorumOnly - те, что доступны только голосованием ответственных.
+type State struct {
+	TenderId   int          `json:"tender_id"`
+	Version    int          `json:"version"`
+	Status     string       `json:"status"`
+	Allowed    []Transition `json:"allowed_transitions"`
+	QuorumOnly []Transition `json:"quorum_transitions,omitempty"`
+}
@@ -0,0 +1,164 @@
+package apikeyapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/sariya23/tender/internal/domain/models"
+	schema "github.com/sariya23/tender/internal/hanlders"
+	"github.com/sariya23/tender/internal/lib/unmarshal"
+	"github.com/sariya23/tender/internal/middleware"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+func (apiKeySrv *APIKeyService) GetAPIKeys(ctx context.Context) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		const operationPlace = "internal.api.apikeyapi.GetAPIKeys"
+		logger := apiKeySrv.logger.With("op", operationPlace)
+		logger.Info(fmt.Sprintf("request to %v", ginContext.Request.URL))
+
+		employee, ok := middleware.EmployeeFromContext(ginContext.Request.Context())
+		if !ok {
+			logger.Warn("request is not authenticated")
+			ginContext.JSON(http.StatusUnauthorized, schema.GetAPIKeysResponse{Message: middleware.ErrNotAuthenticated.Error(), APIKeys: []models.APIKey{}})
+			return
+		}
+
+		apiKeys, err := apiKeySrv.apiKeyService.GetAPIKeys(ctx, employee.ID)
+		if err != nil {
+			logger.Error("unexpected error", slog.String("err", err.Error()))
+			ginContext.JSON(http.StatusInternalServerError, schema.GetAPIKeysResponse{Message: "internal error", APIKeys: []models.APIKey{}})
+			return
+		}
+		logger.Info("send success response")
+		ginContext.JSON(http.StatusOK, schema.GetAPIKeysResponse{Message: "ok", APIKeys: apiKeys})
+	}
+}
+
+func (apiKeySrv *APIKeyService) IssueAPIKey(ctx context.Context) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		const operationPlace = "internal.api.apikeyapi.IssueAPIKey"
+		logger := apiKeySrv.logger.With("op", operationPlace)
+		logger.Info(fmt.Sprintf("request to %v", ginContext.Request.URL))
+
+		employee, ok := middleware.EmployeeFromContext(ginContext.Request.Context())
+		if !ok {
+			logger.Warn("request is not authenticated")
+			ginContext.JSON(http.StatusUnauthorized, schema.IssueAPIKeyResponse{Message: middleware.ErrNotAuthenticated.Error()})
+			return
+		}
+
+		body := ginContext.Request.Body
+		defer func() {
+			err := body.Close()
+			if err != nil {
+				logger.Error("cannot close body", slog.String("err", err.Error()))
+			}
+		}()
+
+		bodyData, err := io.ReadAll(body)
+		if err != nil {
+			logger.Error("cannot read body", slog.String("err", err.Error()))
+			ginContext.JSON(http.StatusInternalServerError, schema.IssueAPIKeyResponse{Message: "internal error"})
+			return
+		}
+		logger.Info("success read body")
+		issueReq, err := unmarshal.IssueAPIKeyRequest(bodyData)
+		if err != nil {
+			if errors.Is(err, unmarshal.ErrSyntax) {
+				logger.Warn("req syntax error", slog.String("err", err.Error()))
+				ginContext.JSON(http.StatusBadRequest, schema.IssueAPIKeyResponse{Message: fmt.Sprintf("json syntax err: %s", err.Error())})
+				return
+			} else if errors.Is(err, unmarshal.ErrType) {
+				logger.Warn("req type error", slog.String("err", err.Error()))
+				ginContext.JSON(http.StatusBadRequest, schema.IssueAPIKeyResponse{Message: fmt.Sprintf("json type err: %s", err.Error())})
+				return
+			} else {
+				logger.Error("unexpected error", slog.String("err", err.Error()))
+				ginContext.JSON(http.StatusInternalServerError, schema.IssueAPIKeyResponse{Message: "internal error"})
+				return
+			}
+		}
+		logger.Info("success unmarshal request")
+
+		validate := validator.New(validator.WithRequiredStructEnabled())
+		err = validate.Struct(&issueReq)
+		if err != nil {
+			logger.Error("validation error", slog.String("err", err.Error()))
+			ginContext.JSON(http.StatusBadRequest, schema.IssueAPIKeyResponse{Message: fmt.Sprintf("validation failed: %s", err.Error())})
+			return
+		}
+		logger.Info("validate success")
+
+		apiKey, key, err := apiKeySrv.apiKeyService.IssueAPIKey(ctx, employee.ID, issueReq.Name, issueReq.Scopes)
+		if err != nil {
+			if errors.Is(err, outerror.ErrUnknownAPIKeyScope) {
+				logger.Warn("unknown scope", slog.Any("scopes", issueReq.Scopes))
+				ginContext.JSON(
+					http.StatusBadRequest,
+					schema.IssueAPIKeyResponse{
+						Message: fmt.Sprintf(
+							"unknown scope, allowed: %s, %s, %s",
+							models.ScopeTendersRead,
+							models.ScopeTendersWrite,
+							models.ScopeTendersRollback,
+						),
+					},
+				)
+				return
+			} else if errors.Is(err, outerror.ErrEmployeeNotFound) {
+				logger.Warn(fmt.Sprintf("employee with id=<%d> not found", employee.ID))
+				ginContext.JSON(http.StatusUnauthorized, schema.IssueAPIKeyResponse{Message: middleware.ErrNotAuthenticated.Error()})
+				return
+			} else {
+				logger.Error("unexpected error", slog.String("err", err.Error()))
+				ginContext.JSON(http.StatusInternalServerError, schema.IssueAPIKeyResponse{Message: "internal error"})
+				return
+			}
+		}
+		logger.Info("api key issued success")
+		ginContext.JSON(http.StatusOK, schema.IssueAPIKeyResponse{Message: "ok", APIKey: apiKey, Key: key})
+	}
+}
+
+func (apiKeySrv *APIKeyService) RevokeAPIKey(ctx context.Context) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		const operationPlace = "internal.api.apikeyapi.RevokeAPIKey"
+		logger := apiKeySrv.logger.With("op", operationPlace)
+		logger.Info(fmt.Sprintf("request to %v", ginContext.Request.URL))
+
+		employee, ok := middleware.EmployeeFromContext(ginContext.Request.Context())
+		if !ok {
+			logger.Warn("request is not authenticated")
+			ginContext.JSON(http.StatusUnauthorized, schema.RevokeAPIKeyResponse{Message: middleware.ErrNotAuthenticated.Error()})
+			return
+		}
+		apiKeyId, err := apiKeyIdParam(ginContext)
+		if err != nil {
+			logger.Warn("invalid api key id", slog.String("err", err.Error()))
+			ginContext.JSON(http.StatusNotFound, schema.RevokeAPIKeyResponse{Message: err.Error()})
+			return
+		}
+
+		apiKey, err := apiKeySrv.apiKeyService.RevokeAPIKey(ctx, employee.ID, apiKeyId)
+		if err != nil {
+			if errors.Is(err, outerror.ErrAPIKeyNotFound) {
+				logger.Warn(fmt.Sprintf("api key with id=<%d> not found", apiKeyId))
+				ginContext.JSON(http.StatusNotFound, schema.RevokeAPIKeyResponse{Message: fmt.Sprintf("api key with id=<%d> not found", apiKeyId)})
+				return
+			} else {
+				logger.Error("unexpected error", slog.String("err", err.Error()))
+				ginContext.JSON(http.StatusInternalServerError, schema.RevokeAPIKeyResponse{Message: "internal error"})
+				return
+			}
+		}
+		logger.Info("api key revoked success")
+		ginContext.JSON(http.StatusOK, schema.RevokeAPIKeyResponse{Message: "ok", APIKey: apiKey})
+	}
+}
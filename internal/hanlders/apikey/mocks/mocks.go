@@ -0,0 +1,36 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockAPIKeyServiceProvider реализует интерфейс APIKeyServiceProvider
+// для целей тестирования. Он позволяет задавать ожидаемые результаты
+// методов:
+//
+// - IssueAPIKey
+//
+// - GetAPIKeys
+//
+// - RevokeAPIKey
+type MockAPIKeyServiceProvider struct {
+	mock.Mock
+}
+
+func (m *MockAPIKeyServiceProvider) IssueAPIKey(ctx context.Context, employeeId int, name string, scopes []string) (models.APIKey, string, error) {
+	args := m.Called(ctx, employeeId, name, scopes)
+	return args.Get(0).(models.APIKey), args.String(1), args.Error(2)
+}
+
+func (m *MockAPIKeyServiceProvider) GetAPIKeys(ctx context.Context, employeeId int) ([]models.APIKey, error) {
+	args := m.Called(ctx, employeeId)
+	return args.Get(0).([]models.APIKey), args.Error(1)
+}
+
+func (m *MockAPIKeyServiceProvider) RevokeAPIKey(ctx context.Context, employeeId int, apiKeyId int) (models.APIKey, error) {
+	args := m.Called(ctx, employeeId, apiKeyId)
+	return args.Get(0).(models.APIKey), args.Error(1)
+}
@@ -0,0 +1,46 @@
+package apikeyapi
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sariya23/tender/internal/domain/models"
+)
+
+type APIKeyServiceProvider interface {
+	IssueAPIKey(ctx context.Context, employeeId int, name string, scopes []string) (models.APIKey, string, error)
+	GetAPIKeys(ctx context.Context, employeeId int) ([]models.APIKey, error)
+	RevokeAPIKey(ctx context.Context, employeeId int, apiKeyId int) (models.APIKey, error)
+}
+
+type APIKeyService struct {
+	logger        *slog.Logger
+	apiKeyService APIKeyServiceProvider
+}
+
+func New(logger *slog.Logger, apiKeyService APIKeyServiceProvider) *APIKeyService {
+	return &APIKeyService{
+		logger:        logger,
+		apiKeyService: apiKeyService,
+	}
+}
+
+var (
+	errAPIKeyIdNotInteger  = errors.New("cannot convert api key id to integer")
+	errAPIKeyIdNotPositive = errors.New("api key id must be positive integer")
+)
+
+// apiKeyIdParam читает id API ключа из параметра пути apiKeyId.
+func apiKeyIdParam(ginContext *gin.Context) (int, error) {
+	apiKeyId, err := strconv.Atoi(ginContext.Param("apiKeyId"))
+	if err != nil {
+		return 0, errAPIKeyIdNotInteger
+	}
+	if apiKeyId <= 0 {
+		return 0, errAPIKeyIdNotPositive
+	}
+	return apiKeyId, nil
+}
@@ -0,0 +1,306 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	apikeyapi "github.com/sariya23/tender/internal/hanlders/apikey"
+	"github.com/sariya23/tender/internal/hanlders/apikey/mocks"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestIssueAPIKey_Success проверяет успешный выпуск API ключа.
+//
+// Возвращается код 200, ключ и его данные.
+func TestIssueAPIKey_Success(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockAPIKeyService := new(mocks.MockAPIKeyServiceProvider)
+	createdAt := time.Date(2024, 12, 23, 10, 0, 0, 0, time.UTC)
+	scopes := []string{models.ScopeTendersRead, models.ScopeTendersWrite}
+	issuedAPIKey := models.APIKey{ID: 1, EmployeeId: 2, Name: "erp", Prefix: "tnd_0123abcd", Scopes: scopes, CreatedAt: createdAt}
+	reqBody := `{"name": "erp", "scopes": ["tenders:read", "tenders:write"]}`
+	expectedBody := `
+	{
+		"api_key": {
+			"id": 1,
+			"employee_id": 2,
+			"name": "erp",
+			"prefix": "tnd_0123abcd",
+			"scopes": ["tenders:read", "tenders:write"],
+			"created_at": "2024-12-23T10:00:00Z",
+			"revoked_at": null
+		},
+		"key": "tnd_0123abcdef",
+		"message": "ok"
+	}`
+	svc := apikeyapi.New(logger, mockAPIKeyService)
+
+	mockAPIKeyService.On("IssueAPIKey", ctx, 2, "erp", scopes).Return(issuedAPIKey, "tnd_0123abcdef", nil)
+	router := gin.New()
+	router.Use(authenticatedAs(models.Employee{ID: 2, Username: "qwe"}))
+	router.POST("/api/api-keys/new", svc.IssueAPIKey(ctx))
+	req := httptest.NewRequest(http.MethodPost, "/api/api-keys/new", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestIssueAPIKey_FailUnknownScope проверяет, что если
+// указано неизвестное право, то возвращается код 400.
+func TestIssueAPIKey_FailUnknownScope(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockAPIKeyService := new(mocks.MockAPIKeyServiceProvider)
+	reqBody := `{"name": "erp", "scopes": ["bids:write"]}`
+	expectedBody := `
+	{
+		"api_key": {
+			"id": 0,
+			"employee_id": 0,
+			"name": "",
+			"prefix": "",
+			"scopes": null,
+			"created_at": "0001-01-01T00:00:00Z",
+			"revoked_at": null
+		},
+		"key": "",
+		"message": "unknown scope, allowed: tenders:read, tenders:write, tenders:rollback"
+	}`
+	svc := apikeyapi.New(logger, mockAPIKeyService)
+
+	mockAPIKeyService.On("IssueAPIKey", ctx, 2, "erp", []string{"bids:write"}).Return(models.APIKey{}, "", outerror.ErrUnknownAPIKeyScope)
+	router := gin.New()
+	router.Use(authenticatedAs(models.Employee{ID: 2, Username: "qwe"}))
+	router.POST("/api/api-keys/new", svc.IssueAPIKey(ctx))
+	req := httptest.NewRequest(http.MethodPost, "/api/api-keys/new", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestIssueAPIKey_FailValidation проверяет, что ключ
+// без прав не выпускается и возвращается код 400.
+func TestIssueAPIKey_FailValidation(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockAPIKeyService := new(mocks.MockAPIKeyServiceProvider)
+	reqBody := `{"name": "erp", "scopes": []}`
+	svc := apikeyapi.New(logger, mockAPIKeyService)
+	router := gin.New()
+	router.Use(authenticatedAs(models.Employee{ID: 2, Username: "qwe"}))
+	router.POST("/api/api-keys/new", svc.IssueAPIKey(ctx))
+	req := httptest.NewRequest(http.MethodPost, "/api/api-keys/new", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	require.Contains(t, w.Body.String(), "validation failed")
+	mockAPIKeyService.AssertNotCalled(t, "IssueAPIKey")
+}
+
+// TestGetAPIKeys_Success проверяет, что возвращаются ключи
+// аутентифицированного сотрудника.
+//
+// Возвращается код 200.
+func TestGetAPIKeys_Success(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockAPIKeyService := new(mocks.MockAPIKeyServiceProvider)
+	createdAt := time.Date(2024, 12, 23, 10, 0, 0, 0, time.UTC)
+	apiKeys := []models.APIKey{
+		{ID: 1, EmployeeId: 2, Name: "erp", Prefix: "tnd_0123abcd", Scopes: []string{models.ScopeTendersRead}, CreatedAt: createdAt},
+	}
+	expectedBody := `
+	{
+		"api_keys": [
+			{
+				"id": 1,
+				"employee_id": 2,
+				"name": "erp",
+				"prefix": "tnd_0123abcd",
+				"scopes": ["tenders:read"],
+				"created_at": "2024-12-23T10:00:00Z",
+				"revoked_at": null
+			}
+		],
+		"message": "ok"
+	}`
+	svc := apikeyapi.New(logger, mockAPIKeyService)
+
+	mockAPIKeyService.On("GetAPIKeys", ctx, 2).Return(apiKeys, nil)
+	router := gin.New()
+	router.Use(authenticatedAs(models.Employee{ID: 2, Username: "qwe"}))
+	router.GET("/api/api-keys", svc.GetAPIKeys(ctx))
+	req := httptest.NewRequest(http.MethodGet, "/api/api-keys", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestGetAPIKeys_FailNotAuthenticated проверяет, что
+// без аутентификации возвращается код 401.
+func TestGetAPIKeys_FailNotAuthenticated(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockAPIKeyService := new(mocks.MockAPIKeyServiceProvider)
+	expectedBody := `{"api_keys": [], "message": "authentication required"}`
+	svc := apikeyapi.New(logger, mockAPIKeyService)
+	router := gin.New()
+	router.GET("/api/api-keys", svc.GetAPIKeys(ctx))
+	req := httptest.NewRequest(http.MethodGet, "/api/api-keys", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+	mockAPIKeyService.AssertNotCalled(t, "GetAPIKeys")
+}
+
+// TestRevokeAPIKey_Success проверяет успешный отзыв ключа.
+//
+// Возвращается код 200 и отозванный ключ.
+func TestRevokeAPIKey_Success(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockAPIKeyService := new(mocks.MockAPIKeyServiceProvider)
+	createdAt := time.Date(2024, 12, 23, 10, 0, 0, 0, time.UTC)
+	revokedAt := time.Date(2024, 12, 24, 10, 0, 0, 0, time.UTC)
+	revokedAPIKey := models.APIKey{
+		ID:         1,
+		EmployeeId: 2,
+		Name:       "erp",
+		Prefix:     "tnd_0123abcd",
+		Scopes:     []string{models.ScopeTendersRead},
+		CreatedAt:  createdAt,
+		RevokedAt:  &revokedAt,
+	}
+	expectedBody := `
+	{
+		"api_key": {
+			"id": 1,
+			"employee_id": 2,
+			"name": "erp",
+			"prefix": "tnd_0123abcd",
+			"scopes": ["tenders:read"],
+			"created_at": "2024-12-23T10:00:00Z",
+			"revoked_at": "2024-12-24T10:00:00Z"
+		},
+		"message": "ok"
+	}`
+	svc := apikeyapi.New(logger, mockAPIKeyService)
+
+	mockAPIKeyService.On("RevokeAPIKey", ctx, 2, 1).Return(revokedAPIKey, nil)
+	router := gin.New()
+	router.Use(authenticatedAs(models.Employee{ID: 2, Username: "qwe"}))
+	router.DELETE("/api/api-keys/:apiKeyId", svc.RevokeAPIKey(ctx))
+	req := httptest.NewRequest(http.MethodDelete, "/api/api-keys/1", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestRevokeAPIKey_FailNotFound проверяет, что если у сотрудника
+// нет такого действующего ключа, то возвращается код 404.
+func TestRevokeAPIKey_FailNotFound(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockAPIKeyService := new(mocks.MockAPIKeyServiceProvider)
+	svc := apikeyapi.New(logger, mockAPIKeyService)
+
+	mockAPIKeyService.On("RevokeAPIKey", ctx, 2, 1).Return(models.APIKey{}, outerror.ErrAPIKeyNotFound)
+	router := gin.New()
+	router.Use(authenticatedAs(models.Employee{ID: 2, Username: "qwe"}))
+	router.DELETE("/api/api-keys/:apiKeyId", svc.RevokeAPIKey(ctx))
+	req := httptest.NewRequest(http.MethodDelete, "/api/api-keys/1", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	require.Contains(t, w.Body.String(), "api key with id=\\u003c1\\u003e not found")
+}
+
+// TestRevokeAPIKey_FailInvalidId проверяет, что если id ключа
+// не число, то возвращается код 404.
+func TestRevokeAPIKey_FailInvalidId(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockAPIKeyService := new(mocks.MockAPIKeyServiceProvider)
+	svc := apikeyapi.New(logger, mockAPIKeyService)
+	router := gin.New()
+	router.Use(authenticatedAs(models.Employee{ID: 2, Username: "qwe"}))
+	router.DELETE("/api/api-keys/:apiKeyId", svc.RevokeAPIKey(ctx))
+	req := httptest.NewRequest(http.MethodDelete, "/api/api-keys/qwe", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	require.Contains(t, w.Body.String(), "cannot convert api key id to integer")
+	mockAPIKeyService.AssertNotCalled(t, "RevokeAPIKey")
+}
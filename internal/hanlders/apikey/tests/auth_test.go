@@ -0,0 +1,16 @@
+package tests
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/middleware"
+)
+
+// authenticatedAs кладет в контекст запроса сотрудника,
+// как это делает middleware аутентификации.
+func authenticatedAs(employee models.Employee) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		ctx := middleware.ContextWithEmployee(ginContext.Request.Context(), employee)
+		ginContext.Request = ginContext.Request.WithContext(ctx)
+	}
+}
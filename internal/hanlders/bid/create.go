@@ -0,0 +1,182 @@
+package bidapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/sariya23/tender/internal/domain/models"
+	schema "github.com/sariya23/tender/internal/hanlders"
+	"github.com/sariya23/tender/internal/lib/unmarshal"
+	"github.com/sariya23/tender/internal/middleware"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+func (bidSrv *BidService) CreateBid(ctx context.Context) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		const operationPlace = "internal.api.bidapi.CreateBid"
+		logger := bidSrv.logger.With("op", operationPlace)
+		logger.Info(fmt.Sprintf("request to %v", ginContext.Request.URL))
+
+		body := ginContext.Request.Body
+		defer func() {
+			err := body.Close()
+			if err != nil {
+				logger.Error("cannot close body", slog.String("err", err.Error()))
+			}
+		}()
+
+		bodyData, err := io.ReadAll(body)
+		if err != nil {
+			logger.Error("cannot read body", slog.String("err", err.Error()))
+			ginContext.JSON(http.StatusInternalServerError, schema.CreateBidResponse{Message: "internal error", Bid: models.Bid{}})
+			return
+		}
+		logger.Info("success read body")
+		createReq, err := unmarshal.CreateBidRequest(bodyData)
+		if err != nil {
+			if errors.Is(err, unmarshal.ErrSyntax) {
+				logger.Warn("req syntax error", slog.String("err", err.Error()))
+				ginContext.JSON(
+					http.StatusBadRequest,
+					schema.CreateBidResponse{
+						Message: fmt.Sprintf("json syntax err: %s", err.Error()),
+						Bid:     models.Bid{},
+					},
+				)
+				return
+			} else if errors.Is(err, unmarshal.ErrType) {
+				logger.Warn("req type error", slog.String("err", err.Error()))
+				ginContext.JSON(
+					http.StatusBadRequest,
+					schema.CreateBidResponse{
+						Message: fmt.Sprintf("json type err: %s", err.Error()),
+						Bid:     models.Bid{},
+					},
+				)
+				return
+			} else {
+				logger.Error("unexpected error", slog.String("err", err.Error()))
+				ginContext.JSON(http.StatusInternalServerError, schema.CreateBidResponse{Message: "internal error", Bid: models.Bid{}})
+				return
+			}
+		}
+		logger.Info("success unmarshal request")
+
+		createReq.Bid.CreatorUsername, err = middleware.ActingUsername(ginContext, createReq.Bid.CreatorUsername)
+		if err != nil {
+			logger.Warn("cannot resolve acting employee", slog.String("err", err.Error()))
+			ginContext.JSON(middleware.AuthErrorStatus(err), schema.CreateBidResponse{Message: err.Error(), Bid: models.Bid{}})
+			return
+		}
+
+		validate := validator.New(validator.WithRequiredStructEnabled())
+		err = validate.Struct(&createReq)
+		if err != nil {
+			logger.Error("validation error", slog.String("err", err.Error()))
+			ginContext.JSON(
+				http.StatusBadRequest,
+				schema.CreateBidResponse{
+					Message: fmt.Sprintf("validation failed: %s", err.Error()),
+					Bid:     models.Bid{},
+				},
+			)
+			return
+		}
+		logger.Info("validate success")
+
+		bid, err := bidSrv.bidService.CreateBid(ctx, createReq.Bid)
+		if err != nil {
+			if errors.Is(err, outerror.ErrTenderNotFound) {
+				logger.Warn("tender not found", slog.String("err", err.Error()))
+				ginContext.JSON(
+					http.StatusUnprocessableEntity,
+					schema.CreateBidResponse{
+						Message: fmt.Sprintf("tender with id=<%d> not found", createReq.Bid.TenderId),
+						Bid:     models.Bid{},
+					},
+				)
+				return
+			} else if errors.Is(err, outerror.ErrTenderNotPublished) {
+				logger.Warn("tender not published", slog.String("err", err.Error()))
+				ginContext.JSON(
+					http.StatusUnprocessableEntity,
+					schema.CreateBidResponse{
+						Message: fmt.Sprintf("tender with id=<%d> is not published", createReq.Bid.TenderId),
+						Bid:     models.Bid{},
+					},
+				)
+				return
+			} else if errors.Is(err, outerror.ErrBidOrganizationIsTenderOrganization) {
+				logger.Warn("bid organization is tender organization", slog.String("err", err.Error()))
+				ginContext.JSON(
+					http.StatusForbidden,
+					schema.CreateBidResponse{
+						Message: fmt.Sprintf(
+							"organization with id=<%d> cannot make bid on its own tender with id=<%d>",
+							createReq.Bid.OrganizationId,
+							createReq.Bid.TenderId,
+						),
+						Bid: models.Bid{},
+					},
+				)
+				return
+			} else if errors.Is(err, outerror.ErrEmployeeNotFound) {
+				logger.Warn("employee not found", slog.String("err", err.Error()))
+				ginContext.JSON(
+					http.StatusUnprocessableEntity,
+					schema.CreateBidResponse{
+						Message: fmt.Sprintf("employee with username=<%s> not found", createReq.Bid.CreatorUsername),
+						Bid:     models.Bid{},
+					},
+				)
+				return
+			} else if errors.Is(err, outerror.ErrOrganizationNotFound) {
+				logger.Warn("organization not found", slog.String("err", err.Error()))
+				ginContext.JSON(
+					http.StatusUnprocessableEntity,
+					schema.CreateBidResponse{
+						Message: fmt.Sprintf("organization with id=<%d> not found", createReq.Bid.OrganizationId),
+						Bid:     models.Bid{},
+					},
+				)
+				return
+			} else if errors.Is(err, outerror.ErrEmployeeNotResponsibleForOrganization) {
+				logger.Warn("employee not responsible for organization", slog.String("err", err.Error()))
+				ginContext.JSON(
+					http.StatusForbidden,
+					schema.CreateBidResponse{
+						Message: fmt.Sprintf(
+							"employee <%s> not responsible for organization with id=<%d>",
+							createReq.Bid.CreatorUsername,
+							createReq.Bid.OrganizationId,
+						),
+						Bid: models.Bid{},
+					},
+				)
+				return
+			} else if errors.Is(err, outerror.ErrNewBidCannotCreatedWithStatusNotCreated) {
+				logger.Warn("cannot create bid with status", slog.String("status", createReq.Bid.Status))
+				ginContext.JSON(
+					http.StatusBadRequest,
+					schema.CreateBidResponse{
+						Message: fmt.Sprintf("cannot create bid with status <%s>", createReq.Bid.Status),
+						Bid:     models.Bid{},
+					},
+				)
+				return
+			} else {
+				logger.Error("unexpected error", slog.String("err", err.Error()))
+				ginContext.JSON(http.StatusInternalServerError, schema.CreateBidResponse{Message: "internal error", Bid: models.Bid{}})
+				return
+			}
+		}
+		logger.Info("bid created success")
+		ginContext.JSON(http.StatusOK, schema.CreateBidResponse{Message: "ok", Bid: bid})
+	}
+}
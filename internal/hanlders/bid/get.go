@@ -0,0 +1,134 @@
+package bidapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sariya23/tender/internal/domain/models"
+	schema "github.com/sariya23/tender/internal/hanlders"
+	"github.com/sariya23/tender/internal/middleware"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+func (bidSrv *BidService) GetTenderBids(ctx context.Context) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		const operationPlace = "internal.api.bidapi.GetTenderBids"
+		logger := bidSrv.logger.With("op", operationPlace)
+		logger.Info(fmt.Sprintf("request to %v", ginContext.Request.URL))
+
+		tenderId := ginContext.Param("tenderId")
+		convertedTenderId, err := strconv.Atoi(tenderId)
+		if err != nil {
+			logger.Error(
+				"cannot convert tender id to int",
+				slog.String("tender id", tenderId),
+				slog.String("err", err.Error()),
+			)
+			ginContext.JSON(http.StatusNotFound, schema.GetTenderBidsResponse{Message: "cannot convert tenderId to integer", Bids: []models.Bid{}})
+			return
+		}
+		if convertedTenderId < 0 {
+			logger.Error("tender id is not positive integer", slog.String("tender id", tenderId))
+			ginContext.JSON(http.StatusNotFound, schema.GetTenderBidsResponse{Message: "tender id must be positive integer", Bids: []models.Bid{}})
+			return
+		}
+
+		username, err := middleware.ActingUsername(ginContext, ginContext.Query("username"))
+		if err != nil {
+			logger.Warn("cannot resolve acting employee", slog.String("err", err.Error()))
+			ginContext.JSON(middleware.AuthErrorStatus(err), schema.GetTenderBidsResponse{Message: err.Error(), Bids: []models.Bid{}})
+			return
+		}
+
+		bids, err := bidSrv.bidService.GetTenderBids(ctx, convertedTenderId, username)
+		if err != nil {
+			if errors.Is(err, outerror.ErrTenderNotFound) {
+				logger.Warn(fmt.Sprintf("tender with id=<%d> not found", convertedTenderId))
+				ginContext.JSON(
+					http.StatusNotFound,
+					schema.GetTenderBidsResponse{
+						Message: fmt.Sprintf("tender with id=<%d> not found", convertedTenderId),
+						Bids:    []models.Bid{},
+					},
+				)
+				return
+			} else if errors.Is(err, outerror.ErrEmployeeNotResponsibleForTender) {
+				logger.Warn(fmt.Sprintf("employee with username=<%s> not creator of tender with id=<%d>", username, convertedTenderId))
+				ginContext.JSON(
+					http.StatusForbidden,
+					schema.GetTenderBidsResponse{
+						Message: fmt.Sprintf("employee with username=<%s> not creator of tender with id=<%d>", username, convertedTenderId),
+						Bids:    []models.Bid{},
+					},
+				)
+				return
+			} else if errors.Is(err, outerror.ErrTenderBidsNotFound) {
+				logger.Warn(fmt.Sprintf("not found bids for tender with id=<%d>", convertedTenderId))
+				ginContext.JSON(
+					http.StatusOK,
+					schema.GetTenderBidsResponse{
+						Message: fmt.Sprintf("not found bids for tender with id=<%d>", convertedTenderId),
+						Bids:    []models.Bid{},
+					},
+				)
+				return
+			} else {
+				logger.Error("unexpected error", slog.String("err", err.Error()))
+				ginContext.JSON(http.StatusInternalServerError, schema.GetTenderBidsResponse{Message: "internal error", Bids: []models.Bid{}})
+				return
+			}
+		}
+		logger.Info("success get tender bids")
+		ginContext.JSON(http.StatusOK, schema.GetTenderBidsResponse{Message: "ok", Bids: bids})
+	}
+}
+
+func (bidSrv *BidService) GetEmployeeBidsByUsername(ctx context.Context) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		const operationPlace = "internal.api.bidapi.GetEmployeeBidsByUsername"
+		logger := bidSrv.logger.With("op", operationPlace)
+		logger.Info(fmt.Sprintf("request to %s", ginContext.Request.URL))
+
+		username, err := middleware.ActingUsername(ginContext, ginContext.Query("username"))
+		if err != nil {
+			logger.Warn("cannot resolve acting employee", slog.String("err", err.Error()))
+			ginContext.JSON(middleware.AuthErrorStatus(err), schema.GetEmployeeBidsResponse{Message: err.Error(), Bids: []models.Bid{}})
+			return
+		}
+		bids, err := bidSrv.bidService.GetEmployeeBidsByUsername(ctx, username)
+		if err != nil {
+			if errors.Is(err, outerror.ErrEmployeeNotFound) {
+				logger.Warn(fmt.Sprintf("employee with username=<%s> not found", username))
+				ginContext.JSON(
+					http.StatusNotFound,
+					schema.GetEmployeeBidsResponse{
+						Message: fmt.Sprintf("employee with username=<%s> not found", username),
+						Bids:    []models.Bid{},
+					},
+				)
+				return
+			} else if errors.Is(err, outerror.ErrEmployeeBidsNotFound) {
+				logger.Warn(fmt.Sprintf("not found bids for employee with username=<%s>", username))
+				ginContext.JSON(
+					http.StatusOK,
+					schema.GetEmployeeBidsResponse{
+						Message: fmt.Sprintf("not found bids for employee with username=<%s>", username),
+						Bids:    []models.Bid{},
+					},
+				)
+				return
+			} else {
+				logger.Error("unexpected error", slog.String("err", err.Error()))
+				ginContext.JSON(http.StatusInternalServerError, schema.GetEmployeeBidsResponse{Message: "internal error", Bids: []models.Bid{}})
+				return
+			}
+		}
+		logger.Info("success get employee bids")
+		ginContext.JSON(http.StatusOK, schema.GetEmployeeBidsResponse{Message: "ok", Bids: bids})
+	}
+}
@@ -0,0 +1,50 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockBidServiceProvider реализует интерфейс BidServiceProvider
+// для целей тестирования. Он позволяет задавать ожидаемые результаты
+// методов:
+//
+// - CreateBid
+//
+// - GetTenderBids
+//
+// - GetEmployeeBidsByUsername
+//
+// - EditBid
+//
+// - RollbackBid
+type MockBidServiceProvider struct {
+	mock.Mock
+}
+
+func (m *MockBidServiceProvider) CreateBid(ctx context.Context, bid models.Bid) (models.Bid, error) {
+	args := m.Called(ctx, bid)
+	return args.Get(0).(models.Bid), args.Error(1)
+}
+
+func (m *MockBidServiceProvider) GetTenderBids(ctx context.Context, tenderId int, username string) ([]models.Bid, error) {
+	args := m.Called(ctx, tenderId, username)
+	return args.Get(0).([]models.Bid), args.Error(1)
+}
+
+func (m *MockBidServiceProvider) GetEmployeeBidsByUsername(ctx context.Context, username string) ([]models.Bid, error) {
+	args := m.Called(ctx, username)
+	return args.Get(0).([]models.Bid), args.Error(1)
+}
+
+func (m *MockBidServiceProvider) EditBid(ctx context.Context, bidId int, updateBid models.BidToUpdate, username string) (models.Bid, error) {
+	args := m.Called(ctx, bidId, updateBid, username)
+	return args.Get(0).(models.Bid), args.Error(1)
+}
+
+func (m *MockBidServiceProvider) RollbackBid(ctx context.Context, bidId int, version int, username string) (models.Bid, error) {
+	args := m.Called(ctx, bidId, version, username)
+	return args.Get(0).(models.Bid), args.Error(1)
+}
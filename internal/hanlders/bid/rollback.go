@@ -0,0 +1,185 @@
+package bidapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/sariya23/tender/internal/domain/models"
+	schema "github.com/sariya23/tender/internal/hanlders"
+	"github.com/sariya23/tender/internal/lib/unmarshal"
+	"github.com/sariya23/tender/internal/middleware"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+func (bidSrv *BidService) RollbackBid(ctx context.Context) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		const operationPlace = "internal.api.bidapi.RollbackBid"
+		logger := bidSrv.logger.With("op", operationPlace)
+		logger.Info(fmt.Sprintf("request to %v", ginContext.Request.URL.Path))
+
+		bidId := ginContext.Param("bidId")
+		convertedBidId, err := strconv.Atoi(bidId)
+		if err != nil {
+			logger.Error(
+				"cannot convert bid id to int",
+				slog.String("bid id", bidId),
+				slog.String("err", err.Error()),
+			)
+			ginContext.JSON(http.StatusNotFound, schema.RollbackBidResponse{Message: "cannot convert bidId to integer"})
+			return
+		}
+		if convertedBidId < 0 {
+			logger.Error("bid id is not positive integer", slog.String("bid id", bidId))
+			ginContext.JSON(http.StatusNotFound, schema.RollbackBidResponse{Message: "bid id must be positive integer"})
+			return
+		}
+
+		version := ginContext.Param("version")
+		convertedVersion, err := strconv.Atoi(version)
+		if err != nil {
+			logger.Error(
+				"cannot convert version to int",
+				slog.String("version", version),
+				slog.String("err", err.Error()),
+			)
+			ginContext.JSON(http.StatusNotFound, schema.RollbackBidResponse{Message: "cannot convert version to integer"})
+			return
+		}
+		if convertedVersion < 0 {
+			logger.Error("version is not positive integer", slog.String("version", version))
+			ginContext.JSON(http.StatusNotFound, schema.RollbackBidResponse{Message: "version must be positive integer"})
+			return
+		}
+
+		body := ginContext.Request.Body
+		defer func() {
+			err := body.Close()
+			if err != nil {
+				logger.Error("cannot close body", slog.String("err", err.Error()))
+			}
+		}()
+
+		bodyData, err := io.ReadAll(body)
+		if err != nil {
+			logger.Error("cannot read body", slog.String("err", err.Error()))
+			ginContext.JSON(http.StatusInternalServerError, schema.RollbackBidResponse{Message: "internal error", RollbackBid: models.Bid{}})
+			return
+		}
+		logger.Info("success read body")
+		rollbackReq, err := unmarshal.RollbackBidRequest(bodyData)
+		if err != nil {
+			if errors.Is(err, unmarshal.ErrSyntax) {
+				logger.Warn("req syntax error", slog.String("err", err.Error()))
+				ginContext.JSON(
+					http.StatusBadRequest,
+					schema.RollbackBidResponse{
+						Message:     fmt.Sprintf("json syntax err: %s", err.Error()),
+						RollbackBid: models.Bid{},
+					},
+				)
+				return
+			} else if errors.Is(err, unmarshal.ErrType) {
+				logger.Warn("req type error", slog.String("err", err.Error()))
+				ginContext.JSON(
+					http.StatusBadRequest,
+					schema.RollbackBidResponse{
+						Message:     fmt.Sprintf("json type err: %s", err.Error()),
+						RollbackBid: models.Bid{},
+					},
+				)
+				return
+			} else {
+				logger.Error("unexpected error", slog.String("err", err.Error()))
+				ginContext.JSON(http.StatusInternalServerError, schema.RollbackBidResponse{Message: "internal error", RollbackBid: models.Bid{}})
+				return
+			}
+		}
+		logger.Info("success unmarshal request")
+
+		rollbackReq.Username, err = middleware.ActingUsername(ginContext, rollbackReq.Username)
+		if err != nil {
+			logger.Warn("cannot resolve acting employee", slog.String("err", err.Error()))
+			ginContext.JSON(middleware.AuthErrorStatus(err), schema.RollbackBidResponse{Message: err.Error(), RollbackBid: models.Bid{}})
+			return
+		}
+
+		validate := validator.New(validator.WithRequiredStructEnabled())
+		err = validate.Struct(&rollbackReq)
+		if err != nil {
+			logger.Error("validation error", slog.String("err", err.Error()))
+			ginContext.JSON(
+				http.StatusBadRequest,
+				schema.RollbackBidResponse{
+					Message:     fmt.Sprintf("validation failed: %s", err.Error()),
+					RollbackBid: models.Bid{},
+				},
+			)
+			return
+		}
+		logger.Info("validate success")
+
+		bid, err := bidSrv.bidService.RollbackBid(ctx, convertedBidId, convertedVersion, rollbackReq.Username)
+		if err != nil {
+			if errors.Is(err, outerror.ErrBidNotFound) {
+				logger.Warn(fmt.Sprintf("bid with id=<%d> not found", convertedBidId))
+				ginContext.JSON(
+					http.StatusUnprocessableEntity,
+					schema.RollbackBidResponse{
+						Message: fmt.Sprintf("bid with id=<%d> not found", convertedBidId),
+					},
+				)
+				return
+			} else if errors.Is(err, outerror.ErrBidVersionNotFound) {
+				logger.Warn(fmt.Sprintf("bid with id=<%d> doesnt have version=<%d>", convertedBidId, convertedVersion))
+				ginContext.JSON(
+					http.StatusUnprocessableEntity,
+					schema.RollbackBidResponse{
+						Message: fmt.Sprintf("bid with id=<%d> doesnt have version=<%d>", convertedBidId, convertedVersion),
+					},
+				)
+				return
+			} else if errors.Is(err, outerror.ErrEmployeeNotResponsibleForBid) {
+				logger.Warn(fmt.Sprintf("employee with username=<%s> not creator of bid with id=<%d>", rollbackReq.Username, convertedBidId))
+				ginContext.JSON(
+					http.StatusForbidden,
+					schema.RollbackBidResponse{
+						Message: fmt.Sprintf("employee with username=<%s> not creator of bid with id=<%d>", rollbackReq.Username, convertedBidId),
+					},
+				)
+				return
+			} else if errors.Is(err, outerror.ErrCannotSetThisBidStatus) {
+				logger.Warn("cannot set this bid status")
+				ginContext.JSON(
+					http.StatusBadRequest,
+					schema.RollbackBidResponse{
+						Message: "cannot set this bid status. Cannot set bid status from PUBLISHED to CREATED and from CANCELED to any other",
+					},
+				)
+				return
+			} else if errors.Is(err, outerror.ErrTenderNotPublished) {
+				logger.Warn("bid tender not published")
+				ginContext.JSON(
+					http.StatusUnprocessableEntity,
+					schema.RollbackBidResponse{
+						Message: fmt.Sprintf("tender of bid with id=<%d> is not published", convertedBidId),
+					},
+				)
+				return
+			} else {
+				logger.Error("unexpected error", slog.String("err", err.Error()))
+				ginContext.JSON(http.StatusInternalServerError, schema.RollbackBidResponse{Message: "internal error"})
+				return
+			}
+		}
+
+		logger.Info("rollback success")
+		ginContext.JSON(http.StatusOK, schema.RollbackBidResponse{Message: "ok", RollbackBid: bid})
+	}
+}
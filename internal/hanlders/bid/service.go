@@ -0,0 +1,28 @@
+package bidapi
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/sariya23/tender/internal/domain/models"
+)
+
+type BidServiceProvider interface {
+	CreateBid(ctx context.Context, bid models.Bid) (models.Bid, error)
+	GetTenderBids(ctx context.Context, tenderId int, username string) ([]models.Bid, error)
+	GetEmployeeBidsByUsername(ctx context.Context, username string) ([]models.Bid, error)
+	EditBid(ctx context.Context, bidId int, updateBid models.BidToUpdate, username string) (models.Bid, error)
+	RollbackBid(ctx context.Context, bidId int, version int, username string) (models.Bid, error)
+}
+
+type BidService struct {
+	logger     *slog.Logger
+	bidService BidServiceProvider
+}
+
+func New(logger *slog.Logger, bidService BidServiceProvider) *BidService {
+	return &BidService{
+		logger:     logger,
+		bidService: bidService,
+	}
+}
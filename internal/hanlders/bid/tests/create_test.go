@@ -0,0 +1,213 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sariya23/tender/internal/domain/models"
+	bidapi "github.com/sariya23/tender/internal/hanlders/bid"
+	"github.com/sariya23/tender/internal/hanlders/bid/mocks"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCreateBid_Success проверяет, что при успешном
+// создании предложения возвращается код 200 и созданное предложение.
+func TestCreateBid_Success(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockBidService := new(mocks.MockBidServiceProvider)
+	bidToCreate := models.Bid{
+		TenderId:        1,
+		BidName:         "qwe",
+		Description:     "qwe",
+		Status:          "CREATED",
+		OrganizationId:  2,
+		CreatorUsername: "qwe",
+	}
+	createdBid := bidToCreate
+	createdBid.ID = 1
+	createdBid.Version = 1
+	reqBody := `
+	{
+		"bid": {
+			"tender_id": 1,
+			"name": "qwe",
+			"description": "qwe",
+			"status": "CREATED",
+			"organization_id": 2,
+			"creator_username": "qwe"
+		}
+	}`
+	expectedBody := `
+	{
+		"bid": {
+			"id": 1,
+			"tender_id": 1,
+			"name": "qwe",
+			"description": "qwe",
+			"status": "CREATED",
+			"organization_id": 2,
+			"creator_username": "qwe",
+			"version": 1
+		},
+		"message": "ok"
+	}`
+	svc := bidapi.New(logger, mockBidService)
+	mockBidService.On("CreateBid", ctx, bidToCreate).Return(createdBid, nil)
+	router := gin.New()
+	router.Use(authenticatedAs("qwe"))
+	router.POST("/api/bids/new", svc.CreateBid(ctx))
+	req := httptest.NewRequest(http.MethodPost, "/api/bids/new", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestCreateBid_FailTenderNotPublished проверяет, что
+// если тендер не опубликован, то возвращается код 422.
+func TestCreateBid_FailTenderNotPublished(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockBidService := new(mocks.MockBidServiceProvider)
+	bidToCreate := models.Bid{
+		TenderId:        1,
+		BidName:         "qwe",
+		Description:     "qwe",
+		Status:          "CREATED",
+		OrganizationId:  2,
+		CreatorUsername: "qwe",
+	}
+	reqBody := `
+	{
+		"bid": {
+			"tender_id": 1,
+			"name": "qwe",
+			"description": "qwe",
+			"status": "CREATED",
+			"organization_id": 2,
+			"creator_username": "qwe"
+		}
+	}`
+	expectedBody := `
+	{
+		"bid": {
+			"id": 0,
+			"tender_id": 0,
+			"name": "",
+			"description": "",
+			"status": "",
+			"organization_id": 0,
+			"creator_username": "",
+			"version": 0
+		},
+		"message": "tender with id=<1> is not published"
+	}`
+	svc := bidapi.New(logger, mockBidService)
+	mockBidService.On("CreateBid", ctx, bidToCreate).Return(models.Bid{}, outerror.ErrTenderNotPublished)
+	router := gin.New()
+	router.Use(authenticatedAs("qwe"))
+	router.POST("/api/bids/new", svc.CreateBid(ctx))
+	req := httptest.NewRequest(http.MethodPost, "/api/bids/new", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestCreateBid_FailSameOrganization проверяет, что если
+// организация делает предложение по своему тендеру, то возвращается код 403.
+func TestCreateBid_FailSameOrganization(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockBidService := new(mocks.MockBidServiceProvider)
+	bidToCreate := models.Bid{
+		TenderId:        1,
+		BidName:         "qwe",
+		Description:     "qwe",
+		Status:          "CREATED",
+		OrganizationId:  2,
+		CreatorUsername: "qwe",
+	}
+	reqBody := `
+	{
+		"bid": {
+			"tender_id": 1,
+			"name": "qwe",
+			"description": "qwe",
+			"status": "CREATED",
+			"organization_id": 2,
+			"creator_username": "qwe"
+		}
+	}`
+	svc := bidapi.New(logger, mockBidService)
+	mockBidService.On("CreateBid", ctx, bidToCreate).Return(models.Bid{}, outerror.ErrBidOrganizationIsTenderOrganization)
+	router := gin.New()
+	router.Use(authenticatedAs("qwe"))
+	router.POST("/api/bids/new", svc.CreateBid(ctx))
+	req := httptest.NewRequest(http.MethodPost, "/api/bids/new", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	require.Contains(t, w.Body.String(), "cannot make bid on its own tender")
+}
+
+// TestCreateBid_FailValidation проверяет, что
+// если не указаны обязательные поля, то возвращается код 400.
+func TestCreateBid_FailValidation(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockBidService := new(mocks.MockBidServiceProvider)
+	reqBody := `
+	{
+		"bid": {
+			"name": "qwe",
+			"status": "CREATED"
+		}
+	}`
+	svc := bidapi.New(logger, mockBidService)
+	router := gin.New()
+	router.Use(authenticatedAs("qwe"))
+	router.POST("/api/bids/new", svc.CreateBid(ctx))
+	req := httptest.NewRequest(http.MethodPost, "/api/bids/new", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	require.Contains(t, w.Body.String(), "validation failed")
+	mockBidService.AssertNotCalled(t, "CreateBid")
+}
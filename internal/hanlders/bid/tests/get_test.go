@@ -0,0 +1,149 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sariya23/tender/internal/domain/models"
+	bidapi "github.com/sariya23/tender/internal/hanlders/bid"
+	"github.com/sariya23/tender/internal/hanlders/bid/mocks"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetTenderBids_Success проверяет, что
+// создатель тендера получает предложения по нему.
+func TestGetTenderBids_Success(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockBidService := new(mocks.MockBidServiceProvider)
+	bids := []models.Bid{
+		{ID: 1, TenderId: 2, BidName: "qwe", Description: "qwe", Status: "PUBLISHED", OrganizationId: 3, CreatorUsername: "zxc", Version: 1},
+	}
+	expectedBody := `
+	{
+		"bids": [
+			{
+				"id": 1,
+				"tender_id": 2,
+				"name": "qwe",
+				"description": "qwe",
+				"status": "PUBLISHED",
+				"organization_id": 3,
+				"creator_username": "zxc",
+				"version": 1
+			}
+		],
+		"message": "ok"
+	}`
+	svc := bidapi.New(logger, mockBidService)
+	mockBidService.On("GetTenderBids", ctx, 2, "qwe").Return(bids, nil)
+	router := gin.New()
+	router.Use(authenticatedAs("qwe"))
+	router.GET("/api/bids/tender/:tenderId/list", svc.GetTenderBids(ctx))
+	req := httptest.NewRequest(http.MethodGet, "/api/bids/tender/2/list?username=qwe", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestGetTenderBids_FailNotTenderCreator проверяет, что если
+// предложения запрашивает не создатель тендера, то возвращается код 403.
+func TestGetTenderBids_FailNotTenderCreator(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockBidService := new(mocks.MockBidServiceProvider)
+	expectedBody := `
+	{
+		"bids": [],
+		"message": "employee with username=<zxc> not creator of tender with id=<2>"
+	}`
+	svc := bidapi.New(logger, mockBidService)
+	mockBidService.On("GetTenderBids", ctx, 2, "zxc").Return([]models.Bid{}, outerror.ErrEmployeeNotResponsibleForTender)
+	router := gin.New()
+	router.Use(authenticatedAs("zxc"))
+	router.GET("/api/bids/tender/:tenderId/list", svc.GetTenderBids(ctx))
+	req := httptest.NewRequest(http.MethodGet, "/api/bids/tender/2/list?username=zxc", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestGetEmployeeBids_FailNotAuthenticated проверяет, что
+// без аутентификации возвращается код 401.
+func TestGetEmployeeBids_FailNotAuthenticated(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockBidService := new(mocks.MockBidServiceProvider)
+	expectedBody := `
+	{
+		"bids": [],
+		"message": "authentication required"
+	}`
+	svc := bidapi.New(logger, mockBidService)
+	router := gin.New()
+	router.GET("/api/bids/my", svc.GetEmployeeBidsByUsername(ctx))
+	req := httptest.NewRequest(http.MethodGet, "/api/bids/my", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestGetEmployeeBids_SuccessBidsNotFound проверяет, что
+// если у сотрудника нет предложений, то возвращается код 200 и пустой список.
+func TestGetEmployeeBids_SuccessBidsNotFound(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockBidService := new(mocks.MockBidServiceProvider)
+	expectedBody := `
+	{
+		"bids": [],
+		"message": "not found bids for employee with username=<qwe>"
+	}`
+	svc := bidapi.New(logger, mockBidService)
+	mockBidService.On("GetEmployeeBidsByUsername", ctx, "qwe").Return([]models.Bid{}, outerror.ErrEmployeeBidsNotFound)
+	router := gin.New()
+	router.Use(authenticatedAs("qwe"))
+	router.GET("/api/bids/my", svc.GetEmployeeBidsByUsername(ctx))
+	req := httptest.NewRequest(http.MethodGet, "/api/bids/my?username=qwe", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
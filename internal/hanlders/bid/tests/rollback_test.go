@@ -0,0 +1,162 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sariya23/tender/internal/domain/models"
+	bidapi "github.com/sariya23/tender/internal/hanlders/bid"
+	"github.com/sariya23/tender/internal/hanlders/bid/mocks"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRollbackBid_Success проверяет, что при успешном
+// откате возвращается код 200 и активная версия предложения.
+func TestRollbackBid_Success(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockBidService := new(mocks.MockBidServiceProvider)
+	bid := models.Bid{ID: 2, TenderId: 1, BidName: "qwe", Description: "qwe", Status: "CREATED", OrganizationId: 3, CreatorUsername: "qwe", Version: 1}
+	expectedBody := `
+	{
+		"rollback_bid": {
+			"id": 2,
+			"tender_id": 1,
+			"name": "qwe",
+			"description": "qwe",
+			"status": "CREATED",
+			"organization_id": 3,
+			"creator_username": "qwe",
+			"version": 1
+		},
+		"message": "ok"
+	}`
+	svc := bidapi.New(logger, mockBidService)
+	mockBidService.On("RollbackBid", ctx, 2, 1, "qwe").Return(bid, nil)
+	router := gin.New()
+	router.Use(authenticatedAs("qwe"))
+	router.PUT("/api/bids/:bidId/rollback/:version", svc.RollbackBid(ctx))
+	req := httptest.NewRequest(http.MethodPut, "/api/bids/2/rollback/1", strings.NewReader(`{"username": "qwe"}`))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestRollbackBid_FailVersionNotFound проверяет, что
+// если у предложения нет указанной версии, то возвращается код 422.
+func TestRollbackBid_FailVersionNotFound(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockBidService := new(mocks.MockBidServiceProvider)
+	expectedBody := `
+	{
+		"rollback_bid": {
+			"id": 0,
+			"tender_id": 0,
+			"name": "",
+			"description": "",
+			"status": "",
+			"organization_id": 0,
+			"creator_username": "",
+			"version": 0
+		},
+		"message": "bid with id=<2> doesnt have version=<7>"
+	}`
+	svc := bidapi.New(logger, mockBidService)
+	mockBidService.On("RollbackBid", ctx, 2, 7, "qwe").Return(models.Bid{}, outerror.ErrBidVersionNotFound)
+	router := gin.New()
+	router.Use(authenticatedAs("qwe"))
+	router.PUT("/api/bids/:bidId/rollback/:version", svc.RollbackBid(ctx))
+	req := httptest.NewRequest(http.MethodPut, "/api/bids/2/rollback/7", strings.NewReader(`{"username": "qwe"}`))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestRollbackBid_FailInternalError проверяет, что
+// при внутренней ошибке возвращается код 500.
+func TestRollbackBid_FailInternalError(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockBidService := new(mocks.MockBidServiceProvider)
+	svc := bidapi.New(logger, mockBidService)
+	mockBidService.On("RollbackBid", ctx, 2, 1, "qwe").Return(models.Bid{}, errors.New("some err"))
+	router := gin.New()
+	router.Use(authenticatedAs("qwe"))
+	router.PUT("/api/bids/:bidId/rollback/:version", svc.RollbackBid(ctx))
+	req := httptest.NewRequest(http.MethodPut, "/api/bids/2/rollback/1", strings.NewReader(`{"username": "qwe"}`))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	require.Contains(t, w.Body.String(), "internal error")
+}
+
+// TestRollbackBid_FailTenderNotPublished проверяет, что
+// если тендер предложения не опубликован, то возвращается код 422.
+func TestRollbackBid_FailTenderNotPublished(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockBidService := new(mocks.MockBidServiceProvider)
+	expectedBody := `
+	{
+		"rollback_bid": {
+			"id": 0,
+			"tender_id": 0,
+			"name": "",
+			"description": "",
+			"status": "",
+			"organization_id": 0,
+			"creator_username": "",
+			"version": 0
+		},
+		"message": "tender of bid with id=<2> is not published"
+	}`
+	svc := bidapi.New(logger, mockBidService)
+	mockBidService.On("RollbackBid", ctx, 2, 1, "qwe").Return(models.Bid{}, outerror.ErrTenderNotPublished)
+	router := gin.New()
+	router.Use(authenticatedAs("qwe"))
+	router.PUT("/api/bids/:bidId/rollback/:version", svc.RollbackBid(ctx))
+	req := httptest.NewRequest(http.MethodPut, "/api/bids/2/rollback/1", strings.NewReader(`{"username": "qwe"}`))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
@@ -0,0 +1,170 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sariya23/tender/internal/domain/models"
+	bidapi "github.com/sariya23/tender/internal/hanlders/bid"
+	"github.com/sariya23/tender/internal/hanlders/bid/mocks"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEditBid_Success проверяет, что при успешном
+// обновлении возвращается код 200 и обновленное предложение.
+func TestEditBid_Success(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockBidService := new(mocks.MockBidServiceProvider)
+	newName := "zxc"
+	updatedBid := models.Bid{ID: 1, TenderId: 2, BidName: "zxc", Description: "qwe", Status: "CREATED", OrganizationId: 3, CreatorUsername: "qwe", Version: 2}
+	reqBody := `
+	{
+		"update_bid_data": {
+			"name": "zxc"
+		},
+		"username": "qwe"
+	}`
+	expectedBody := `
+	{
+		"updated_bid": {
+			"id": 1,
+			"tender_id": 2,
+			"name": "zxc",
+			"description": "qwe",
+			"status": "CREATED",
+			"organization_id": 3,
+			"creator_username": "qwe",
+			"version": 2
+		},
+		"message": "ok"
+	}`
+	svc := bidapi.New(logger, mockBidService)
+	mockBidService.On("EditBid", ctx, 1, models.BidToUpdate{BidName: &newName}, "qwe").Return(updatedBid, nil)
+	router := gin.New()
+	router.Use(authenticatedAs("qwe"))
+	router.PATCH("/api/bids/:bidId/edit", svc.EditBid(ctx))
+	req := httptest.NewRequest(http.MethodPatch, "/api/bids/1/edit", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestEditBid_FailNotCreator проверяет, что
+// если предложение обновляет не его создатель, то возвращается код 403.
+func TestEditBid_FailNotCreator(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockBidService := new(mocks.MockBidServiceProvider)
+	newName := "zxc"
+	reqBody := `
+	{
+		"update_bid_data": {
+			"name": "zxc"
+		},
+		"username": "asd"
+	}`
+	expectedBody := `
+	{
+		"updated_bid": {
+			"id": 0,
+			"tender_id": 0,
+			"name": "",
+			"description": "",
+			"status": "",
+			"organization_id": 0,
+			"creator_username": "",
+			"version": 0
+		},
+		"message": "employee with username=<asd> not creator of bid with id=<1>"
+	}`
+	svc := bidapi.New(logger, mockBidService)
+	mockBidService.On("EditBid", ctx, 1, models.BidToUpdate{BidName: &newName}, "asd").Return(models.Bid{}, outerror.ErrEmployeeNotResponsibleForBid)
+	router := gin.New()
+	router.Use(authenticatedAs("asd"))
+	router.PATCH("/api/bids/:bidId/edit", svc.EditBid(ctx))
+	req := httptest.NewRequest(http.MethodPatch, "/api/bids/1/edit", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestEditBid_FailVersionConflict проверяет, что если предложение
+// изменили параллельно, то возвращается код 409.
+func TestEditBid_FailVersionConflict(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockBidService := new(mocks.MockBidServiceProvider)
+	newName := "zxc"
+	reqBody := `
+	{
+		"update_bid_data": {
+			"name": "zxc"
+		},
+		"username": "qwe"
+	}`
+	svc := bidapi.New(logger, mockBidService)
+	mockBidService.On("EditBid", ctx, 1, models.BidToUpdate{BidName: &newName}, "qwe").Return(models.Bid{}, outerror.ErrBidVersionConflict)
+	router := gin.New()
+	router.Use(authenticatedAs("qwe"))
+	router.PATCH("/api/bids/:bidId/edit", svc.EditBid(ctx))
+	req := httptest.NewRequest(http.MethodPatch, "/api/bids/1/edit", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusConflict, w.Code)
+	require.Contains(t, w.Body.String(), "was changed by someone else")
+}
+
+// TestEditBid_FailBidIdIsNotInt проверяет, что
+// если bidId не число, то возвращается код 404.
+func TestEditBid_FailBidIdIsNotInt(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockBidService := new(mocks.MockBidServiceProvider)
+	svc := bidapi.New(logger, mockBidService)
+	router := gin.New()
+	router.Use(authenticatedAs("qwe"))
+	router.PATCH("/api/bids/:bidId/edit", svc.EditBid(ctx))
+	req := httptest.NewRequest(http.MethodPatch, "/api/bids/qwe/edit", strings.NewReader(`{"username": "qwe"}`))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	require.Contains(t, w.Body.String(), "cannot convert bidId to integer")
+}
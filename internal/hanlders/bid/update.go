@@ -0,0 +1,187 @@
+package bidapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/sariya23/tender/internal/domain/models"
+	schema "github.com/sariya23/tender/internal/hanlders"
+	"github.com/sariya23/tender/internal/lib/unmarshal"
+	"github.com/sariya23/tender/internal/middleware"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+func (bidSrv *BidService) EditBid(ctx context.Context) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		const operationPlace = "internal.api.bidapi.EditBid"
+		logger := bidSrv.logger.With("op", operationPlace)
+		logger.Info(fmt.Sprintf("request to %v", ginContext.Request.URL.Path))
+
+		bidId := ginContext.Param("bidId")
+		convertedBidId, err := strconv.Atoi(bidId)
+		if err != nil {
+			logger.Error(
+				"cannot convert bid id to int",
+				slog.String("bid id", bidId),
+				slog.String("err", err.Error()),
+			)
+			ginContext.JSON(http.StatusNotFound, schema.EditBidResponse{Message: "cannot convert bidId to integer", UpdatedBid: models.Bid{}})
+			return
+		}
+		if convertedBidId < 0 {
+			logger.Error("bid id is not positive integer", slog.String("bid id", bidId))
+			ginContext.JSON(http.StatusNotFound, schema.EditBidResponse{Message: "bid id must be positive integer", UpdatedBid: models.Bid{}})
+			return
+		}
+
+		body := ginContext.Request.Body
+		defer func() {
+			err := body.Close()
+			if err != nil {
+				logger.Error("cannot close body", slog.String("err", err.Error()))
+			}
+		}()
+
+		bodyData, err := io.ReadAll(body)
+		if err != nil {
+			logger.Error("cannot read body", slog.String("err", err.Error()))
+			ginContext.JSON(http.StatusInternalServerError, schema.EditBidResponse{Message: "internal error", UpdatedBid: models.Bid{}})
+			return
+		}
+		logger.Info("success read body")
+
+		editReq, err := unmarshal.EditBidRequest(bodyData)
+		if err != nil {
+			if errors.Is(err, unmarshal.ErrSyntax) {
+				logger.Warn("req syntax error", slog.String("err", err.Error()))
+				ginContext.JSON(
+					http.StatusBadRequest,
+					schema.EditBidResponse{
+						Message:    fmt.Sprintf("json syntax err: %s", err.Error()),
+						UpdatedBid: models.Bid{},
+					},
+				)
+				return
+			} else if errors.Is(err, unmarshal.ErrType) {
+				logger.Warn("req type error", slog.String("err", err.Error()))
+				ginContext.JSON(
+					http.StatusBadRequest,
+					schema.EditBidResponse{
+						Message:    fmt.Sprintf("json type err: %s", err.Error()),
+						UpdatedBid: models.Bid{},
+					},
+				)
+				return
+			} else {
+				logger.Error("unexpected error", slog.String("err", err.Error()))
+				ginContext.JSON(http.StatusInternalServerError, schema.EditBidResponse{Message: "internal error", UpdatedBid: models.Bid{}})
+				return
+			}
+		}
+		logger.Info("success unmarshal request")
+
+		editReq.Username, err = middleware.ActingUsername(ginContext, editReq.Username)
+		if err != nil {
+			logger.Warn("cannot resolve acting employee", slog.String("err", err.Error()))
+			ginContext.JSON(middleware.AuthErrorStatus(err), schema.EditBidResponse{Message: err.Error(), UpdatedBid: models.Bid{}})
+			return
+		}
+
+		validate := validator.New(validator.WithRequiredStructEnabled())
+		err = validate.Struct(&editReq)
+		if err != nil {
+			logger.Error("validation error", slog.String("err", err.Error()))
+			ginContext.JSON(
+				http.StatusBadRequest,
+				schema.EditBidResponse{
+					Message:    fmt.Sprintf("validation failed: %s", err.Error()),
+					UpdatedBid: models.Bid{},
+				},
+			)
+			return
+		}
+		logger.Info("validate success")
+
+		bid, err := bidSrv.bidService.EditBid(ctx, convertedBidId, editReq.UpdateBidData, editReq.Username)
+		if err != nil {
+			if errors.Is(err, outerror.ErrNothingToUpdate) {
+				logger.Warn("nothing to update")
+				ginContext.JSON(http.StatusBadRequest, schema.EditBidResponse{Message: "nothing to update", UpdatedBid: models.Bid{}})
+				return
+			} else if errors.Is(err, outerror.ErrUnknownBidStatus) {
+				logger.Warn(fmt.Sprintf("bid status <%s> is unknown", *editReq.UpdateBidData.Status))
+				ginContext.JSON(
+					http.StatusBadRequest,
+					schema.EditBidResponse{
+						Message:    fmt.Sprintf("bid status=<%s> is unknown", *editReq.UpdateBidData.Status),
+						UpdatedBid: models.Bid{},
+					},
+				)
+				return
+			} else if errors.Is(err, outerror.ErrCannotSetThisBidStatus) {
+				logger.Warn("cannot set this bid status")
+				ginContext.JSON(
+					http.StatusBadRequest,
+					schema.EditBidResponse{
+						Message:    "cannot set this bid status. Cannot set bid status from PUBLISHED to CREATED and from CANCELED to any other",
+						UpdatedBid: models.Bid{},
+					},
+				)
+				return
+			} else if errors.Is(err, outerror.ErrBidNotFound) {
+				logger.Warn(fmt.Sprintf("bid with id=<%d> not found", convertedBidId))
+				ginContext.JSON(
+					http.StatusUnprocessableEntity,
+					schema.EditBidResponse{
+						Message:    fmt.Sprintf("bid with id=<%d> not found", convertedBidId),
+						UpdatedBid: models.Bid{},
+					},
+				)
+				return
+			} else if errors.Is(err, outerror.ErrEmployeeNotResponsibleForBid) {
+				logger.Warn("employee not creator of bid")
+				ginContext.JSON(
+					http.StatusForbidden,
+					schema.EditBidResponse{
+						Message:    fmt.Sprintf("employee with username=<%s> not creator of bid with id=<%d>", editReq.Username, convertedBidId),
+						UpdatedBid: models.Bid{},
+					},
+				)
+				return
+			} else if errors.Is(err, outerror.ErrTenderNotPublished) {
+				logger.Warn("bid tender not published")
+				ginContext.JSON(
+					http.StatusUnprocessableEntity,
+					schema.EditBidResponse{
+						Message:    fmt.Sprintf("tender of bid with id=<%d> is not published", convertedBidId),
+						UpdatedBid: models.Bid{},
+					},
+				)
+				return
+			} else if errors.Is(err, outerror.ErrBidVersionConflict) {
+				logger.Warn("bid was changed concurrently")
+				ginContext.JSON(
+					http.StatusConflict,
+					schema.EditBidResponse{
+						Message:    fmt.Sprintf("bid with id=<%d> was changed by someone else, retry the request", convertedBidId),
+						UpdatedBid: models.Bid{},
+					},
+				)
+				return
+			} else {
+				logger.Error("unexpected error", slog.String("err", err.Error()))
+				ginContext.JSON(http.StatusInternalServerError, schema.EditBidResponse{Message: "internal error", UpdatedBid: models.Bid{}})
+				return
+			}
+		}
+		logger.Info("bid updated success")
+		ginContext.JSON(http.StatusOK, schema.EditBidResponse{Message: "ok", UpdatedBid: bid})
+	}
+}
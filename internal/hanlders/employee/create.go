@@ -0,0 +1,106 @@
+package employeeapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/sariya23/tender/internal/domain/models"
+	schema "github.com/sariya23/tender/internal/hanlders"
+	"github.com/sariya23/tender/internal/lib/unmarshal"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+func (employeeSrv *EmployeeService) CreateEmployee(ctx context.Context) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		const operationPlace = "internal.api.employeeapi.CreateEmployee"
+		logger := employeeSrv.logger.With("op", operationPlace)
+		logger.Info(fmt.Sprintf("request to %v", ginContext.Request.URL))
+
+		body := ginContext.Request.Body
+		defer func() {
+			err := body.Close()
+			if err != nil {
+				logger.Error("cannot close body", slog.String("err", err.Error()))
+			}
+		}()
+
+		bodyData, err := io.ReadAll(body)
+		if err != nil {
+			logger.Error("cannot read body", slog.String("err", err.Error()))
+			ginContext.JSON(http.StatusInternalServerError, schema.CreateEmployeeResponse{Message: "internal error", Employee: models.Employee{}})
+			return
+		}
+		logger.Info("success read body")
+		createReq, err := unmarshal.CreateEmployeeRequest(bodyData)
+		if err != nil {
+			if errors.Is(err, unmarshal.ErrSyntax) {
+				logger.Warn("req syntax error", slog.String("err", err.Error()))
+				ginContext.JSON(
+					http.StatusBadRequest,
+					schema.CreateEmployeeResponse{
+						Message:  fmt.Sprintf("json syntax err: %s", err.Error()),
+						Employee: models.Employee{},
+					},
+				)
+				return
+			} else if errors.Is(err, unmarshal.ErrType) {
+				logger.Warn("req type error", slog.String("err", err.Error()))
+				ginContext.JSON(
+					http.StatusBadRequest,
+					schema.CreateEmployeeResponse{
+						Message:  fmt.Sprintf("json type err: %s", err.Error()),
+						Employee: models.Employee{},
+					},
+				)
+				return
+			} else {
+				logger.Error("unexpected error", slog.String("err", err.Error()))
+				ginContext.JSON(http.StatusInternalServerError, schema.CreateEmployeeResponse{Message: "internal error", Employee: models.Employee{}})
+				return
+			}
+		}
+		logger.Info("success unmarshal request")
+
+		validate := validator.New(validator.WithRequiredStructEnabled())
+		err = validate.Struct(&createReq)
+		if err != nil {
+			logger.Error("validation error", slog.String("err", err.Error()))
+			ginContext.JSON(
+				http.StatusBadRequest,
+				schema.CreateEmployeeResponse{
+					Message:  fmt.Sprintf("validation failed: %s", err.Error()),
+					Employee: models.Employee{},
+				},
+			)
+			return
+		}
+		logger.Info("validate success")
+
+		employee, err := employeeSrv.employeeService.CreateEmployee(ctx, createReq.Employee)
+		if err != nil {
+			if errors.Is(err, outerror.ErrEmployeeAlreadyExists) {
+				logger.Warn(fmt.Sprintf("employee with username=<%s> already exists", createReq.Employee.Username))
+				ginContext.JSON(
+					http.StatusConflict,
+					schema.CreateEmployeeResponse{
+						Message:  fmt.Sprintf("employee with username=<%s> already exists", createReq.Employee.Username),
+						Employee: models.Employee{},
+					},
+				)
+				return
+			} else {
+				logger.Error("unexpected error", slog.String("err", err.Error()))
+				ginContext.JSON(http.StatusInternalServerError, schema.CreateEmployeeResponse{Message: "internal error", Employee: models.Employee{}})
+				return
+			}
+		}
+		logger.Info("employee created success")
+		ginContext.JSON(http.StatusOK, schema.CreateEmployeeResponse{Message: "ok", Employee: employee})
+	}
+}
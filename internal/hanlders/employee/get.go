@@ -0,0 +1,113 @@
+package employeeapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sariya23/tender/internal/domain/models"
+	schema "github.com/sariya23/tender/internal/hanlders"
+	"github.com/sariya23/tender/internal/lib/pagequery"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+func (employeeSrv *EmployeeService) GetEmployee(ctx context.Context) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		const operationPlace = "internal.api.employeeapi.GetEmployee"
+		logger := employeeSrv.logger.With("op", operationPlace)
+		logger.Info(fmt.Sprintf("request to %v", ginContext.Request.URL))
+
+		employeeId, err := employeeIdParam(ginContext)
+		if err != nil {
+			logger.Warn("invalid employee id", slog.String("err", err.Error()))
+			ginContext.JSON(http.StatusNotFound, schema.GetEmployeeResponse{Message: err.Error(), Employee: models.Employee{}})
+			return
+		}
+
+		employee, err := employeeSrv.employeeService.GetEmployee(ctx, employeeId)
+		if err != nil {
+			if errors.Is(err, outerror.ErrEmployeeNotFound) {
+				logger.Warn(fmt.Sprintf("employee with id=<%d> not found", employeeId))
+				ginContext.JSON(
+					http.StatusNotFound,
+					schema.GetEmployeeResponse{
+						Message:  fmt.Sprintf("employee with id=<%d> not found", employeeId),
+						Employee: models.Employee{},
+					},
+				)
+				return
+			} else {
+				logger.Error("unexpected error", slog.String("err", err.Error()))
+				ginContext.JSON(http.StatusInternalServerError, schema.GetEmployeeResponse{Message: "internal error", Employee: models.Employee{}})
+				return
+			}
+		}
+		logger.Info("send success response")
+		ginContext.JSON(http.StatusOK, schema.GetEmployeeResponse{Message: "ok", Employee: employee})
+	}
+}
+
+func (employeeSrv *EmployeeService) GetEmployeeByUsername(ctx context.Context) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		const operationPlace = "internal.api.employeeapi.GetEmployeeByUsername"
+		logger := employeeSrv.logger.With("op", operationPlace)
+		logger.Info(fmt.Sprintf("request to %v", ginContext.Request.URL))
+
+		username := ginContext.Param("username")
+		employee, err := employeeSrv.employeeService.GetEmployeeByUsername(ctx, username)
+		if err != nil {
+			if errors.Is(err, outerror.ErrEmployeeNotFound) {
+				logger.Warn(fmt.Sprintf("employee with username=<%s> not found", username))
+				ginContext.JSON(
+					http.StatusNotFound,
+					schema.GetEmployeeResponse{
+						Message:  fmt.Sprintf("employee with username=<%s> not found", username),
+						Employee: models.Employee{},
+					},
+				)
+				return
+			} else {
+				logger.Error("unexpected error", slog.String("err", err.Error()))
+				ginContext.JSON(http.StatusInternalServerError, schema.GetEmployeeResponse{Message: "internal error", Employee: models.Employee{}})
+				return
+			}
+		}
+		logger.Info("send success response")
+		ginContext.JSON(http.StatusOK, schema.GetEmployeeResponse{Message: "ok", Employee: employee})
+	}
+}
+
+func (employeeSrv *EmployeeService) GetEmployees(ctx context.Context) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		const operationPlace = "internal.api.employeeapi.GetEmployees"
+		logger := employeeSrv.logger.With("op", operationPlace)
+		logger.Info(fmt.Sprintf("request to %v", ginContext.Request.URL))
+
+		page, err := pagequery.Parse(ginContext)
+		if err != nil {
+			logger.Warn("invalid page", slog.String("err", err.Error()))
+			ginContext.JSON(http.StatusBadRequest, schema.GetEmployeesResponse{Message: err.Error(), Employees: []models.Employee{}})
+			return
+		}
+
+		employees, err := employeeSrv.employeeService.GetEmployees(ctx, page)
+		if err != nil {
+			logger.Error("unexpected error", slog.String("err", err.Error()))
+			ginContext.JSON(http.StatusInternalServerError, schema.GetEmployeesResponse{Message: "internal error", Employees: []models.Employee{}})
+			return
+		}
+		logger.Info("send success response")
+		ginContext.JSON(
+			http.StatusOK,
+			schema.GetEmployeesResponse{
+				Message:    "ok",
+				Employees:  employees.Employees,
+				Total:      employees.Total,
+				NextCursor: employees.NextCursor,
+			},
+		)
+	}
+}
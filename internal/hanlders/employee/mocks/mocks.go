@@ -0,0 +1,50 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockEmployeeServiceProvider реализует интерфейс EmployeeServiceProvider
+// для целей тестирования. Он позволяет задавать ожидаемые результаты
+// методов:
+//
+// - CreateEmployee
+//
+// - GetEmployee
+//
+// - GetEmployeeByUsername
+//
+// - GetEmployees
+//
+// - EditEmployee
+type MockEmployeeServiceProvider struct {
+	mock.Mock
+}
+
+func (m *MockEmployeeServiceProvider) CreateEmployee(ctx context.Context, employee models.Employee) (models.Employee, error) {
+	args := m.Called(ctx, employee)
+	return args.Get(0).(models.Employee), args.Error(1)
+}
+
+func (m *MockEmployeeServiceProvider) GetEmployee(ctx context.Context, employeeId int) (models.Employee, error) {
+	args := m.Called(ctx, employeeId)
+	return args.Get(0).(models.Employee), args.Error(1)
+}
+
+func (m *MockEmployeeServiceProvider) GetEmployeeByUsername(ctx context.Context, username string) (models.Employee, error) {
+	args := m.Called(ctx, username)
+	return args.Get(0).(models.Employee), args.Error(1)
+}
+
+func (m *MockEmployeeServiceProvider) GetEmployees(ctx context.Context, page models.Page) (models.EmployeePage, error) {
+	args := m.Called(ctx, page)
+	return args.Get(0).(models.EmployeePage), args.Error(1)
+}
+
+func (m *MockEmployeeServiceProvider) EditEmployee(ctx context.Context, employeeId int, updateEmployee models.EmployeeToUpdate) (models.Employee, error) {
+	args := m.Called(ctx, employeeId, updateEmployee)
+	return args.Get(0).(models.Employee), args.Error(1)
+}
@@ -0,0 +1,48 @@
+package employeeapi
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sariya23/tender/internal/domain/models"
+)
+
+type EmployeeServiceProvider interface {
+	CreateEmployee(ctx context.Context, employee models.Employee) (models.Employee, error)
+	GetEmployee(ctx context.Context, employeeId int) (models.Employee, error)
+	GetEmployeeByUsername(ctx context.Context, username string) (models.Employee, error)
+	GetEmployees(ctx context.Context, page models.Page) (models.EmployeePage, error)
+	EditEmployee(ctx context.Context, employeeId int, updateEmployee models.EmployeeToUpdate) (models.Employee, error)
+}
+
+type EmployeeService struct {
+	logger          *slog.Logger
+	employeeService EmployeeServiceProvider
+}
+
+func New(logger *slog.Logger, employeeService EmployeeServiceProvider) *EmployeeService {
+	return &EmployeeService{
+		logger:          logger,
+		employeeService: employeeService,
+	}
+}
+
+var (
+	errEmployeeIdNotInteger  = errors.New("cannot convert employee id to integer")
+	errEmployeeIdNotPositive = errors.New("employee id must be positive integer")
+)
+
+// employeeIdParam читает id сотрудника из параметра пути employeeId.
+func employeeIdParam(ginContext *gin.Context) (int, error) {
+	employeeId, err := strconv.Atoi(ginContext.Param("employeeId"))
+	if err != nil {
+		return 0, errEmployeeIdNotInteger
+	}
+	if employeeId <= 0 {
+		return 0, errEmployeeIdNotPositive
+	}
+	return employeeId, nil
+}
@@ -0,0 +1,132 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	employeeapi "github.com/sariya23/tender/internal/hanlders/employee"
+	"github.com/sariya23/tender/internal/hanlders/employee/mocks"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCreateEmployee_Success проверяет успешное
+// создание сотрудника.
+//
+// Возвращается код 200 и созданный сотрудник.
+func TestCreateEmployee_Success(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockEmployeeService := new(mocks.MockEmployeeServiceProvider)
+	createdAt := time.Date(2024, 12, 21, 10, 0, 0, 0, time.UTC)
+	employeeToCreate := models.Employee{Username: "qwe", FirstName: "Ivan", LastName: "Ivanov"}
+	createdEmployee := models.Employee{ID: 1, Username: "qwe", FirstName: "Ivan", LastName: "Ivanov", CreatedAt: createdAt, UpdatedAt: createdAt}
+	reqBody := `{"employee": {"username": "qwe", "first_name": "Ivan", "last_name": "Ivanov"}}`
+	expectedBody := `
+	{
+		"employee": {
+			"id": 1,
+			"username": "qwe",
+			"first_name": "Ivan",
+			"last_name": "Ivanov",
+			"created_at": "2024-12-21T10:00:00Z",
+			"updated_at": "2024-12-21T10:00:00Z"
+		},
+		"message": "ok"
+	}`
+	svc := employeeapi.New(logger, mockEmployeeService)
+
+	mockEmployeeService.On("CreateEmployee", ctx, employeeToCreate).Return(createdEmployee, nil)
+	router := gin.New()
+	router.POST("/api/employees/new", svc.CreateEmployee(ctx))
+	req := httptest.NewRequest(http.MethodPost, "/api/employees/new", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestCreateEmployee_FailValidation проверяет, что
+// сотрудник без username не создается.
+//
+// Возвращается код 400.
+func TestCreateEmployee_FailValidation(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockEmployeeService := new(mocks.MockEmployeeServiceProvider)
+	reqBody := `{"employee": {"first_name": "Ivan"}}`
+	svc := employeeapi.New(logger, mockEmployeeService)
+
+	router := gin.New()
+	router.POST("/api/employees/new", svc.CreateEmployee(ctx))
+	req := httptest.NewRequest(http.MethodPost, "/api/employees/new", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	require.Contains(t, w.Body.String(), "validation failed")
+	mockEmployeeService.AssertNotCalled(t, "CreateEmployee")
+}
+
+// TestCreateEmployee_FailAlreadyExists проверяет, что
+// нельзя создать сотрудника с занятым username.
+//
+// Возвращается код 409.
+func TestCreateEmployee_FailAlreadyExists(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockEmployeeService := new(mocks.MockEmployeeServiceProvider)
+	employeeToCreate := models.Employee{Username: "qwe"}
+	reqBody := `{"employee": {"username": "qwe"}}`
+	expectedBody := `
+	{
+		"employee": {
+			"id": 0,
+			"username": "",
+			"first_name": "",
+			"last_name": "",
+			"created_at": "0001-01-01T00:00:00Z",
+			"updated_at": "0001-01-01T00:00:00Z"
+		},
+		"message": "employee with username=<qwe> already exists"
+	}`
+	svc := employeeapi.New(logger, mockEmployeeService)
+
+	mockEmployeeService.On("CreateEmployee", ctx, employeeToCreate).Return(models.Employee{}, outerror.ErrEmployeeAlreadyExists)
+	router := gin.New()
+	router.POST("/api/employees/new", svc.CreateEmployee(ctx))
+	req := httptest.NewRequest(http.MethodPost, "/api/employees/new", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusConflict, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
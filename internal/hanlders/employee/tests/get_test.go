@@ -0,0 +1,223 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	employeeapi "github.com/sariya23/tender/internal/hanlders/employee"
+	"github.com/sariya23/tender/internal/hanlders/employee/mocks"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetEmployee_Success проверяет, что
+// сотрудник возвращается по id.
+//
+// Возвращается код 200.
+func TestGetEmployee_Success(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockEmployeeService := new(mocks.MockEmployeeServiceProvider)
+	employee := models.Employee{ID: 3, Username: "qwe"}
+	expectedBody := `
+	{
+		"employee": {
+			"id": 3,
+			"username": "qwe",
+			"first_name": "",
+			"last_name": "",
+			"created_at": "0001-01-01T00:00:00Z",
+			"updated_at": "0001-01-01T00:00:00Z"
+		},
+		"message": "ok"
+	}`
+	svc := employeeapi.New(logger, mockEmployeeService)
+
+	mockEmployeeService.On("GetEmployee", ctx, 3).Return(employee, nil)
+	router := gin.New()
+	router.GET("/api/employees/:employeeId", svc.GetEmployee(ctx))
+	req := httptest.NewRequest(http.MethodGet, "/api/employees/3", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestGetEmployee_FailNotFound проверяет, что
+// для несуществующей или некорректной id сотрудника
+// возвращается код 404.
+func TestGetEmployee_FailNotFound(t *testing.T) {
+	cases := []struct {
+		name       string
+		employeeId string
+		message    string
+	}{
+		{name: "not integer", employeeId: "qwe", message: "cannot convert employee id to integer"},
+		{name: "not positive", employeeId: "-1", message: "employee id must be positive integer"},
+		{name: "not found", employeeId: "5", message: "employee with id=\\u003c5\\u003e not found"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			gin.SetMode(gin.TestMode)
+			ctx := context.Background()
+
+			logger := slogdiscard.NewDiscardLogger()
+			mockEmployeeService := new(mocks.MockEmployeeServiceProvider)
+			expectedBody := `
+			{
+				"employee": {
+					"id": 0,
+					"username": "",
+					"first_name": "",
+					"last_name": "",
+					"created_at": "0001-01-01T00:00:00Z",
+					"updated_at": "0001-01-01T00:00:00Z"
+				},
+				"message": "` + tc.message + `"
+			}`
+			svc := employeeapi.New(logger, mockEmployeeService)
+
+			mockEmployeeService.On("GetEmployee", ctx, 5).Return(models.Employee{}, outerror.ErrEmployeeNotFound)
+			router := gin.New()
+			router.GET("/api/employees/:employeeId", svc.GetEmployee(ctx))
+			req := httptest.NewRequest(http.MethodGet, "/api/employees/"+tc.employeeId, nil)
+			w := httptest.NewRecorder()
+
+			// Act
+			router.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, http.StatusNotFound, w.Code)
+			require.JSONEq(t, expectedBody, w.Body.String())
+		})
+	}
+}
+
+// TestGetEmployeeByUsername_Success проверяет, что
+// сотрудник возвращается по username.
+//
+// Возвращается код 200.
+func TestGetEmployeeByUsername_Success(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockEmployeeService := new(mocks.MockEmployeeServiceProvider)
+	employee := models.Employee{ID: 3, Username: "qwe", LastName: "Ivanov"}
+	expectedBody := `
+	{
+		"employee": {
+			"id": 3,
+			"username": "qwe",
+			"first_name": "",
+			"last_name": "Ivanov",
+			"created_at": "0001-01-01T00:00:00Z",
+			"updated_at": "0001-01-01T00:00:00Z"
+		},
+		"message": "ok"
+	}`
+	svc := employeeapi.New(logger, mockEmployeeService)
+
+	mockEmployeeService.On("GetEmployeeByUsername", ctx, "qwe").Return(employee, nil)
+	router := gin.New()
+	router.GET("/api/employees/by-username/:username", svc.GetEmployeeByUsername(ctx))
+	req := httptest.NewRequest(http.MethodGet, "/api/employees/by-username/qwe", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestGetEmployeeByUsername_FailNotFound проверяет, что
+// если сотрудника с таким username нет, то возвращается код 404.
+func TestGetEmployeeByUsername_FailNotFound(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockEmployeeService := new(mocks.MockEmployeeServiceProvider)
+	svc := employeeapi.New(logger, mockEmployeeService)
+
+	mockEmployeeService.On("GetEmployeeByUsername", ctx, "qwe").Return(models.Employee{}, outerror.ErrEmployeeNotFound)
+	router := gin.New()
+	router.GET("/api/employees/by-username/:username", svc.GetEmployeeByUsername(ctx))
+	req := httptest.NewRequest(http.MethodGet, "/api/employees/by-username/qwe", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	require.Contains(t, w.Body.String(), "not found")
+}
+
+// TestGetEmployees_Success проверяет, что
+// возвращается страница сотрудников.
+//
+// Возвращается код 200.
+func TestGetEmployees_Success(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockEmployeeService := new(mocks.MockEmployeeServiceProvider)
+	nextCursor := 1
+	employees := models.EmployeePage{
+		Employees:  []models.Employee{{ID: 1, Username: "qwe"}},
+		Total:      2,
+		NextCursor: &nextCursor,
+	}
+	expectedBody := `
+	{
+		"employees": [
+			{
+				"id": 1,
+				"username": "qwe",
+				"first_name": "",
+				"last_name": "",
+				"created_at": "0001-01-01T00:00:00Z",
+				"updated_at": "0001-01-01T00:00:00Z"
+			}
+		],
+		"total": 2,
+		"next_cursor": 1,
+		"message": "ok"
+	}`
+	svc := employeeapi.New(logger, mockEmployeeService)
+
+	mockEmployeeService.On("GetEmployees", ctx, models.Page{Limit: 1}).Return(employees, nil)
+	router := gin.New()
+	router.GET("/api/employees/", svc.GetEmployees(ctx))
+	req := httptest.NewRequest(http.MethodGet, "/api/employees/?limit=1", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
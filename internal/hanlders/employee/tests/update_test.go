@@ -0,0 +1,130 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	employeeapi "github.com/sariya23/tender/internal/hanlders/employee"
+	"github.com/sariya23/tender/internal/hanlders/employee/mocks"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEditEmployee_Success проверяет успешное
+// обновление сотрудника.
+//
+// Возвращается код 200 и обновленный сотрудник.
+func TestEditEmployee_Success(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockEmployeeService := new(mocks.MockEmployeeServiceProvider)
+	newUsername := "new"
+	updateEmployee := models.EmployeeToUpdate{Username: &newUsername}
+	updatedEmployee := models.Employee{ID: 1, Username: newUsername}
+	reqBody := `{"update_employee_data": {"username": "new"}}`
+	expectedBody := `
+	{
+		"updated_employee": {
+			"id": 1,
+			"username": "new",
+			"first_name": "",
+			"last_name": "",
+			"created_at": "0001-01-01T00:00:00Z",
+			"updated_at": "0001-01-01T00:00:00Z"
+		},
+		"message": "ok"
+	}`
+	svc := employeeapi.New(logger, mockEmployeeService)
+
+	mockEmployeeService.On("EditEmployee", ctx, 1, updateEmployee).Return(updatedEmployee, nil)
+	router := gin.New()
+	router.PATCH("/api/employees/:employeeId/edit", svc.EditEmployee(ctx))
+	req := httptest.NewRequest(http.MethodPatch, "/api/employees/1/edit", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestEditEmployee_FailUsernameTaken проверяет, что
+// нельзя сменить username на занятый.
+//
+// Возвращается код 409.
+func TestEditEmployee_FailUsernameTaken(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockEmployeeService := new(mocks.MockEmployeeServiceProvider)
+	newUsername := "taken"
+	updateEmployee := models.EmployeeToUpdate{Username: &newUsername}
+	reqBody := `{"update_employee_data": {"username": "taken"}}`
+	expectedBody := `
+	{
+		"updated_employee": {
+			"id": 0,
+			"username": "",
+			"first_name": "",
+			"last_name": "",
+			"created_at": "0001-01-01T00:00:00Z",
+			"updated_at": "0001-01-01T00:00:00Z"
+		},
+		"message": "employee with username=<taken> already exists"
+	}`
+	svc := employeeapi.New(logger, mockEmployeeService)
+
+	mockEmployeeService.On("EditEmployee", ctx, 1, updateEmployee).Return(models.Employee{}, outerror.ErrEmployeeAlreadyExists)
+	router := gin.New()
+	router.PATCH("/api/employees/:employeeId/edit", svc.EditEmployee(ctx))
+	req := httptest.NewRequest(http.MethodPatch, "/api/employees/1/edit", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusConflict, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestEditEmployee_FailNothingToUpdate проверяет, что
+// если нечего обновлять, то возвращается код 400.
+func TestEditEmployee_FailNothingToUpdate(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockEmployeeService := new(mocks.MockEmployeeServiceProvider)
+	reqBody := `{"update_employee_data": {}}`
+	svc := employeeapi.New(logger, mockEmployeeService)
+
+	mockEmployeeService.On("EditEmployee", ctx, 1, models.EmployeeToUpdate{}).Return(models.Employee{}, outerror.ErrNothingToUpdate)
+	router := gin.New()
+	router.PATCH("/api/employees/:employeeId/edit", svc.EditEmployee(ctx))
+	req := httptest.NewRequest(http.MethodPatch, "/api/employees/1/edit", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	require.Contains(t, w.Body.String(), "nothing to update")
+}
@@ -0,0 +1,127 @@
+package employeeapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/sariya23/tender/internal/domain/models"
+	schema "github.com/sariya23/tender/internal/hanlders"
+	"github.com/sariya23/tender/internal/lib/unmarshal"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+func (employeeSrv *EmployeeService) EditEmployee(ctx context.Context) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		const operationPlace = "internal.api.employeeapi.EditEmployee"
+		logger := employeeSrv.logger.With("op", operationPlace)
+		logger.Info(fmt.Sprintf("request to %v", ginContext.Request.URL))
+
+		employeeId, err := employeeIdParam(ginContext)
+		if err != nil {
+			logger.Warn("invalid employee id", slog.String("err", err.Error()))
+			ginContext.JSON(http.StatusNotFound, schema.EditEmployeeResponse{Message: err.Error(), UpdatedEmployee: models.Employee{}})
+			return
+		}
+
+		body := ginContext.Request.Body
+		defer func() {
+			err := body.Close()
+			if err != nil {
+				logger.Error("cannot close body", slog.String("err", err.Error()))
+			}
+		}()
+
+		bodyData, err := io.ReadAll(body)
+		if err != nil {
+			logger.Error("cannot read body", slog.String("err", err.Error()))
+			ginContext.JSON(http.StatusInternalServerError, schema.EditEmployeeResponse{Message: "internal error", UpdatedEmployee: models.Employee{}})
+			return
+		}
+		logger.Info("success read body")
+		editReq, err := unmarshal.EditEmployeeRequest(bodyData)
+		if err != nil {
+			if errors.Is(err, unmarshal.ErrSyntax) {
+				logger.Warn("req syntax error", slog.String("err", err.Error()))
+				ginContext.JSON(
+					http.StatusBadRequest,
+					schema.EditEmployeeResponse{
+						Message:         fmt.Sprintf("json syntax err: %s", err.Error()),
+						UpdatedEmployee: models.Employee{},
+					},
+				)
+				return
+			} else if errors.Is(err, unmarshal.ErrType) {
+				logger.Warn("req type error", slog.String("err", err.Error()))
+				ginContext.JSON(
+					http.StatusBadRequest,
+					schema.EditEmployeeResponse{
+						Message:         fmt.Sprintf("json type err: %s", err.Error()),
+						UpdatedEmployee: models.Employee{},
+					},
+				)
+				return
+			} else {
+				logger.Error("unexpected error", slog.String("err", err.Error()))
+				ginContext.JSON(http.StatusInternalServerError, schema.EditEmployeeResponse{Message: "internal error", UpdatedEmployee: models.Employee{}})
+				return
+			}
+		}
+		logger.Info("success unmarshal request")
+
+		validate := validator.New(validator.WithRequiredStructEnabled())
+		err = validate.Struct(&editReq)
+		if err != nil {
+			logger.Error("validation error", slog.String("err", err.Error()))
+			ginContext.JSON(
+				http.StatusBadRequest,
+				schema.EditEmployeeResponse{
+					Message:         fmt.Sprintf("validation failed: %s", err.Error()),
+					UpdatedEmployee: models.Employee{},
+				},
+			)
+			return
+		}
+		logger.Info("validate success")
+
+		employee, err := employeeSrv.employeeService.EditEmployee(ctx, employeeId, editReq.UpdateEmployeeData)
+		if err != nil {
+			if errors.Is(err, outerror.ErrNothingToUpdate) {
+				logger.Warn("nothing to update")
+				ginContext.JSON(http.StatusBadRequest, schema.EditEmployeeResponse{Message: "nothing to update", UpdatedEmployee: models.Employee{}})
+				return
+			} else if errors.Is(err, outerror.ErrEmployeeAlreadyExists) {
+				logger.Warn(fmt.Sprintf("employee with username=<%s> already exists", *editReq.UpdateEmployeeData.Username))
+				ginContext.JSON(
+					http.StatusConflict,
+					schema.EditEmployeeResponse{
+						Message:         fmt.Sprintf("employee with username=<%s> already exists", *editReq.UpdateEmployeeData.Username),
+						UpdatedEmployee: models.Employee{},
+					},
+				)
+				return
+			} else if errors.Is(err, outerror.ErrEmployeeNotFound) {
+				logger.Warn(fmt.Sprintf("employee with id=<%d> not found", employeeId))
+				ginContext.JSON(
+					http.StatusNotFound,
+					schema.EditEmployeeResponse{
+						Message:         fmt.Sprintf("employee with id=<%d> not found", employeeId),
+						UpdatedEmployee: models.Employee{},
+					},
+				)
+				return
+			} else {
+				logger.Error("unexpected error", slog.String("err", err.Error()))
+				ginContext.JSON(http.StatusInternalServerError, schema.EditEmployeeResponse{Message: "internal error", UpdatedEmployee: models.Employee{}})
+				return
+			}
+		}
+		logger.Info("employee updated success")
+		ginContext.JSON(http.StatusOK, schema.EditEmployeeResponse{Message: "ok", UpdatedEmployee: employee})
+	}
+}
@@ -0,0 +1,106 @@
+package organizationapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/sariya23/tender/internal/domain/models"
+	schema "github.com/sariya23/tender/internal/hanlders"
+	"github.com/sariya23/tender/internal/lib/unmarshal"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+func (orgSrv *OrganizationService) CreateOrganization(ctx context.Context) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		const operationPlace = "internal.api.organizationapi.CreateOrganization"
+		logger := orgSrv.logger.With("op", operationPlace)
+		logger.Info(fmt.Sprintf("request to %v", ginContext.Request.URL))
+
+		body := ginContext.Request.Body
+		defer func() {
+			err := body.Close()
+			if err != nil {
+				logger.Error("cannot close body", slog.String("err", err.Error()))
+			}
+		}()
+
+		bodyData, err := io.ReadAll(body)
+		if err != nil {
+			logger.Error("cannot read body", slog.String("err", err.Error()))
+			ginContext.JSON(http.StatusInternalServerError, schema.CreateOrganizationResponse{Message: "internal error", Organization: models.Organization{}})
+			return
+		}
+		logger.Info("success read body")
+		createReq, err := unmarshal.CreateOrganizationRequest(bodyData)
+		if err != nil {
+			if errors.Is(err, unmarshal.ErrSyntax) {
+				logger.Warn("req syntax error", slog.String("err", err.Error()))
+				ginContext.JSON(
+					http.StatusBadRequest,
+					schema.CreateOrganizationResponse{
+						Message:      fmt.Sprintf("json syntax err: %s", err.Error()),
+						Organization: models.Organization{},
+					},
+				)
+				return
+			} else if errors.Is(err, unmarshal.ErrType) {
+				logger.Warn("req type error", slog.String("err", err.Error()))
+				ginContext.JSON(
+					http.StatusBadRequest,
+					schema.CreateOrganizationResponse{
+						Message:      fmt.Sprintf("json type err: %s", err.Error()),
+						Organization: models.Organization{},
+					},
+				)
+				return
+			} else {
+				logger.Error("unexpected error", slog.String("err", err.Error()))
+				ginContext.JSON(http.StatusInternalServerError, schema.CreateOrganizationResponse{Message: "internal error", Organization: models.Organization{}})
+				return
+			}
+		}
+		logger.Info("success unmarshal request")
+
+		validate := validator.New(validator.WithRequiredStructEnabled())
+		err = validate.Struct(&createReq)
+		if err != nil {
+			logger.Error("validation error", slog.String("err", err.Error()))
+			ginContext.JSON(
+				http.StatusBadRequest,
+				schema.CreateOrganizationResponse{
+					Message:      fmt.Sprintf("validation failed: %s", err.Error()),
+					Organization: models.Organization{},
+				},
+			)
+			return
+		}
+		logger.Info("validate success")
+
+		organization, err := orgSrv.organizationService.CreateOrganization(ctx, createReq.Organization)
+		if err != nil {
+			if errors.Is(err, outerror.ErrUnknownOrganizationType) {
+				logger.Warn("unknown organization type", slog.String("type", createReq.Organization.Type))
+				ginContext.JSON(
+					http.StatusBadRequest,
+					schema.CreateOrganizationResponse{
+						Message:      fmt.Sprintf("organization type=<%s> is unknown", createReq.Organization.Type),
+						Organization: models.Organization{},
+					},
+				)
+				return
+			} else {
+				logger.Error("unexpected error", slog.String("err", err.Error()))
+				ginContext.JSON(http.StatusInternalServerError, schema.CreateOrganizationResponse{Message: "internal error", Organization: models.Organization{}})
+				return
+			}
+		}
+		logger.Info("organization created success")
+		ginContext.JSON(http.StatusOK, schema.CreateOrganizationResponse{Message: "ok", Organization: organization})
+	}
+}
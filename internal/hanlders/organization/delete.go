@@ -0,0 +1,43 @@
+package organizationapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	schema "github.com/sariya23/tender/internal/hanlders"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+func (orgSrv *OrganizationService) DeleteOrganization(ctx context.Context) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		const operationPlace = "internal.api.organizationapi.DeleteOrganization"
+		logger := orgSrv.logger.With("op", operationPlace)
+		logger.Info(fmt.Sprintf("request to %v", ginContext.Request.URL))
+
+		orgId, err := organizationIdParam(ginContext)
+		if err != nil {
+			logger.Warn("invalid organization id", slog.String("err", err.Error()))
+			ginContext.JSON(http.StatusNotFound, schema.DeleteOrganizationResponse{Message: err.Error()})
+			return
+		}
+
+		err = orgSrv.organizationService.DeleteOrganization(ctx, orgId)
+		if err != nil {
+			if errors.Is(err, outerror.ErrOrganizationNotFound) {
+				logger.Warn(fmt.Sprintf("organization with id=<%d> not found", orgId))
+				ginContext.JSON(http.StatusNotFound, schema.DeleteOrganizationResponse{Message: fmt.Sprintf("organization with id=<%d> not found", orgId)})
+				return
+			} else {
+				logger.Error("unexpected error", slog.String("err", err.Error()))
+				ginContext.JSON(http.StatusInternalServerError, schema.DeleteOrganizationResponse{Message: "internal error"})
+				return
+			}
+		}
+		logger.Info("organization deleted success")
+		ginContext.JSON(http.StatusOK, schema.DeleteOrganizationResponse{Message: "ok"})
+	}
+}
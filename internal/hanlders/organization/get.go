@@ -0,0 +1,83 @@
+package organizationapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sariya23/tender/internal/domain/models"
+	schema "github.com/sariya23/tender/internal/hanlders"
+	"github.com/sariya23/tender/internal/lib/pagequery"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+func (orgSrv *OrganizationService) GetOrganization(ctx context.Context) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		const operationPlace = "internal.api.organizationapi.GetOrganization"
+		logger := orgSrv.logger.With("op", operationPlace)
+		logger.Info(fmt.Sprintf("request to %v", ginContext.Request.URL))
+
+		orgId, err := organizationIdParam(ginContext)
+		if err != nil {
+			logger.Warn("invalid organization id", slog.String("err", err.Error()))
+			ginContext.JSON(http.StatusNotFound, schema.GetOrganizationResponse{Message: err.Error(), Organization: models.Organization{}})
+			return
+		}
+
+		organization, err := orgSrv.organizationService.GetOrganization(ctx, orgId)
+		if err != nil {
+			if errors.Is(err, outerror.ErrOrganizationNotFound) {
+				logger.Warn(fmt.Sprintf("organization with id=<%d> not found", orgId))
+				ginContext.JSON(
+					http.StatusNotFound,
+					schema.GetOrganizationResponse{
+						Message:      fmt.Sprintf("organization with id=<%d> not found", orgId),
+						Organization: models.Organization{},
+					},
+				)
+				return
+			} else {
+				logger.Error("unexpected error", slog.String("err", err.Error()))
+				ginContext.JSON(http.StatusInternalServerError, schema.GetOrganizationResponse{Message: "internal error", Organization: models.Organization{}})
+				return
+			}
+		}
+		logger.Info("send success response")
+		ginContext.JSON(http.StatusOK, schema.GetOrganizationResponse{Message: "ok", Organization: organization})
+	}
+}
+
+func (orgSrv *OrganizationService) GetOrganizations(ctx context.Context) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		const operationPlace = "internal.api.organizationapi.GetOrganizations"
+		logger := orgSrv.logger.With("op", operationPlace)
+		logger.Info(fmt.Sprintf("request to %v", ginContext.Request.URL))
+
+		page, err := pagequery.Parse(ginContext)
+		if err != nil {
+			logger.Warn("invalid page", slog.String("err", err.Error()))
+			ginContext.JSON(http.StatusBadRequest, schema.GetOrganizationsResponse{Message: err.Error(), Organizations: []models.Organization{}})
+			return
+		}
+
+		organizations, err := orgSrv.organizationService.GetOrganizations(ctx, page)
+		if err != nil {
+			logger.Error("unexpected error", slog.String("err", err.Error()))
+			ginContext.JSON(http.StatusInternalServerError, schema.GetOrganizationsResponse{Message: "internal error", Organizations: []models.Organization{}})
+			return
+		}
+		logger.Info("send success response")
+		ginContext.JSON(
+			http.StatusOK,
+			schema.GetOrganizationsResponse{
+				Message:       "ok",
+				Organizations: organizations.Organizations,
+				Total:         organizations.Total,
+				NextCursor:    organizations.NextCursor,
+			},
+		)
+	}
+}
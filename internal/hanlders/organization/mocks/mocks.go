@@ -0,0 +1,71 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockOrganizationServiceProvider реализует интерфейс OrganizationServiceProvider
+// для целей тестирования. Он позволяет задавать ожидаемые результаты
+// методов:
+//
+// - CreateOrganization
+//
+// - GetOrganization
+//
+// - GetOrganizations
+//
+// - EditOrganization
+//
+// - DeleteOrganization
+//
+// - GetResponsibles
+//
+// - GrantResponsibility
+//
+// - RevokeResponsibility
+type MockOrganizationServiceProvider struct {
+	mock.Mock
+}
+
+func (m *MockOrganizationServiceProvider) CreateOrganization(ctx context.Context, organization models.Organization) (models.Organization, error) {
+	args := m.Called(ctx, organization)
+	return args.Get(0).(models.Organization), args.Error(1)
+}
+
+func (m *MockOrganizationServiceProvider) GetOrganization(ctx context.Context, orgId int) (models.Organization, error) {
+	args := m.Called(ctx, orgId)
+	return args.Get(0).(models.Organization), args.Error(1)
+}
+
+func (m *MockOrganizationServiceProvider) GetOrganizations(ctx context.Context, page models.Page) (models.OrganizationPage, error) {
+	args := m.Called(ctx, page)
+	return args.Get(0).(models.OrganizationPage), args.Error(1)
+}
+
+func (m *MockOrganizationServiceProvider) EditOrganization(ctx context.Context, orgId int, updateOrganization models.OrganizationToUpdate) (models.Organization, error) {
+	args := m.Called(ctx, orgId, updateOrganization)
+	return args.Get(0).(models.Organization), args.Error(1)
+}
+
+func (m *MockOrganizationServiceProvider) DeleteOrganization(ctx context.Context, orgId int) error {
+	args := m.Called(ctx, orgId)
+	return args.Error(0)
+}
+
+func (m *MockOrganizationServiceProvider) GetResponsibles(ctx context.Context, orgId int) ([]models.Employee, error) {
+	args := m.Called(ctx, orgId)
+	return args.Get(0).([]models.Employee), args.Error(1)
+}
+
+func (m *MockOrganizationServiceProvider) GrantResponsibility(ctx context.Context, username string, orgId int, employeeId int, role string) error {
+	args := m.Called(ctx, username, orgId, employeeId, role)
+	return args.Error(0)
+}
+
+func (m *MockOrganizationServiceProvider) RevokeResponsibility(ctx context.Context, username string, orgId int, employeeId int) error {
+	args := m.Called(ctx, username, orgId, employeeId)
+	return args.Error(0)
+}
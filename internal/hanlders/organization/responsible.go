@@ -0,0 +1,226 @@
+package organizationapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/sariya23/tender/internal/domain/models"
+	schema "github.com/sariya23/tender/internal/hanlders"
+	"github.com/sariya23/tender/internal/lib/unmarshal"
+	"github.com/sariya23/tender/internal/middleware"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+func (orgSrv *OrganizationService) GetResponsibles(ctx context.Context) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		const operationPlace = "internal.api.organizationapi.GetResponsibles"
+		logger := orgSrv.logger.With("op", operationPlace)
+		logger.Info(fmt.Sprintf("request to %v", ginContext.Request.URL))
+
+		orgId, err := organizationIdParam(ginContext)
+		if err != nil {
+			logger.Warn("invalid organization id", slog.String("err", err.Error()))
+			ginContext.JSON(http.StatusNotFound, schema.GetOrganizationResponsiblesResponse{Message: err.Error(), Responsibles: []models.Employee{}})
+			return
+		}
+
+		responsibles, err := orgSrv.organizationService.GetResponsibles(ctx, orgId)
+		if err != nil {
+			if errors.Is(err, outerror.ErrOrganizationNotFound) {
+				logger.Warn(fmt.Sprintf("organization with id=<%d> not found", orgId))
+				ginContext.JSON(
+					http.StatusNotFound,
+					schema.GetOrganizationResponsiblesResponse{
+						Message:      fmt.Sprintf("organization with id=<%d> not found", orgId),
+						Responsibles: []models.Employee{},
+					},
+				)
+				return
+			} else {
+				logger.Error("unexpected error", slog.String("err", err.Error()))
+				ginContext.JSON(http.StatusInternalServerError, schema.GetOrganizationResponsiblesResponse{Message: "internal error", Responsibles: []models.Employee{}})
+				return
+			}
+		}
+		logger.Info("send success response")
+		ginContext.JSON(http.StatusOK, schema.GetOrganizationResponsiblesResponse{Message: "ok", Responsibles: responsibles})
+	}
+}
+
+func (orgSrv *OrganizationService) GrantResponsibility(ctx context.Context) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		const operationPlace = "internal.api.organizationapi.GrantResponsibility"
+		logger := orgSrv.logger.With("op", operationPlace)
+		logger.Info(fmt.Sprintf("request to %v", ginContext.Request.URL))
+
+		orgId, err := organizationIdParam(ginContext)
+		if err != nil {
+			logger.Warn("invalid organization id", slog.String("err", err.Error()))
+			ginContext.JSON(http.StatusNotFound, schema.GrantResponsibilityResponse{Message: err.Error()})
+			return
+		}
+		username, err := middleware.ActingUsername(ginContext, "")
+		if err != nil {
+			logger.Warn("cannot resolve acting employee", slog.String("err", err.Error()))
+			ginContext.JSON(middleware.AuthErrorStatus(err), schema.GrantResponsibilityResponse{Message: err.Error()})
+			return
+		}
+
+		body := ginContext.Request.Body
+		defer func() {
+			err := body.Close()
+			if err != nil {
+				logger.Error("cannot close body", slog.String("err", err.Error()))
+			}
+		}()
+
+		bodyData, err := io.ReadAll(body)
+		if err != nil {
+			logger.Error("cannot read body", slog.String("err", err.Error()))
+			ginContext.JSON(http.StatusInternalServerError, schema.GrantResponsibilityResponse{Message: "internal error"})
+			return
+		}
+		logger.Info("success read body")
+		grantReq, err := unmarshal.GrantResponsibilityRequest(bodyData)
+		if err != nil {
+			if errors.Is(err, unmarshal.ErrSyntax) {
+				logger.Warn("req syntax error", slog.String("err", err.Error()))
+				ginContext.JSON(http.StatusBadRequest, schema.GrantResponsibilityResponse{Message: fmt.Sprintf("json syntax err: %s", err.Error())})
+				return
+			} else if errors.Is(err, unmarshal.ErrType) {
+				logger.Warn("req type error", slog.String("err", err.Error()))
+				ginContext.JSON(http.StatusBadRequest, schema.GrantResponsibilityResponse{Message: fmt.Sprintf("json type err: %s", err.Error())})
+				return
+			} else {
+				logger.Error("unexpected error", slog.String("err", err.Error()))
+				ginContext.JSON(http.StatusInternalServerError, schema.GrantResponsibilityResponse{Message: "internal error"})
+				return
+			}
+		}
+		logger.Info("success unmarshal request")
+
+		validate := validator.New(validator.WithRequiredStructEnabled())
+		err = validate.Struct(&grantReq)
+		if err != nil {
+			logger.Error("validation error", slog.String("err", err.Error()))
+			ginContext.JSON(http.StatusBadRequest, schema.GrantResponsibilityResponse{Message: fmt.Sprintf("validation failed: %s", err.Error())})
+			return
+		}
+		logger.Info("validate success")
+
+		err = orgSrv.organizationService.GrantResponsibility(ctx, username, orgId, grantReq.EmployeeId, grantReq.Role)
+		if err != nil {
+			if errors.Is(err, outerror.ErrOrganizationNotFound) {
+				logger.Warn(fmt.Sprintf("organization with id=<%d> not found", orgId))
+				ginContext.JSON(http.StatusNotFound, schema.GrantResponsibilityResponse{Message: fmt.Sprintf("organization with id=<%d> not found", orgId)})
+				return
+			} else if errors.Is(err, outerror.ErrEmployeeNotSystemAdmin) {
+				logger.Warn("employee cannot manage responsibles", slog.String("username", username))
+				ginContext.JSON(
+					http.StatusForbidden,
+					schema.GrantResponsibilityResponse{
+						Message: fmt.Sprintf("employee <%s> is not system administrator or admin of organization with id=<%d>", username, orgId),
+					},
+				)
+				return
+			} else if errors.Is(err, outerror.ErrEmployeeNotFound) {
+				logger.Warn(fmt.Sprintf("employee with id=<%d> not found", grantReq.EmployeeId))
+				ginContext.JSON(
+					http.StatusUnprocessableEntity,
+					schema.GrantResponsibilityResponse{Message: fmt.Sprintf("employee with id=<%d> not found", grantReq.EmployeeId)},
+				)
+				return
+			} else if errors.Is(err, outerror.ErrEmployeeAlreadyResponsible) {
+				logger.Warn("employee already responsible", slog.Int("employee id", grantReq.EmployeeId))
+				ginContext.JSON(
+					http.StatusConflict,
+					schema.GrantResponsibilityResponse{
+						Message: fmt.Sprintf("employee with id=<%d> already responsible for organization with id=<%d>", grantReq.EmployeeId, orgId),
+					},
+				)
+				return
+			} else {
+				logger.Error("unexpected error", slog.String("err", err.Error()))
+				ginContext.JSON(http.StatusInternalServerError, schema.GrantResponsibilityResponse{Message: "internal error"})
+				return
+			}
+		}
+		logger.Info("responsibility granted success")
+		ginContext.JSON(http.StatusOK, schema.GrantResponsibilityResponse{Message: "ok"})
+	}
+}
+
+func (orgSrv *OrganizationService) RevokeResponsibility(ctx context.Context) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		const operationPlace = "internal.api.organizationapi.RevokeResponsibility"
+		logger := orgSrv.logger.With("op", operationPlace)
+		logger.Info(fmt.Sprintf("request to %v", ginContext.Request.URL))
+
+		orgId, err := organizationIdParam(ginContext)
+		if err != nil {
+			logger.Warn("invalid organization id", slog.String("err", err.Error()))
+			ginContext.JSON(http.StatusNotFound, schema.RevokeResponsibilityResponse{Message: err.Error()})
+			return
+		}
+		employeeId, err := employeeIdParam(ginContext)
+		if err != nil {
+			logger.Warn("invalid employee id", slog.String("err", err.Error()))
+			ginContext.JSON(http.StatusNotFound, schema.RevokeResponsibilityResponse{Message: err.Error()})
+			return
+		}
+		username, err := middleware.ActingUsername(ginContext, "")
+		if err != nil {
+			logger.Warn("cannot resolve acting employee", slog.String("err", err.Error()))
+			ginContext.JSON(middleware.AuthErrorStatus(err), schema.RevokeResponsibilityResponse{Message: err.Error()})
+			return
+		}
+
+		err = orgSrv.organizationService.RevokeResponsibility(ctx, username, orgId, employeeId)
+		if err != nil {
+			if errors.Is(err, outerror.ErrOrganizationNotFound) {
+				logger.Warn(fmt.Sprintf("organization with id=<%d> not found", orgId))
+				ginContext.JSON(http.StatusNotFound, schema.RevokeResponsibilityResponse{Message: fmt.Sprintf("organization with id=<%d> not found", orgId)})
+				return
+			} else if errors.Is(err, outerror.ErrEmployeeNotSystemAdmin) {
+				logger.Warn("employee cannot manage responsibles", slog.String("username", username))
+				ginContext.JSON(
+					http.StatusForbidden,
+					schema.RevokeResponsibilityResponse{
+						Message: fmt.Sprintf("employee <%s> is not system administrator or admin of organization with id=<%d>", username, orgId),
+					},
+				)
+				return
+			} else if errors.Is(err, outerror.ErrEmployeeNotResponsibleForOrganization) {
+				logger.Warn("employee not responsible", slog.Int("employee id", employeeId))
+				ginContext.JSON(
+					http.StatusNotFound,
+					schema.RevokeResponsibilityResponse{
+						Message: fmt.Sprintf("employee with id=<%d> not responsible for organization with id=<%d>", employeeId, orgId),
+					},
+				)
+				return
+			} else if errors.Is(err, outerror.ErrLastOrganizationResponsible) {
+				logger.Warn("cannot revoke last responsible", slog.Int("employee id", employeeId))
+				ginContext.JSON(
+					http.StatusConflict,
+					schema.RevokeResponsibilityResponse{
+						Message: fmt.Sprintf("employee with id=<%d> is the last responsible for organization with id=<%d> that has live tenders", employeeId, orgId),
+					},
+				)
+				return
+			} else {
+				logger.Error("unexpected error", slog.String("err", err.Error()))
+				ginContext.JSON(http.StatusInternalServerError, schema.RevokeResponsibilityResponse{Message: "internal error"})
+				return
+			}
+		}
+		logger.Info("responsibility revoked success")
+		ginContext.JSON(http.StatusOK, schema.RevokeResponsibilityResponse{Message: "ok"})
+	}
+}
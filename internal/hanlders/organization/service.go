@@ -0,0 +1,65 @@
+package organizationapi
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sariya23/tender/internal/domain/models"
+)
+
+type OrganizationServiceProvider interface {
+	CreateOrganization(ctx context.Context, organization models.Organization) (models.Organization, error)
+	GetOrganization(ctx context.Context, orgId int) (models.Organization, error)
+	GetOrganizations(ctx context.Context, page models.Page) (models.OrganizationPage, error)
+	EditOrganization(ctx context.Context, orgId int, updateOrganization models.OrganizationToUpdate) (models.Organization, error)
+	DeleteOrganization(ctx context.Context, orgId int) error
+	GetResponsibles(ctx context.Context, orgId int) ([]models.Employee, error)
+	GrantResponsibility(ctx context.Context, username string, orgId int, employeeId int, role string) error
+	RevokeResponsibility(ctx context.Context, username string, orgId int, employeeId int) error
+}
+
+type OrganizationService struct {
+	logger              *slog.Logger
+	organizationService OrganizationServiceProvider
+}
+
+func New(logger *slog.Logger, organizationService OrganizationServiceProvider) *OrganizationService {
+	return &OrganizationService{
+		logger:              logger,
+		organizationService: organizationService,
+	}
+}
+
+var (
+	errOrganizationIdNotInteger  = errors.New("cannot convert organization id to integer")
+	errOrganizationIdNotPositive = errors.New("organization id must be positive integer")
+	errEmployeeIdNotInteger      = errors.New("cannot convert employee id to integer")
+	errEmployeeIdNotPositive     = errors.New("employee id must be positive integer")
+)
+
+// organizationIdParam читает id организации из параметра пути organizationId.
+func organizationIdParam(ginContext *gin.Context) (int, error) {
+	orgId, err := strconv.Atoi(ginContext.Param("organizationId"))
+	if err != nil {
+		return 0, errOrganizationIdNotInteger
+	}
+	if orgId <= 0 {
+		return 0, errOrganizationIdNotPositive
+	}
+	return orgId, nil
+}
+
+// employeeIdParam читает id сотрудника из параметра пути employeeId.
+func employeeIdParam(ginContext *gin.Context) (int, error) {
+	employeeId, err := strconv.Atoi(ginContext.Param("employeeId"))
+	if err != nil {
+		return 0, errEmployeeIdNotInteger
+	}
+	if employeeId <= 0 {
+		return 0, errEmployeeIdNotPositive
+	}
+	return employeeId, nil
+}
@@ -0,0 +1,142 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	organizationapi "github.com/sariya23/tender/internal/hanlders/organization"
+	"github.com/sariya23/tender/internal/hanlders/organization/mocks"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCreateOrganization_Success проверяет успешное
+// создание организации.
+//
+// Возвращается код 200 и созданная организация.
+func TestCreateOrganization_Success(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockOrgService := new(mocks.MockOrganizationServiceProvider)
+	createdAt := time.Date(2024, 12, 21, 10, 0, 0, 0, time.UTC)
+	orgToCreate := models.Organization{Name: "Org", Description: "qwe", Type: "LLC"}
+	createdOrg := models.Organization{ID: 1, Name: "Org", Description: "qwe", Type: "LLC", CreatedAt: createdAt, UpdatedAt: createdAt}
+	reqBody := `{"organization": {"name": "Org", "description": "qwe", "type": "LLC"}}`
+	expectedBody := `
+	{
+		"organization": {
+			"id": 1,
+			"name": "Org",
+			"description": "qwe",
+			"type": "LLC",
+			"created_at": "2024-12-21T10:00:00Z",
+			"updated_at": "2024-12-21T10:00:00Z"
+		},
+		"message": "ok"
+	}`
+	svc := organizationapi.New(logger, mockOrgService)
+
+	mockOrgService.On("CreateOrganization", ctx, orgToCreate).Return(createdOrg, nil)
+	router := gin.New()
+	router.POST("/api/organizations/new", svc.CreateOrganization(ctx))
+	req := httptest.NewRequest(http.MethodPost, "/api/organizations/new", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestCreateOrganization_FailValidation проверяет, что
+// организация без имени или типа не создается.
+//
+// Возвращается код 400.
+func TestCreateOrganization_FailValidation(t *testing.T) {
+	cases := []struct {
+		name    string
+		reqBody string
+	}{
+		{name: "no name", reqBody: `{"organization": {"description": "qwe", "type": "LLC"}}`},
+		{name: "no type", reqBody: `{"organization": {"name": "Org", "description": "qwe"}}`},
+		{name: "too long name", reqBody: `{"organization": {"name": "` + strings.Repeat("a", 101) + `", "type": "LLC"}}`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			gin.SetMode(gin.TestMode)
+			ctx := context.Background()
+
+			logger := slogdiscard.NewDiscardLogger()
+			mockOrgService := new(mocks.MockOrganizationServiceProvider)
+			svc := organizationapi.New(logger, mockOrgService)
+
+			router := gin.New()
+			router.POST("/api/organizations/new", svc.CreateOrganization(ctx))
+			req := httptest.NewRequest(http.MethodPost, "/api/organizations/new", strings.NewReader(tc.reqBody))
+			w := httptest.NewRecorder()
+
+			// Act
+			router.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			require.Contains(t, w.Body.String(), "validation failed")
+			mockOrgService.AssertNotCalled(t, "CreateOrganization")
+		})
+	}
+}
+
+// TestCreateOrganization_FailUnknownType проверяет, что
+// если тип организации не найден в справочнике, то
+// возвращается код 400.
+func TestCreateOrganization_FailUnknownType(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockOrgService := new(mocks.MockOrganizationServiceProvider)
+	orgToCreate := models.Organization{Name: "Org", Type: "qwe"}
+	reqBody := `{"organization": {"name": "Org", "type": "qwe"}}`
+	expectedBody := `
+	{
+		"organization": {
+			"id": 0,
+			"name": "",
+			"description": "",
+			"type": "",
+			"created_at": "0001-01-01T00:00:00Z",
+			"updated_at": "0001-01-01T00:00:00Z"
+		},
+		"message": "organization type=<qwe> is unknown"
+	}`
+	svc := organizationapi.New(logger, mockOrgService)
+
+	mockOrgService.On("CreateOrganization", ctx, orgToCreate).Return(models.Organization{}, outerror.ErrUnknownOrganizationType)
+	router := gin.New()
+	router.POST("/api/organizations/new", svc.CreateOrganization(ctx))
+	req := httptest.NewRequest(http.MethodPost, "/api/organizations/new", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
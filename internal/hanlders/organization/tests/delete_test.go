@@ -0,0 +1,69 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	organizationapi "github.com/sariya23/tender/internal/hanlders/organization"
+	"github.com/sariya23/tender/internal/hanlders/organization/mocks"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDeleteOrganization_Success проверяет успешное
+// удаление организации.
+//
+// Возвращается код 200.
+func TestDeleteOrganization_Success(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockOrgService := new(mocks.MockOrganizationServiceProvider)
+	svc := organizationapi.New(logger, mockOrgService)
+
+	mockOrgService.On("DeleteOrganization", ctx, 2).Return(nil)
+	router := gin.New()
+	router.DELETE("/api/organizations/:organizationId", svc.DeleteOrganization(ctx))
+	req := httptest.NewRequest(http.MethodDelete, "/api/organizations/2", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"message": "ok"}`, w.Body.String())
+}
+
+// TestDeleteOrganization_FailNotFound проверяет, что
+// при удалении несуществующей организации возвращается код 404.
+func TestDeleteOrganization_FailNotFound(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockOrgService := new(mocks.MockOrganizationServiceProvider)
+	svc := organizationapi.New(logger, mockOrgService)
+
+	mockOrgService.On("DeleteOrganization", ctx, 2).Return(outerror.ErrOrganizationNotFound)
+	router := gin.New()
+	router.DELETE("/api/organizations/:organizationId", svc.DeleteOrganization(ctx))
+	req := httptest.NewRequest(http.MethodDelete, "/api/organizations/2", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	require.JSONEq(t, `{"message": "organization with id=<2> not found"}`, w.Body.String())
+}
@@ -0,0 +1,179 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	organizationapi "github.com/sariya23/tender/internal/hanlders/organization"
+	"github.com/sariya23/tender/internal/hanlders/organization/mocks"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetOrganization_Success проверяет, что
+// организация возвращается по id.
+//
+// Возвращается код 200.
+func TestGetOrganization_Success(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockOrgService := new(mocks.MockOrganizationServiceProvider)
+	org := models.Organization{ID: 3, Name: "Org", Description: "qwe", Type: "IE"}
+	expectedBody := `
+	{
+		"organization": {
+			"id": 3,
+			"name": "Org",
+			"description": "qwe",
+			"type": "IE",
+			"created_at": "0001-01-01T00:00:00Z",
+			"updated_at": "0001-01-01T00:00:00Z"
+		},
+		"message": "ok"
+	}`
+	svc := organizationapi.New(logger, mockOrgService)
+
+	mockOrgService.On("GetOrganization", ctx, 3).Return(org, nil)
+	router := gin.New()
+	router.GET("/api/organizations/:organizationId", svc.GetOrganization(ctx))
+	req := httptest.NewRequest(http.MethodGet, "/api/organizations/3", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestGetOrganization_FailNotFound проверяет, что
+// для несуществующей или некорректной id организации
+// возвращается код 404.
+func TestGetOrganization_FailNotFound(t *testing.T) {
+	cases := []struct {
+		name    string
+		orgId   string
+		message string
+	}{
+		{name: "not integer", orgId: "qwe", message: "cannot convert organization id to integer"},
+		{name: "not positive", orgId: "0", message: "organization id must be positive integer"},
+		{name: "not found", orgId: "5", message: "organization with id=<5> not found"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			gin.SetMode(gin.TestMode)
+			ctx := context.Background()
+
+			logger := slogdiscard.NewDiscardLogger()
+			mockOrgService := new(mocks.MockOrganizationServiceProvider)
+			svc := organizationapi.New(logger, mockOrgService)
+
+			mockOrgService.On("GetOrganization", ctx, 5).Return(models.Organization{}, outerror.ErrOrganizationNotFound)
+			router := gin.New()
+			router.GET("/api/organizations/:organizationId", svc.GetOrganization(ctx))
+			req := httptest.NewRequest(http.MethodGet, "/api/organizations/"+tc.orgId, nil)
+			w := httptest.NewRecorder()
+
+			// Act
+			router.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, http.StatusNotFound, w.Code)
+			expectedBody := fmt.Sprintf(`
+			{
+				"organization": {
+					"id": 0,
+					"name": "",
+					"description": "",
+					"type": "",
+					"created_at": "0001-01-01T00:00:00Z",
+					"updated_at": "0001-01-01T00:00:00Z"
+				},
+				"message": %q
+			}`, tc.message)
+			require.JSONEq(t, expectedBody, w.Body.String())
+		})
+	}
+}
+
+// TestGetOrganizations_Success проверяет, что
+// параметры страницы передаются в сервис и
+// возвращается список организаций.
+//
+// Возвращается код 200.
+func TestGetOrganizations_Success(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockOrgService := new(mocks.MockOrganizationServiceProvider)
+	nextCursor := 1
+	orgPage := models.OrganizationPage{
+		Organizations: []models.Organization{{ID: 1, Name: "Org", Type: "IE"}},
+		Total:         2,
+		NextCursor:    &nextCursor,
+	}
+	expectedBody := `
+	{
+		"organizations": [
+			{"id": 1, "name": "Org", "description": "", "type": "IE", "created_at": "0001-01-01T00:00:00Z", "updated_at": "0001-01-01T00:00:00Z"}
+		],
+		"total": 2,
+		"next_cursor": 1,
+		"message": "ok"
+	}`
+	svc := organizationapi.New(logger, mockOrgService)
+
+	mockOrgService.On("GetOrganizations", ctx, models.Page{Limit: 1}).Return(orgPage, nil)
+	router := gin.New()
+	router.GET("/api/organizations/", svc.GetOrganizations(ctx))
+	req := httptest.NewRequest(http.MethodGet, "/api/organizations/?limit=1", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestGetOrganizations_FailInvalidPage проверяет, что
+// при некорректных параметрах страницы возвращается код 400.
+func TestGetOrganizations_FailInvalidPage(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockOrgService := new(mocks.MockOrganizationServiceProvider)
+	expectedBody := `{"organizations": [], "total": 0, "next_cursor": null, "message": "limit must be integer from 1 to 100"}`
+	svc := organizationapi.New(logger, mockOrgService)
+
+	router := gin.New()
+	router.GET("/api/organizations/", svc.GetOrganizations(ctx))
+	req := httptest.NewRequest(http.MethodGet, "/api/organizations/?limit=0", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+	mockOrgService.AssertNotCalled(t, "GetOrganizations")
+}
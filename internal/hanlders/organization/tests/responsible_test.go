@@ -0,0 +1,315 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	organizationapi "github.com/sariya23/tender/internal/hanlders/organization"
+	"github.com/sariya23/tender/internal/hanlders/organization/mocks"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetResponsibles_Success проверяет, что
+// возвращаются ответственные за организацию.
+//
+// Возвращается код 200.
+func TestGetResponsibles_Success(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockOrgService := new(mocks.MockOrganizationServiceProvider)
+	responsibles := []models.Employee{{ID: 2, Username: "qwe"}}
+	expectedBody := `
+	{
+		"responsibles": [
+			{
+				"id": 2,
+				"username": "qwe",
+				"first_name": "",
+				"last_name": "",
+				"created_at": "0001-01-01T00:00:00Z",
+				"updated_at": "0001-01-01T00:00:00Z"
+			}
+		],
+		"message": "ok"
+	}`
+	svc := organizationapi.New(logger, mockOrgService)
+
+	mockOrgService.On("GetResponsibles", ctx, 1).Return(responsibles, nil)
+	router := gin.New()
+	router.GET("/api/organizations/:organizationId/responsibles", svc.GetResponsibles(ctx))
+	req := httptest.NewRequest(http.MethodGet, "/api/organizations/1/responsibles", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestGrantResponsibility_Success проверяет успешное
+// назначение ответственного.
+//
+// Возвращается код 200.
+func TestGrantResponsibility_Success(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockOrgService := new(mocks.MockOrganizationServiceProvider)
+	svc := organizationapi.New(logger, mockOrgService)
+
+	mockOrgService.On("GrantResponsibility", ctx, "qwe", 1, 2, "ADMIN").Return(nil)
+	router := gin.New()
+	router.POST("/api/organizations/:organizationId/responsibles", authenticatedAs("qwe"), svc.GrantResponsibility(ctx))
+	req := httptest.NewRequest(http.MethodPost, "/api/organizations/1/responsibles", strings.NewReader(`{"employee_id": 2, "role": "ADMIN"}`))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"message": "ok"}`, w.Body.String())
+}
+
+// TestGrantResponsibility_Fail проверяет коды ответа
+// при ошибках назначения ответственного.
+func TestGrantResponsibility_Fail(t *testing.T) {
+	cases := []struct {
+		name         string
+		reqBody      string
+		srvErr       error
+		expectedCode int
+		message      string
+	}{
+		{
+			name:         "validation",
+			reqBody:      `{}`,
+			expectedCode: http.StatusBadRequest,
+			message:      "validation failed",
+		},
+		{
+			name:         "unknown role",
+			reqBody:      `{"employee_id": 2, "role": "OWNER"}`,
+			expectedCode: http.StatusBadRequest,
+			message:      "validation failed",
+		},
+		{
+			name:         "organization not found",
+			reqBody:      `{"employee_id": 2}`,
+			srvErr:       outerror.ErrOrganizationNotFound,
+			expectedCode: http.StatusNotFound,
+			message:      "organization with id=\\u003c1\\u003e not found",
+		},
+		{
+			name:         "not organization admin",
+			reqBody:      `{"employee_id": 2}`,
+			srvErr:       errors.Join(outerror.ErrEmployeeNotSystemAdmin, outerror.ErrEmployeeNotResponsibleForOrganization),
+			expectedCode: http.StatusForbidden,
+			message:      "employee \\u003cqwe\\u003e is not system administrator or admin of organization with id=\\u003c1\\u003e",
+		},
+		{
+			name:         "employee not found",
+			reqBody:      `{"employee_id": 2}`,
+			srvErr:       outerror.ErrEmployeeNotFound,
+			expectedCode: http.StatusUnprocessableEntity,
+			message:      "employee with id=\\u003c2\\u003e not found",
+		},
+		{
+			name:         "already responsible",
+			reqBody:      `{"employee_id": 2}`,
+			srvErr:       outerror.ErrEmployeeAlreadyResponsible,
+			expectedCode: http.StatusConflict,
+			message:      "employee with id=\\u003c2\\u003e already responsible for organization with id=\\u003c1\\u003e",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			gin.SetMode(gin.TestMode)
+			ctx := context.Background()
+
+			logger := slogdiscard.NewDiscardLogger()
+			mockOrgService := new(mocks.MockOrganizationServiceProvider)
+			svc := organizationapi.New(logger, mockOrgService)
+
+			mockOrgService.On("GrantResponsibility", ctx, "qwe", 1, 2, "").Return(tc.srvErr)
+			router := gin.New()
+			router.POST("/api/organizations/:organizationId/responsibles", authenticatedAs("qwe"), svc.GrantResponsibility(ctx))
+			req := httptest.NewRequest(http.MethodPost, "/api/organizations/1/responsibles", strings.NewReader(tc.reqBody))
+			w := httptest.NewRecorder()
+
+			// Act
+			router.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, tc.expectedCode, w.Code)
+			require.Contains(t, w.Body.String(), tc.message)
+		})
+	}
+}
+
+// TestRevokeResponsibility_Success проверяет успешное
+// снятие ответственного.
+//
+// Возвращается код 200.
+func TestRevokeResponsibility_Success(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockOrgService := new(mocks.MockOrganizationServiceProvider)
+	svc := organizationapi.New(logger, mockOrgService)
+
+	mockOrgService.On("RevokeResponsibility", ctx, "qwe", 1, 2).Return(nil)
+	router := gin.New()
+	router.DELETE("/api/organizations/:organizationId/responsibles/:employeeId", authenticatedAs("qwe"), svc.RevokeResponsibility(ctx))
+	req := httptest.NewRequest(http.MethodDelete, "/api/organizations/1/responsibles/2", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"message": "ok"}`, w.Body.String())
+}
+
+// TestRevokeResponsibility_FailLastResponsible проверяет, что
+// нельзя снять последнего ответственного организации с незакрытыми тендерами.
+//
+// Возвращается код 409.
+func TestRevokeResponsibility_FailLastResponsible(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockOrgService := new(mocks.MockOrganizationServiceProvider)
+	expectedBody := `{"message": "employee with id=<2> is the last responsible for organization with id=<1> that has live tenders"}`
+	svc := organizationapi.New(logger, mockOrgService)
+
+	mockOrgService.On("RevokeResponsibility", ctx, "qwe", 1, 2).Return(outerror.ErrLastOrganizationResponsible)
+	router := gin.New()
+	router.DELETE("/api/organizations/:organizationId/responsibles/:employeeId", authenticatedAs("qwe"), svc.RevokeResponsibility(ctx))
+	req := httptest.NewRequest(http.MethodDelete, "/api/organizations/1/responsibles/2", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusConflict, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestRevokeResponsibility_FailNotResponsible проверяет, что
+// если сотрудник не ответственный или id сотрудника некорректный,
+// то возвращается код 404.
+func TestRevokeResponsibility_FailNotResponsible(t *testing.T) {
+	cases := []struct {
+		name       string
+		employeeId string
+		message    string
+	}{
+		{name: "not integer", employeeId: "qwe", message: "cannot convert employee id to integer"},
+		{name: "not responsible", employeeId: "2", message: "employee with id=\\u003c2\\u003e not responsible for organization with id=\\u003c1\\u003e"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			gin.SetMode(gin.TestMode)
+			ctx := context.Background()
+
+			logger := slogdiscard.NewDiscardLogger()
+			mockOrgService := new(mocks.MockOrganizationServiceProvider)
+			svc := organizationapi.New(logger, mockOrgService)
+
+			mockOrgService.On("RevokeResponsibility", ctx, "qwe", 1, 2).Return(outerror.ErrEmployeeNotResponsibleForOrganization)
+			router := gin.New()
+			router.DELETE("/api/organizations/:organizationId/responsibles/:employeeId", authenticatedAs("qwe"), svc.RevokeResponsibility(ctx))
+			req := httptest.NewRequest(http.MethodDelete, "/api/organizations/1/responsibles/"+tc.employeeId, nil)
+			w := httptest.NewRecorder()
+
+			// Act
+			router.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, http.StatusNotFound, w.Code)
+			require.JSONEq(t, `{"message": "`+tc.message+`"}`, w.Body.String())
+		})
+	}
+}
+
+// TestRevokeResponsibility_FailNotOrganizationAdmin проверяет, что
+// снять ответственного может только системный администратор или
+// администратор организации.
+//
+// Возвращается код 403.
+func TestRevokeResponsibility_FailNotOrganizationAdmin(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockOrgService := new(mocks.MockOrganizationServiceProvider)
+	expectedBody := `{"message": "employee <qwe> is not system administrator or admin of organization with id=<1>"}`
+	svc := organizationapi.New(logger, mockOrgService)
+
+	mockOrgService.On("RevokeResponsibility", ctx, "qwe", 1, 2).
+		Return(errors.Join(outerror.ErrEmployeeNotSystemAdmin, outerror.ErrEmployeeNotResponsibleForOrganization))
+	router := gin.New()
+	router.DELETE("/api/organizations/:organizationId/responsibles/:employeeId", authenticatedAs("qwe"), svc.RevokeResponsibility(ctx))
+	req := httptest.NewRequest(http.MethodDelete, "/api/organizations/1/responsibles/2", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestGrantResponsibility_FailNotAuthenticated проверяет, что без
+// аутентифицированного сотрудника ответственного назначить нельзя.
+//
+// Возвращается код 401.
+func TestGrantResponsibility_FailNotAuthenticated(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockOrgService := new(mocks.MockOrganizationServiceProvider)
+	svc := organizationapi.New(logger, mockOrgService)
+
+	router := gin.New()
+	router.POST("/api/organizations/:organizationId/responsibles", svc.GrantResponsibility(ctx))
+	req := httptest.NewRequest(http.MethodPost, "/api/organizations/1/responsibles", strings.NewReader(`{"employee_id": 2}`))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	mockOrgService.AssertNotCalled(t, "GrantResponsibility")
+}
@@ -0,0 +1,138 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	organizationapi "github.com/sariya23/tender/internal/hanlders/organization"
+	"github.com/sariya23/tender/internal/hanlders/organization/mocks"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEditOrganization_Success проверяет успешное
+// обновление части полей организации.
+//
+// Возвращается код 200 и обновленная организация.
+func TestEditOrganization_Success(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockOrgService := new(mocks.MockOrganizationServiceProvider)
+	newName := "New org"
+	updateOrg := models.OrganizationToUpdate{Name: &newName}
+	updatedOrg := models.Organization{ID: 2, Name: newName, Description: "qwe", Type: "LLC"}
+	reqBody := `{"update_organization_data": {"name": "New org"}}`
+	expectedBody := `
+	{
+		"updated_organization": {
+			"id": 2,
+			"name": "New org",
+			"description": "qwe",
+			"type": "LLC",
+			"created_at": "0001-01-01T00:00:00Z",
+			"updated_at": "0001-01-01T00:00:00Z"
+		},
+		"message": "ok"
+	}`
+	svc := organizationapi.New(logger, mockOrgService)
+
+	mockOrgService.On("EditOrganization", ctx, 2, updateOrg).Return(updatedOrg, nil)
+	router := gin.New()
+	router.PATCH("/api/organizations/:organizationId/edit", svc.EditOrganization(ctx))
+	req := httptest.NewRequest(http.MethodPatch, "/api/organizations/2/edit", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestEditOrganization_FailServiceErrors проверяет, что
+// ошибки сервиса переводятся в коды ответа.
+func TestEditOrganization_FailServiceErrors(t *testing.T) {
+	newType := "qwe"
+	cases := []struct {
+		name         string
+		reqBody      string
+		updateOrg    models.OrganizationToUpdate
+		serviceErr   error
+		expectedCode int
+		message      string
+	}{
+		{
+			name:         "nothing to update",
+			reqBody:      `{"update_organization_data": {}}`,
+			updateOrg:    models.OrganizationToUpdate{},
+			serviceErr:   outerror.ErrNothingToUpdate,
+			expectedCode: http.StatusBadRequest,
+			message:      "nothing to update",
+		},
+		{
+			name:         "unknown type",
+			reqBody:      `{"update_organization_data": {"type": "qwe"}}`,
+			updateOrg:    models.OrganizationToUpdate{Type: &newType},
+			serviceErr:   outerror.ErrUnknownOrganizationType,
+			expectedCode: http.StatusBadRequest,
+			message:      "organization type=<qwe> is unknown",
+		},
+		{
+			name:         "organization not found",
+			reqBody:      `{"update_organization_data": {"type": "qwe"}}`,
+			updateOrg:    models.OrganizationToUpdate{Type: &newType},
+			serviceErr:   outerror.ErrOrganizationNotFound,
+			expectedCode: http.StatusNotFound,
+			message:      "organization with id=<2> not found",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			gin.SetMode(gin.TestMode)
+			ctx := context.Background()
+
+			logger := slogdiscard.NewDiscardLogger()
+			mockOrgService := new(mocks.MockOrganizationServiceProvider)
+			svc := organizationapi.New(logger, mockOrgService)
+
+			mockOrgService.On("EditOrganization", ctx, 2, tc.updateOrg).Return(models.Organization{}, tc.serviceErr)
+			router := gin.New()
+			router.PATCH("/api/organizations/:organizationId/edit", svc.EditOrganization(ctx))
+			req := httptest.NewRequest(http.MethodPatch, "/api/organizations/2/edit", strings.NewReader(tc.reqBody))
+			w := httptest.NewRecorder()
+
+			// Act
+			router.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, tc.expectedCode, w.Code)
+			expectedBody := fmt.Sprintf(`
+			{
+				"updated_organization": {
+					"id": 0,
+					"name": "",
+					"description": "",
+					"type": "",
+					"created_at": "0001-01-01T00:00:00Z",
+					"updated_at": "0001-01-01T00:00:00Z"
+				},
+				"message": %q
+			}`, tc.message)
+			require.JSONEq(t, expectedBody, w.Body.String())
+		})
+	}
+}
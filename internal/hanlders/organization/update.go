@@ -0,0 +1,127 @@
+package organizationapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/sariya23/tender/internal/domain/models"
+	schema "github.com/sariya23/tender/internal/hanlders"
+	"github.com/sariya23/tender/internal/lib/unmarshal"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+func (orgSrv *OrganizationService) EditOrganization(ctx context.Context) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		const operationPlace = "internal.api.organizationapi.EditOrganization"
+		logger := orgSrv.logger.With("op", operationPlace)
+		logger.Info(fmt.Sprintf("request to %v", ginContext.Request.URL))
+
+		orgId, err := organizationIdParam(ginContext)
+		if err != nil {
+			logger.Warn("invalid organization id", slog.String("err", err.Error()))
+			ginContext.JSON(http.StatusNotFound, schema.EditOrganizationResponse{Message: err.Error(), UpdatedOrganization: models.Organization{}})
+			return
+		}
+
+		body := ginContext.Request.Body
+		defer func() {
+			err := body.Close()
+			if err != nil {
+				logger.Error("cannot close body", slog.String("err", err.Error()))
+			}
+		}()
+
+		bodyData, err := io.ReadAll(body)
+		if err != nil {
+			logger.Error("cannot read body", slog.String("err", err.Error()))
+			ginContext.JSON(http.StatusInternalServerError, schema.EditOrganizationResponse{Message: "internal error", UpdatedOrganization: models.Organization{}})
+			return
+		}
+		logger.Info("success read body")
+		editReq, err := unmarshal.EditOrganizationRequest(bodyData)
+		if err != nil {
+			if errors.Is(err, unmarshal.ErrSyntax) {
+				logger.Warn("req syntax error", slog.String("err", err.Error()))
+				ginContext.JSON(
+					http.StatusBadRequest,
+					schema.EditOrganizationResponse{
+						Message:             fmt.Sprintf("json syntax err: %s", err.Error()),
+						UpdatedOrganization: models.Organization{},
+					},
+				)
+				return
+			} else if errors.Is(err, unmarshal.ErrType) {
+				logger.Warn("req type error", slog.String("err", err.Error()))
+				ginContext.JSON(
+					http.StatusBadRequest,
+					schema.EditOrganizationResponse{
+						Message:             fmt.Sprintf("json type err: %s", err.Error()),
+						UpdatedOrganization: models.Organization{},
+					},
+				)
+				return
+			} else {
+				logger.Error("unexpected error", slog.String("err", err.Error()))
+				ginContext.JSON(http.StatusInternalServerError, schema.EditOrganizationResponse{Message: "internal error", UpdatedOrganization: models.Organization{}})
+				return
+			}
+		}
+		logger.Info("success unmarshal request")
+
+		validate := validator.New(validator.WithRequiredStructEnabled())
+		err = validate.Struct(&editReq)
+		if err != nil {
+			logger.Error("validation error", slog.String("err", err.Error()))
+			ginContext.JSON(
+				http.StatusBadRequest,
+				schema.EditOrganizationResponse{
+					Message:             fmt.Sprintf("validation failed: %s", err.Error()),
+					UpdatedOrganization: models.Organization{},
+				},
+			)
+			return
+		}
+		logger.Info("validate success")
+
+		organization, err := orgSrv.organizationService.EditOrganization(ctx, orgId, editReq.UpdateOrganizationData)
+		if err != nil {
+			if errors.Is(err, outerror.ErrNothingToUpdate) {
+				logger.Warn("nothing to update")
+				ginContext.JSON(http.StatusBadRequest, schema.EditOrganizationResponse{Message: "nothing to update", UpdatedOrganization: models.Organization{}})
+				return
+			} else if errors.Is(err, outerror.ErrUnknownOrganizationType) {
+				logger.Warn(fmt.Sprintf("organization type <%s> is unknown", *editReq.UpdateOrganizationData.Type))
+				ginContext.JSON(
+					http.StatusBadRequest,
+					schema.EditOrganizationResponse{
+						Message:             fmt.Sprintf("organization type=<%s> is unknown", *editReq.UpdateOrganizationData.Type),
+						UpdatedOrganization: models.Organization{},
+					},
+				)
+				return
+			} else if errors.Is(err, outerror.ErrOrganizationNotFound) {
+				logger.Warn(fmt.Sprintf("organization with id=<%d> not found", orgId))
+				ginContext.JSON(
+					http.StatusNotFound,
+					schema.EditOrganizationResponse{
+						Message:             fmt.Sprintf("organization with id=<%d> not found", orgId),
+						UpdatedOrganization: models.Organization{},
+					},
+				)
+				return
+			} else {
+				logger.Error("unexpected error", slog.String("err", err.Error()))
+				ginContext.JSON(http.StatusInternalServerError, schema.EditOrganizationResponse{Message: "internal error", UpdatedOrganization: models.Organization{}})
+				return
+			}
+		}
+		logger.Info("organization updated success")
+		ginContext.JSON(http.StatusOK, schema.EditOrganizationResponse{Message: "ok", UpdatedOrganization: organization})
+	}
+}
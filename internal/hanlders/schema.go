@@ -1,10 +1,22 @@
 package schema
 
-import "github.com/sariya23/tender/internal/domain/models"
+import (
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/domain/tenderstatus"
+	"github.com/sariya23/tender/internal/lib/problem"
+)
 
 type GetTendersResponse struct {
-	Tenders []models.Tender `json:"tenders"`
-	Message string          `json:"message"`
+	Tenders    []models.Tender `json:"tenders"`
+	Total      int             `json:"total"`
+	NextCursor *int            `json:"next_cursor"`
+	Message    string          `json:"message"`
+}
+
+type SearchTendersResponse struct {
+	Results []models.TenderSearchResult `json:"results"`
+	Total   int                         `json:"total"`
+	Message string                      `json:"message"`
 }
 
 type CreateTenderRequest struct {
@@ -16,14 +28,42 @@ type CreateTenderResponse struct {
 	Message string        `json:"message"`
 }
 
+// Статусы строк отчета об импорте тендеров.
+const (
+	ImportRowCreated = "created"
+	ImportRowValid   = "valid"
+	ImportRowFailed  = "failed"
+)
+
+// ImportTenderRow результат импорта строки Line файла. Tender - созданный
+// тендер (при dry run - проверенный), Error - почему строка не импортирована.
+type ImportTenderRow struct {
+	Line   int              `json:"line"`
+	Status string           `json:"status"`
+	Tender *models.Tender   `json:"tender,omitempty"`
+	Error  *problem.Details `json:"error,omitempty"`
+}
+
+type ImportTendersResponse struct {
+	DryRun    bool              `json:"dry_run"`
+	Total     int               `json:"total"`
+	Succeeded int               `json:"succeeded"`
+	Failed    int               `json:"failed"`
+	Rows      []ImportTenderRow `json:"rows"`
+	Message   string            `json:"message"`
+}
+
 type GetEmployeeTendersResponse struct {
-	Tenders []models.Tender `json:"tenders"`
-	Message string          `json:"message"`
+	Tenders    []models.Tender `json:"tenders"`
+	Total      int             `json:"total"`
+	NextCursor *int            `json:"next_cursor"`
+	Message    string          `json:"message"`
 }
 
 type EditTenderRequest struct {
 	UpdateTenderData models.TenderToUpdate `json:"update_tender_data"`
-	Username         string                `json:"username" validate:"required"`
+	Username         string                `json:"username,omitempty"`
+	ExpectedVersion  int                   `json:"expected_version" validate:"gte=0"`
 }
 
 type EditTenderResponse struct {
@@ -32,10 +72,221 @@ type EditTenderResponse struct {
 }
 
 type RollbackTenderRequest struct {
-	Username string `json:"username" validate:"required"`
+	Username        string `json:"username,omitempty"`
+	ExpectedVersion int    `json:"expected_version" validate:"gte=0"`
 }
 
 type RollbackTenderResponse struct {
 	RollbackTender models.Tender `json:"rollback_tender"`
 	Message        string        `json:"message"`
 }
+
+type GetTenderVersionsResponse struct {
+	Versions []models.TenderVersion `json:"versions"`
+	Message  string                 `json:"message"`
+}
+
+type GetTenderVersionResponse struct {
+	TenderVersion models.TenderVersion `json:"tender_version"`
+	Message       string               `json:"message"`
+}
+
+type DiffTenderVersionsResponse struct {
+	Diff    models.TenderDiff `json:"diff"`
+	Message string            `json:"message"`
+}
+
+type VoteCloseTenderRequest struct {
+	Username string `json:"username" validate:"required"`
+	Decision string `json:"decision" validate:"required"`
+}
+
+type VoteCloseTenderResponse struct {
+	Voting  models.TenderCloseVoting `json:"voting"`
+	Message string                   `json:"message"`
+}
+
+type GetTenderStatusResponse struct {
+	TenderStatus tenderstatus.State `json:"tender_status"`
+	Message      string             `json:"message"`
+}
+
+type SetTenderStatusRequest struct {
+	Status          string `json:"status" validate:"required"`
+	Reason          string `json:"reason"`
+	Username        string `json:"username,omitempty"`
+	ExpectedVersion int    `json:"expected_version" validate:"gte=0"`
+}
+
+type SetTenderStatusResponse struct {
+	Tender  models.Tender `json:"tender"`
+	Message string        `json:"message"`
+}
+
+type CreateBidRequest struct {
+	Bid models.Bid `json:"bid"`
+}
+
+type CreateBidResponse struct {
+	Bid     models.Bid `json:"bid"`
+	Message string     `json:"message"`
+}
+
+type GetTenderBidsResponse struct {
+	Bids    []models.Bid `json:"bids"`
+	Message string       `json:"message"`
+}
+
+type GetEmployeeBidsResponse struct {
+	Bids    []models.Bid `json:"bids"`
+	Message string       `json:"message"`
+}
+
+type EditBidRequest struct {
+	UpdateBidData models.BidToUpdate `json:"update_bid_data"`
+	Username      string             `json:"username" validate:"required"`
+}
+
+type EditBidResponse struct {
+	UpdatedBid models.Bid `json:"updated_bid"`
+	Message    string     `json:"message"`
+}
+
+type RollbackBidRequest struct {
+	Username string `json:"username" validate:"required"`
+}
+
+type RollbackBidResponse struct {
+	RollbackBid models.Bid `json:"rollback_bid"`
+	Message     string     `json:"message"`
+}
+
+type CreateOrganizationRequest struct {
+	Organization models.Organization `json:"organization"`
+}
+
+type CreateOrganizationResponse struct {
+	Organization models.Organization `json:"organization"`
+	Message      string              `json:"message"`
+}
+
+type GetOrganizationResponse struct {
+	Organization models.Organization `json:"organization"`
+	Message      string              `json:"message"`
+}
+
+type GetOrganizationsResponse struct {
+	Organizations []models.Organization `json:"organizations"`
+	Total         int                   `json:"total"`
+	NextCursor    *int                  `json:"next_cursor"`
+	Message       string                `json:"message"`
+}
+
+type EditOrganizationRequest struct {
+	UpdateOrganizationData models.OrganizationToUpdate `json:"update_organization_data"`
+}
+
+type EditOrganizationResponse struct {
+	UpdatedOrganization models.Organization `json:"updated_organization"`
+	Message             string              `json:"message"`
+}
+
+type DeleteOrganizationResponse struct {
+	Message string `json:"message"`
+}
+
+type CreateEmployeeRequest struct {
+	Employee models.Employee `json:"employee"`
+}
+
+type CreateEmployeeResponse struct {
+	Employee models.Employee `json:"employee"`
+	Message  string          `json:"message"`
+}
+
+type GetEmployeeResponse struct {
+	Employee models.Employee `json:"employee"`
+	Message  string          `json:"message"`
+}
+
+type GetEmployeesResponse struct {
+	Employees  []models.Employee `json:"employees"`
+	Total      int               `json:"total"`
+	NextCursor *int              `json:"next_cursor"`
+	Message    string            `json:"message"`
+}
+
+type EditEmployeeRequest struct {
+	UpdateEmployeeData models.EmployeeToUpdate `json:"update_employee_data"`
+}
+
+type EditEmployeeResponse struct {
+	UpdatedEmployee models.Employee `json:"updated_employee"`
+	Message         string          `json:"message"`
+}
+
+type GetOrganizationResponsiblesResponse struct {
+	Responsibles []models.Employee `json:"responsibles"`
+	Message      string            `json:"message"`
+}
+
+type GrantResponsibilityRequest struct {
+	EmployeeId int    `json:"employee_id" validate:"required,gt=0"`
+	Role       string `json:"role" validate:"omitempty,oneof=ADMIN MEMBER"`
+}
+
+type GrantResponsibilityResponse struct {
+	Message string `json:"message"`
+}
+
+type RevokeResponsibilityResponse struct {
+	Message string `json:"message"`
+}
+
+type GetAPIKeysResponse struct {
+	APIKeys []models.APIKey `json:"api_keys"`
+	Message string          `json:"message"`
+}
+
+type IssueAPIKeyRequest struct {
+	Name   string   `json:"name" validate:"required,max=100"`
+	Scopes []string `json:"scopes" validate:"required,min=1"`
+}
+
+type IssueAPIKeyResponse struct {
+	APIKey  models.APIKey `json:"api_key"`
+	Key     string        `json:"key"`
+	Message string        `json:"message"`
+}
+
+type RevokeAPIKeyResponse struct {
+	APIKey  models.APIKey `json:"api_key"`
+	Message string        `json:"message"`
+}
+
+type GetServiceTypesResponse struct {
+	ServiceTypes []models.ServiceType `json:"service_types"`
+	Message      string               `json:"message"`
+}
+
+type CreateServiceTypeRequest struct {
+	Name string `json:"name" validate:"required,max=50"`
+}
+
+type CreateServiceTypeResponse struct {
+	ServiceType models.ServiceType `json:"service_type"`
+	Message     string             `json:"message"`
+}
+
+type EditServiceTypeRequest struct {
+	Name string `json:"name" validate:"required,max=50"`
+}
+
+type EditServiceTypeResponse struct {
+	UpdatedServiceType models.ServiceType `json:"updated_service_type"`
+	Message            string             `json:"message"`
+}
+
+type DeleteServiceTypeResponse struct {
+	Message string `json:"message"`
+}
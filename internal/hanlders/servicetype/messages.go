@@ -0,0 +1,84 @@
+package servicetypeapi
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/sariya23/tender/internal/lib/i18n"
+	"github.com/sariya23/tender/internal/lib/problem"
+	"github.com/sariya23/tender/internal/lib/unmarshal"
+	"github.com/sariya23/tender/internal/lib/validation"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+// Ключи сообщений хендлеров справочника типов услуг.
+const (
+	msgOK              = "service_type.ok"
+	msgJSONSyntax      = "service_type.json_syntax"
+	msgJSONType        = "service_type.json_type"
+	msgPathNotInteger  = "service_type.path_not_integer"
+	msgPathNotPositive = "service_type.path_not_positive"
+)
+
+// messages каталоги сообщений хендлеров справочника типов услуг.
+var messages = map[string]i18n.Catalog{
+	i18n.English: {
+		msgOK:              "ok",
+		msgJSONSyntax:      "json syntax err: {0}",
+		msgJSONType:        "json type err: {0}",
+		msgPathNotInteger:  "cannot convert {0} to integer",
+		msgPathNotPositive: "{0} must be positive integer",
+	},
+	i18n.Russian: {
+		msgOK:              "ок",
+		msgJSONSyntax:      "синтаксическая ошибка json: {0}",
+		msgJSONType:        "ошибка типа в json: {0}",
+		msgPathNotInteger:  "{0} должен быть числом",
+		msgPathNotPositive: "{0} должен быть положительным целым числом",
+	},
+}
+
+// translator возвращает переводчик на язык из заголовка Accept-Language.
+func (serviceTypeSrv *ServiceTypeService) translator(ginContext *gin.Context) ut.Translator {
+	return serviceTypeSrv.translations.Translator(ginContext.GetHeader("Accept-Language"))
+}
+
+// message возвращает сообщение key на языке клиента.
+func (serviceTypeSrv *ServiceTypeService) message(ginContext *gin.Context, key string, params ...string) string {
+	return i18n.T(serviceTypeSrv.translator(ginContext), key, params...)
+}
+
+// bodyProblem переводит ошибку разбора тела запроса в ошибку для ответа.
+func (serviceTypeSrv *ServiceTypeService) bodyProblem(ginContext *gin.Context, err error) error {
+	if errors.Is(err, unmarshal.ErrSyntax) {
+		return problem.New(outerror.ErrInvalidRequest, serviceTypeSrv.message(ginContext, msgJSONSyntax, err.Error()))
+	} else if errors.Is(err, unmarshal.ErrType) {
+		return problem.New(outerror.ErrInvalidRequest, serviceTypeSrv.message(ginContext, msgJSONType, err.Error()))
+	}
+	return fmt.Errorf("cannot unmarshal request: %w", err)
+}
+
+// validationProblem переводит ошибку валидатора в ошибку с ошибками полей.
+func (serviceTypeSrv *ServiceTypeService) validationProblem(ginContext *gin.Context, err error) error {
+	return &problem.Error{
+		Err:    outerror.ErrValidationFailed,
+		Fields: validation.FieldErrors(err, serviceTypeSrv.translator(ginContext)),
+	}
+}
+
+// serviceTypeId читает id типа услуг из параметра пути serviceTypeId.
+// Если параметр некорректный, то возвращается ErrServiceTypeNotFound.
+func (serviceTypeSrv *ServiceTypeService) serviceTypeId(ginContext *gin.Context) (int, error) {
+	const name = "serviceTypeId"
+	id, err := strconv.Atoi(ginContext.Param(name))
+	if err != nil {
+		return 0, problem.New(outerror.ErrServiceTypeNotFound, serviceTypeSrv.message(ginContext, msgPathNotInteger, name))
+	}
+	if id <= 0 {
+		return 0, problem.New(outerror.ErrServiceTypeNotFound, serviceTypeSrv.message(ginContext, msgPathNotPositive, name))
+	}
+	return id, nil
+}
@@ -0,0 +1,43 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockServiceTypeServiceProvider реализует интерфейс ServiceTypeServiceProvider
+// для целей тестирования. Он позволяет задавать ожидаемые результаты
+// методов:
+//
+// - GetServiceTypes
+//
+// - CreateServiceType
+//
+// - EditServiceType
+//
+// - DeleteServiceType
+type MockServiceTypeServiceProvider struct {
+	mock.Mock
+}
+
+func (m *MockServiceTypeServiceProvider) GetServiceTypes(ctx context.Context) ([]models.ServiceType, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]models.ServiceType), args.Error(1)
+}
+
+func (m *MockServiceTypeServiceProvider) CreateServiceType(ctx context.Context, employeeId int, name string) (models.ServiceType, error) {
+	args := m.Called(ctx, employeeId, name)
+	return args.Get(0).(models.ServiceType), args.Error(1)
+}
+
+func (m *MockServiceTypeServiceProvider) EditServiceType(ctx context.Context, employeeId int, serviceTypeId int, name string) (models.ServiceType, error) {
+	args := m.Called(ctx, employeeId, serviceTypeId, name)
+	return args.Get(0).(models.ServiceType), args.Error(1)
+}
+
+func (m *MockServiceTypeServiceProvider) DeleteServiceType(ctx context.Context, employeeId int, serviceTypeId int) error {
+	args := m.Called(ctx, employeeId, serviceTypeId)
+	return args.Error(0)
+}
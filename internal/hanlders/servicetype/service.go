@@ -0,0 +1,35 @@
+package servicetypeapi
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/lib/i18n"
+	"github.com/sariya23/tender/internal/lib/validation"
+)
+
+type ServiceTypeServiceProvider interface {
+	GetServiceTypes(ctx context.Context) ([]models.ServiceType, error)
+	CreateServiceType(ctx context.Context, employeeId int, name string) (models.ServiceType, error)
+	EditServiceType(ctx context.Context, employeeId int, serviceTypeId int, name string) (models.ServiceType, error)
+	DeleteServiceType(ctx context.Context, employeeId int, serviceTypeId int) error
+}
+
+type ServiceTypeService struct {
+	logger             *slog.Logger
+	serviceTypeService ServiceTypeServiceProvider
+	validate           *validator.Validate
+	translations       *i18n.Translations
+}
+
+func New(logger *slog.Logger, serviceTypeService ServiceTypeServiceProvider) *ServiceTypeService {
+	translations := i18n.MustNew(messages)
+	return &ServiceTypeService{
+		logger:             logger,
+		serviceTypeService: serviceTypeService,
+		validate:           validation.New(translations),
+		translations:       translations,
+	}
+}
@@ -0,0 +1,182 @@
+package servicetypeapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	schema "github.com/sariya23/tender/internal/hanlders"
+	"github.com/sariya23/tender/internal/lib/unmarshal"
+	"github.com/sariya23/tender/internal/middleware"
+)
+
+func (serviceTypeSrv *ServiceTypeService) GetServiceTypes(ctx context.Context) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		const operationPlace = "internal.api.servicetypeapi.GetServiceTypes"
+		logger := serviceTypeSrv.logger.With("op", operationPlace)
+		logger.Info(fmt.Sprintf("request to %v", ginContext.Request.URL))
+
+		serviceTypes, err := serviceTypeSrv.serviceTypeService.GetServiceTypes(ctx)
+		if err != nil {
+			logger.Error("cannot get service types", slog.String("err", err.Error()))
+			ginContext.Error(err)
+			return
+		}
+		logger.Info("send success response")
+		ginContext.JSON(http.StatusOK, schema.GetServiceTypesResponse{Message: serviceTypeSrv.message(ginContext, msgOK), ServiceTypes: serviceTypes})
+	}
+}
+
+func (serviceTypeSrv *ServiceTypeService) CreateServiceType(ctx context.Context) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		const operationPlace = "internal.api.servicetypeapi.CreateServiceType"
+		logger := serviceTypeSrv.logger.With("op", operationPlace)
+		logger.Info(fmt.Sprintf("request to %v", ginContext.Request.URL))
+
+		employee, ok := middleware.EmployeeFromContext(ginContext.Request.Context())
+		if !ok {
+			logger.Warn("request is not authenticated")
+			ginContext.Error(middleware.ErrNotAuthenticated)
+			return
+		}
+
+		body := ginContext.Request.Body
+		defer func() {
+			err := body.Close()
+			if err != nil {
+				logger.Error("cannot close body", slog.String("err", err.Error()))
+			}
+		}()
+
+		bodyData, err := io.ReadAll(body)
+		if err != nil {
+			logger.Error("cannot read body", slog.String("err", err.Error()))
+			ginContext.Error(fmt.Errorf("cannot read body: %w", err))
+			return
+		}
+		logger.Info("success read body")
+		createReq, err := unmarshal.CreateServiceTypeRequest(bodyData)
+		if err != nil {
+			logger.Warn("cannot unmarshal request", slog.String("err", err.Error()))
+			ginContext.Error(serviceTypeSrv.bodyProblem(ginContext, err))
+			return
+		}
+		logger.Info("success unmarshal request")
+
+		createReq.Name = strings.TrimSpace(createReq.Name)
+		err = serviceTypeSrv.validate.Struct(&createReq)
+		if err != nil {
+			logger.Warn("validation error", slog.String("err", err.Error()))
+			ginContext.Error(serviceTypeSrv.validationProblem(ginContext, err))
+			return
+		}
+		logger.Info("validate success")
+
+		serviceType, err := serviceTypeSrv.serviceTypeService.CreateServiceType(ctx, employee.ID, createReq.Name)
+		if err != nil {
+			logger.Warn("cannot create service type", slog.String("err", err.Error()))
+			ginContext.Error(err)
+			return
+		}
+		logger.Info("service type created success")
+		ginContext.JSON(http.StatusOK, schema.CreateServiceTypeResponse{Message: serviceTypeSrv.message(ginContext, msgOK), ServiceType: serviceType})
+	}
+}
+
+func (serviceTypeSrv *ServiceTypeService) EditServiceType(ctx context.Context) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		const operationPlace = "internal.api.servicetypeapi.EditServiceType"
+		logger := serviceTypeSrv.logger.With("op", operationPlace)
+		logger.Info(fmt.Sprintf("request to %v", ginContext.Request.URL))
+
+		employee, ok := middleware.EmployeeFromContext(ginContext.Request.Context())
+		if !ok {
+			logger.Warn("request is not authenticated")
+			ginContext.Error(middleware.ErrNotAuthenticated)
+			return
+		}
+
+		serviceTypeId, err := serviceTypeSrv.serviceTypeId(ginContext)
+		if err != nil {
+			logger.Warn("invalid service type id", slog.String("err", err.Error()))
+			ginContext.Error(err)
+			return
+		}
+
+		body := ginContext.Request.Body
+		defer func() {
+			err := body.Close()
+			if err != nil {
+				logger.Error("cannot close body", slog.String("err", err.Error()))
+			}
+		}()
+
+		bodyData, err := io.ReadAll(body)
+		if err != nil {
+			logger.Error("cannot read body", slog.String("err", err.Error()))
+			ginContext.Error(fmt.Errorf("cannot read body: %w", err))
+			return
+		}
+		logger.Info("success read body")
+		editReq, err := unmarshal.EditServiceTypeRequest(bodyData)
+		if err != nil {
+			logger.Warn("cannot unmarshal request", slog.String("err", err.Error()))
+			ginContext.Error(serviceTypeSrv.bodyProblem(ginContext, err))
+			return
+		}
+		logger.Info("success unmarshal request")
+
+		editReq.Name = strings.TrimSpace(editReq.Name)
+		err = serviceTypeSrv.validate.Struct(&editReq)
+		if err != nil {
+			logger.Warn("validation error", slog.String("err", err.Error()))
+			ginContext.Error(serviceTypeSrv.validationProblem(ginContext, err))
+			return
+		}
+		logger.Info("validate success")
+
+		serviceType, err := serviceTypeSrv.serviceTypeService.EditServiceType(ctx, employee.ID, serviceTypeId, editReq.Name)
+		if err != nil {
+			logger.Warn("cannot edit service type", slog.Int("service type id", serviceTypeId), slog.String("err", err.Error()))
+			ginContext.Error(err)
+			return
+		}
+		logger.Info("service type edited success")
+		ginContext.JSON(http.StatusOK, schema.EditServiceTypeResponse{Message: serviceTypeSrv.message(ginContext, msgOK), UpdatedServiceType: serviceType})
+	}
+}
+
+func (serviceTypeSrv *ServiceTypeService) DeleteServiceType(ctx context.Context) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		const operationPlace = "internal.api.servicetypeapi.DeleteServiceType"
+		logger := serviceTypeSrv.logger.With("op", operationPlace)
+		logger.Info(fmt.Sprintf("request to %v", ginContext.Request.URL))
+
+		employee, ok := middleware.EmployeeFromContext(ginContext.Request.Context())
+		if !ok {
+			logger.Warn("request is not authenticated")
+			ginContext.Error(middleware.ErrNotAuthenticated)
+			return
+		}
+
+		serviceTypeId, err := serviceTypeSrv.serviceTypeId(ginContext)
+		if err != nil {
+			logger.Warn("invalid service type id", slog.String("err", err.Error()))
+			ginContext.Error(err)
+			return
+		}
+
+		err = serviceTypeSrv.serviceTypeService.DeleteServiceType(ctx, employee.ID, serviceTypeId)
+		if err != nil {
+			logger.Warn("cannot delete service type", slog.Int("service type id", serviceTypeId), slog.String("err", err.Error()))
+			ginContext.Error(err)
+			return
+		}
+		logger.Info("service type deleted success")
+		ginContext.JSON(http.StatusOK, schema.DeleteServiceTypeResponse{Message: serviceTypeSrv.message(ginContext, msgOK)})
+	}
+}
@@ -0,0 +1,228 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sariya23/tender/internal/domain/models"
+	servicetypeapi "github.com/sariya23/tender/internal/hanlders/servicetype"
+	"github.com/sariya23/tender/internal/hanlders/servicetype/mocks"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	"github.com/sariya23/tender/internal/lib/problem"
+	"github.com/sariya23/tender/internal/middleware"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/stretchr/testify/require"
+)
+
+// newRouter возвращает роутер, который, как и приложение, отдает
+// ошибки через middleware.Problems, с middleware middlewares.
+func newRouter(middlewares ...gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(middleware.Problems(slogdiscard.NewDiscardLogger(), outerror.Catalog))
+	router.Use(middlewares...)
+	return router
+}
+
+// authenticatedAs кладет в контекст запроса сотрудника с id employeeId,
+// как это делает middleware аутентификации.
+func authenticatedAs(employeeId int) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		ctx := middleware.ContextWithEmployee(ginContext.Request.Context(), models.Employee{ID: employeeId})
+		ginContext.Request = ginContext.Request.WithContext(ctx)
+	}
+}
+
+// requireProblem проверяет, что в ответе ошибка с кодом code
+// и статусом status, и возвращает тело ответа.
+func requireProblem(t *testing.T, w *httptest.ResponseRecorder, status int, code string) problem.Details {
+	t.Helper()
+	require.Equal(t, status, w.Code)
+	require.Equal(t, problem.ContentType, w.Header().Get("Content-Type"))
+	var details problem.Details
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &details))
+	require.Equal(t, code, details.Code)
+	return details
+}
+
+// TestGetServiceTypes_Success проверяет, что список типов услуг
+// отдается с количеством опубликованных тендеров.
+func TestGetServiceTypes_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockServiceTypeService := new(mocks.MockServiceTypeServiceProvider)
+	handlers := servicetypeapi.New(slogdiscard.NewDiscardLogger(), mockServiceTypeService)
+	mockServiceTypeService.On("GetServiceTypes", ctx).Return([]models.ServiceType{
+		{ID: 1, Name: "Construction", PublishedTenders: 3},
+		{ID: 2, Name: "Delivery", PublishedTenders: 0},
+	}, nil)
+	expectedBody := `
+	{
+		"service_types": [
+			{"id": 1, "name": "Construction", "published_tenders": 3},
+			{"id": 2, "name": "Delivery", "published_tenders": 0}
+		],
+		"message": "ok"
+	}`
+	router := newRouter()
+	router.GET("/api/service-types", handlers.GetServiceTypes(ctx))
+	req := httptest.NewRequest(http.MethodGet, "/api/service-types", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestCreateServiceType_Success проверяет, что тип услуг создается
+// от имени аутентифицированного сотрудника, а пробелы вокруг
+// названия отбрасываются.
+func TestCreateServiceType_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockServiceTypeService := new(mocks.MockServiceTypeServiceProvider)
+	handlers := servicetypeapi.New(slogdiscard.NewDiscardLogger(), mockServiceTypeService)
+	mockServiceTypeService.On("CreateServiceType", ctx, 7, "Consulting").Return(models.ServiceType{ID: 4, Name: "Consulting"}, nil)
+	router := newRouter(authenticatedAs(7))
+	router.POST("/api/service-types/new", handlers.CreateServiceType(ctx))
+	req := httptest.NewRequest(http.MethodPost, "/api/service-types/new", strings.NewReader(`{"name": " Consulting "}`))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"service_type": {"id": 4, "name": "Consulting", "published_tenders": 0}, "message": "ok"}`, w.Body.String())
+}
+
+// TestCreateServiceType_FailEmptyName проверяет, что название
+// из одних пробелов не проходит валидацию.
+func TestCreateServiceType_FailEmptyName(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockServiceTypeService := new(mocks.MockServiceTypeServiceProvider)
+	handlers := servicetypeapi.New(slogdiscard.NewDiscardLogger(), mockServiceTypeService)
+	router := newRouter(authenticatedAs(7))
+	router.POST("/api/service-types/new", handlers.CreateServiceType(ctx))
+	req := httptest.NewRequest(http.MethodPost, "/api/service-types/new", strings.NewReader(`{"name": "  "}`))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	details := requireProblem(t, w, http.StatusBadRequest, "validation_failed")
+	require.Equal(t, []problem.FieldError{{Pointer: "/name", Rule: "required", Message: "value is required"}}, details.Errors)
+	mockServiceTypeService.AssertNotCalled(t, "CreateServiceType")
+}
+
+// TestCreateServiceType_FailNotSystemAdmin проверяет, что если сотрудник
+// не системный администратор, то возвращается 403.
+func TestCreateServiceType_FailNotSystemAdmin(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockServiceTypeService := new(mocks.MockServiceTypeServiceProvider)
+	handlers := servicetypeapi.New(slogdiscard.NewDiscardLogger(), mockServiceTypeService)
+	mockServiceTypeService.On("CreateServiceType", ctx, 7, "Consulting").Return(models.ServiceType{}, outerror.ErrEmployeeNotSystemAdmin)
+	router := newRouter(authenticatedAs(7))
+	router.POST("/api/service-types/new", handlers.CreateServiceType(ctx))
+	req := httptest.NewRequest(http.MethodPost, "/api/service-types/new", strings.NewReader(`{"name": "Consulting"}`))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	requireProblem(t, w, http.StatusForbidden, "employee_not_system_admin")
+}
+
+// TestCreateServiceType_FailNotAuthenticated проверяет, что без
+// аутентификации тип услуг не создается.
+func TestCreateServiceType_FailNotAuthenticated(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockServiceTypeService := new(mocks.MockServiceTypeServiceProvider)
+	handlers := servicetypeapi.New(slogdiscard.NewDiscardLogger(), mockServiceTypeService)
+	router := newRouter()
+	router.POST("/api/service-types/new", handlers.CreateServiceType(ctx))
+	req := httptest.NewRequest(http.MethodPost, "/api/service-types/new", strings.NewReader(`{"name": "Consulting"}`))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	requireProblem(t, w, http.StatusUnauthorized, "not_authenticated")
+	mockServiceTypeService.AssertNotCalled(t, "CreateServiceType")
+}
+
+// TestEditServiceType_FailAlreadyExists проверяет, что переименование
+// в занятое название возвращает 409.
+func TestEditServiceType_FailAlreadyExists(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockServiceTypeService := new(mocks.MockServiceTypeServiceProvider)
+	handlers := servicetypeapi.New(slogdiscard.NewDiscardLogger(), mockServiceTypeService)
+	mockServiceTypeService.On("EditServiceType", ctx, 7, 2, "construction").Return(models.ServiceType{}, outerror.ErrServiceTypeAlreadyExists)
+	router := newRouter(authenticatedAs(7))
+	router.PATCH("/api/service-types/:serviceTypeId/edit", handlers.EditServiceType(ctx))
+	req := httptest.NewRequest(http.MethodPatch, "/api/service-types/2/edit", strings.NewReader(`{"name": "construction"}`))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	requireProblem(t, w, http.StatusConflict, "service_type_already_exists")
+}
+
+// TestDeleteServiceType_FailInvalidId проверяет, что на некорректный
+// id в пути возвращается 404 с пояснением на языке клиента.
+func TestDeleteServiceType_FailInvalidId(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockServiceTypeService := new(mocks.MockServiceTypeServiceProvider)
+	handlers := servicetypeapi.New(slogdiscard.NewDiscardLogger(), mockServiceTypeService)
+	router := newRouter(authenticatedAs(7))
+	router.DELETE("/api/service-types/:serviceTypeId", handlers.DeleteServiceType(ctx))
+	req := httptest.NewRequest(http.MethodDelete, "/api/service-types/qwe", nil)
+	req.Header.Set("Accept-Language", "ru")
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	details := requireProblem(t, w, http.StatusNotFound, "service_type_not_found")
+	require.Equal(t, "serviceTypeId должен быть числом", details.Detail)
+	mockServiceTypeService.AssertNotCalled(t, "DeleteServiceType")
+}
+
+// TestDeleteServiceType_FailInUse проверяет, что удаление типа,
+// который есть у тендеров, возвращает 409.
+func TestDeleteServiceType_FailInUse(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockServiceTypeService := new(mocks.MockServiceTypeServiceProvider)
+	handlers := servicetypeapi.New(slogdiscard.NewDiscardLogger(), mockServiceTypeService)
+	mockServiceTypeService.On("DeleteServiceType", ctx, 7, 1).Return(outerror.ErrServiceTypeInUse)
+	router := newRouter(authenticatedAs(7))
+	router.DELETE("/api/service-types/:serviceTypeId", handlers.DeleteServiceType(ctx))
+	req := httptest.NewRequest(http.MethodDelete, "/api/service-types/1", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	requireProblem(t, w, http.StatusConflict, "service_type_in_use")
+}
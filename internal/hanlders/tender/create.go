@@ -2,18 +2,15 @@ package tenderapi
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"io"
 	"log/slog"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
-	"github.com/go-playground/validator/v10"
-	"github.com/sariya23/tender/internal/domain/models"
 	schema "github.com/sariya23/tender/internal/hanlders"
 	"github.com/sariya23/tender/internal/lib/unmarshal"
-	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/sariya23/tender/internal/middleware"
 )
 
 func (tenderSrv *TenderService) CreateTender(ctx context.Context) gin.HandlerFunc {
@@ -33,119 +30,40 @@ func (tenderSrv *TenderService) CreateTender(ctx context.Context) gin.HandlerFun
 		bodyData, err := io.ReadAll(body)
 		if err != nil {
 			logger.Error("cannot read body", slog.String("err", err.Error()))
-			ginContext.JSON(http.StatusInternalServerError, schema.CreateTenderResponse{Message: "internal error", Tender: models.Tender{}})
+			ginContext.Error(fmt.Errorf("cannot read body: %w", err))
 			return
 		}
 		logger.Info("success read body")
 		createReq, err := unmarshal.CreateRequest([]byte(bodyData))
 		if err != nil {
-			if errors.Is(err, unmarshal.ErrSyntax) {
-				logger.Warn("req syntax error", slog.String("err", err.Error()))
-				ginContext.JSON(
-					http.StatusBadRequest,
-					schema.CreateTenderResponse{
-						Message: fmt.Sprintf("json syntax err: %s", err.Error()),
-						Tender:  models.Tender{},
-					},
-				)
-				return
-			} else if errors.Is(err, unmarshal.ErrType) {
-				logger.Warn("req type error", slog.String("err", err.Error()))
-				ginContext.JSON(
-					http.StatusBadRequest,
-					schema.CreateTenderResponse{
-						Message: fmt.Sprintf("json type err: %s", err.Error()),
-						Tender:  models.Tender{},
-					},
-				)
-				return
-			} else {
-				logger.Error("unexpected error", slog.String("err", err.Error()))
-				ginContext.JSON(http.StatusInternalServerError, schema.CreateTenderResponse{Message: "internal error", Tender: models.Tender{}})
-				return
-			}
+			logger.Warn("cannot unmarshal request", slog.String("err", err.Error()))
+			ginContext.Error(tenderSrv.bodyProblem(ginContext, err))
+			return
 		}
 		logger.Info("success unmarshal request")
 
-		validate := validator.New(validator.WithRequiredStructEnabled())
-		err = validate.Struct(&createReq)
+		createReq.Tender.CreatorUsername, err = middleware.ActingUsername(ginContext, createReq.Tender.CreatorUsername)
+		if err != nil {
+			logger.Warn("cannot resolve acting employee", slog.String("err", err.Error()))
+			ginContext.Error(err)
+			return
+		}
+
+		err = tenderSrv.validate.Struct(&createReq)
 		if err != nil {
-			logger.Error("validation error", slog.String("err", err.Error()))
-			ginContext.JSON(
-				http.StatusBadRequest,
-				schema.CreateTenderResponse{
-					Message: fmt.Sprintf("validation failed: %s", err.Error()),
-					Tender:  models.Tender{},
-				},
-			)
+			logger.Warn("validation error", slog.String("err", err.Error()))
+			ginContext.Error(tenderSrv.validationProblem(ginContext, err))
 			return
 		}
 		logger.Info("validate success")
 		tender, err := tenderSrv.tenderService.CreateTender(ctx, createReq.Tender)
 		if err != nil {
-			if errors.Is(err, outerror.ErrEmployeeNotFound) {
-				logger.Warn("employee not found", slog.String("err", err.Error()))
-				ginContext.JSON(
-					http.StatusUnprocessableEntity,
-					schema.CreateTenderResponse{
-						Message: fmt.Sprintf(
-							"employee with username=<%s> not found",
-							createReq.Tender.CreatorUsername,
-						),
-						Tender: models.Tender{},
-					},
-				)
-				return
-			} else if errors.Is(err, outerror.ErrOrganizationNotFound) {
-				logger.Warn("organization not found", slog.String("err", err.Error()))
-				ginContext.JSON(
-					http.StatusUnprocessableEntity,
-					schema.CreateTenderResponse{
-						Message: fmt.Sprintf(
-							"organization with id=<%d> not found",
-							createReq.Tender.OrganizationId,
-						),
-						Tender: models.Tender{},
-					},
-				)
-				return
-			} else if errors.Is(err, outerror.ErrEmployeeNotResponsibleForOrganization) {
-				logger.Warn("employee not responsible for organization", slog.String("err", err.Error()))
-				ginContext.JSON(
-					http.StatusForbidden,
-					schema.CreateTenderResponse{
-						Message: fmt.Sprintf(
-							"employee <%s> not responsible for organization with id=<%d>",
-							createReq.Tender.CreatorUsername,
-							createReq.Tender.OrganizationId,
-						),
-						Tender: models.Tender{},
-					},
-				)
-				return
-			} else if errors.Is(err, outerror.ErrNewTenderCannotCreatedWithStatusNotCreated) {
-				logger.Warn("cannot create tender with status", slog.String("status", createReq.Tender.Status))
-				ginContext.JSON(
-					http.StatusBadRequest,
-					schema.CreateTenderResponse{
-						Message: fmt.Sprintf("cannot create tender with status <%s>", createReq.Tender.Status),
-						Tender:  models.Tender{},
-					},
-				)
-				return
-			} else {
-				logger.Error("unexpected error", slog.String("err", err.Error()))
-				ginContext.JSON(
-					http.StatusInternalServerError,
-					schema.CreateTenderResponse{
-						Message: "internal error",
-						Tender:  models.Tender{},
-					},
-				)
-				return
-			}
+			logger.Warn("cannot create tender", slog.String("err", err.Error()))
+			ginContext.Error(err)
+			return
 		}
 		logger.Info("tender created success")
-		ginContext.JSON(http.StatusOK, schema.CreateTenderResponse{Message: "ok", Tender: tender})
+		ginContext.Header("ETag", tenderETag(tender.Version))
+		ginContext.JSON(http.StatusOK, schema.CreateTenderResponse{Message: tenderSrv.message(ginContext, msgOK), Tender: tender})
 	}
 }
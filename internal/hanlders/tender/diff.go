@@ -0,0 +1,59 @@
+package tenderapi
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	schema "github.com/sariya23/tender/internal/hanlders"
+	"github.com/sariya23/tender/internal/lib/problem"
+	"github.com/sariya23/tender/internal/middleware"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+func (tenderSrv *TenderService) DiffTenderVersions(ctx context.Context) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		const operationPlace = "internal.api.tenderapi.DiffTenderVersions"
+		logger := tenderSrv.logger.With("op", operationPlace)
+		logger.Info(fmt.Sprintf("request to %v", ginContext.Request.URL))
+
+		tenderId, err := tenderSrv.pathId(ginContext, "tenderId", outerror.ErrTenderNotFound)
+		if err != nil {
+			logger.Warn("invalid tender id", slog.String("err", err.Error()))
+			ginContext.Error(err)
+			return
+		}
+
+		username, err := middleware.ActingUsername(ginContext, ginContext.Query("username"))
+		if err != nil {
+			logger.Warn("cannot resolve acting employee", slog.String("err", err.Error()))
+			ginContext.Error(err)
+			return
+		}
+
+		fromVersion, err := strconv.Atoi(ginContext.Query("from"))
+		if err != nil || fromVersion <= 0 {
+			logger.Warn("invalid from version", slog.String("from", ginContext.Query("from")))
+			ginContext.Error(problem.New(outerror.ErrInvalidRequest, tenderSrv.message(ginContext, msgFromNotPositive)))
+			return
+		}
+		toVersion, err := strconv.Atoi(ginContext.Query("to"))
+		if err != nil || toVersion <= 0 {
+			logger.Warn("invalid to version", slog.String("to", ginContext.Query("to")))
+			ginContext.Error(problem.New(outerror.ErrInvalidRequest, tenderSrv.message(ginContext, msgToNotPositive)))
+			return
+		}
+
+		diff, err := tenderSrv.tenderService.DiffTenderVersions(ctx, tenderId, fromVersion, toVersion, username)
+		if err != nil {
+			logger.Warn("cannot diff tender versions", slog.Int("tender id", tenderId), slog.String("err", err.Error()))
+			ginContext.Error(err)
+			return
+		}
+		logger.Info("success diff tender versions")
+		ginContext.JSON(http.StatusOK, schema.DiffTenderVersionsResponse{Message: tenderSrv.message(ginContext, msgOK), Diff: diff})
+	}
+}
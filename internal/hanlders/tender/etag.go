@@ -0,0 +1,40 @@
+package tenderapi
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	errInvalidIfMatch        = errors.New("If-Match header must contain tender version")
+	errExpectedVersionDiffer = errors.New("If-Match header and expected_version differ")
+)
+
+// tenderETag возвращает ETag тендера. ETag - это номер текущей версии тендера.
+func tenderETag(version int) string {
+	return fmt.Sprintf("%q", strconv.Itoa(version))
+}
+
+// expectedTenderVersion возвращает версию тендера, которую ожидает клиент.
+// Версию можно передать в заголовке If-Match (ETag из ответа) или в теле
+// запроса в поле expected_version. 0 - клиент ничего не ожидает.
+func expectedTenderVersion(ginContext *gin.Context, bodyVersion int) (int, error) {
+	ifMatch := strings.TrimSpace(ginContext.GetHeader("If-Match"))
+	if ifMatch == "" || ifMatch == "*" {
+		return bodyVersion, nil
+	}
+
+	ifMatch = strings.TrimPrefix(ifMatch, "W/")
+	version, err := strconv.Atoi(strings.Trim(ifMatch, `"`))
+	if err != nil || version <= 0 {
+		return 0, errInvalidIfMatch
+	}
+	if bodyVersion != 0 && bodyVersion != version {
+		return 0, errExpectedVersionDiffer
+	}
+	return version, nil
+}
@@ -0,0 +1,92 @@
+package tenderapi
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sariya23/tender/internal/domain/models"
+)
+
+var (
+	errInvalidOrganizationId = errors.New("organization_id must be positive integer")
+	errInvalidCreatedFrom    = errors.New("created_from must be RFC3339 date-time")
+	errInvalidCreatedTo      = errors.New("created_to must be RFC3339 date-time")
+	errInvalidCreatedRange   = errors.New("created_from must be before created_to")
+	errInvalidSort           = errors.New("sort must be one of id, name, created_at, service_type, optionally prefixed with -")
+	errAfterIdWithSort       = errors.New("after_id can be used only with sort by id")
+)
+
+// parseTenderFilter читает фильтр списка тендеров из query параметров.
+//
+// srv_type и status можно передать несколько раз или через запятую.
+// Значение srv_type=all означает любой тип услуг.
+func parseTenderFilter(ginContext *gin.Context) (models.TenderFilter, error) {
+	filter := models.TenderFilter{
+		CreatorUsername: ginContext.Query("creator_username"),
+		NameContains:    ginContext.Query("name"),
+		Viewer:          ginContext.Query("username"),
+	}
+
+	serviceTypes := queryList(ginContext, "srv_type")
+	for _, serviceType := range serviceTypes {
+		if serviceType == "all" {
+			serviceTypes = nil
+			break
+		}
+	}
+	filter.ServiceTypes = serviceTypes
+	filter.Statuses = queryList(ginContext, "status")
+
+	if orgId, ok := ginContext.GetQuery("organization_id"); ok {
+		convertedOrgId, err := strconv.Atoi(orgId)
+		if err != nil || convertedOrgId <= 0 {
+			return models.TenderFilter{}, errInvalidOrganizationId
+		}
+		filter.OrganizationId = convertedOrgId
+	}
+
+	if createdFrom, ok := ginContext.GetQuery("created_from"); ok {
+		parsedCreatedFrom, err := time.Parse(time.RFC3339, createdFrom)
+		if err != nil {
+			return models.TenderFilter{}, errInvalidCreatedFrom
+		}
+		filter.CreatedFrom = &parsedCreatedFrom
+	}
+	if createdTo, ok := ginContext.GetQuery("created_to"); ok {
+		parsedCreatedTo, err := time.Parse(time.RFC3339, createdTo)
+		if err != nil {
+			return models.TenderFilter{}, errInvalidCreatedTo
+		}
+		filter.CreatedTo = &parsedCreatedTo
+	}
+	if filter.CreatedFrom != nil && filter.CreatedTo != nil && filter.CreatedFrom.After(*filter.CreatedTo) {
+		return models.TenderFilter{}, errInvalidCreatedRange
+	}
+
+	if sort, ok := ginContext.GetQuery("sort"); ok {
+		parsedSort, ok := models.ParseTenderSort(sort)
+		if !ok {
+			return models.TenderFilter{}, errInvalidSort
+		}
+		filter.Sort = parsedSort
+	}
+	return filter, nil
+}
+
+// queryList возвращает непустые значения query параметра key,
+// переданные несколько раз или через запятую.
+func queryList(ginContext *gin.Context, key string) []string {
+	var values []string
+	for _, value := range ginContext.QueryArray(key) {
+		for _, part := range strings.Split(value, ",") {
+			part = strings.TrimSpace(part)
+			if part != "" {
+				values = append(values, part)
+			}
+		}
+	}
+	return values
+}
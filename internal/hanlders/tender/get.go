@@ -6,10 +6,14 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/sariya23/tender/internal/domain/models"
 	schema "github.com/sariya23/tender/internal/hanlders"
+	"github.com/sariya23/tender/internal/lib/pagequery"
+	"github.com/sariya23/tender/internal/lib/problem"
+	"github.com/sariya23/tender/internal/middleware"
 	outerror "github.com/sariya23/tender/internal/out_error"
 )
 
@@ -19,30 +23,67 @@ func (tenderSrv *TenderService) GetTenders(ctx context.Context) gin.HandlerFunc
 		logger := tenderSrv.logger.With("op", operationPlace)
 		logger.Info(fmt.Sprintf("request to %v", ginContext.Request.URL))
 
-		serviceType := ginContext.DefaultQuery("srv_type", "all")
-		tenders, err := tenderSrv.tenderService.GetTenders(ctx, serviceType)
+		page, err := pagequery.Parse(ginContext)
 		if err != nil {
-			if errors.Is(err, outerror.ErrTendersWithThisServiceTypeNotFound) {
+			logger.Warn("invalid page", slog.String("err", err.Error()))
+			ginContext.Error(tenderSrv.invalidRequest(ginContext, err))
+			return
+		}
+
+		filter, err := parseTenderFilter(ginContext)
+		if err != nil {
+			logger.Warn("invalid filter", slog.String("err", err.Error()))
+			ginContext.Error(tenderSrv.invalidRequest(ginContext, err))
+			return
+		}
+		if _, ok := middleware.EmployeeFromContext(ginContext.Request.Context()); ok || filter.Viewer != "" {
+			filter.Viewer, err = middleware.ActingUsername(ginContext, filter.Viewer)
+			if err != nil {
+				logger.Warn("cannot resolve viewer", slog.String("err", err.Error()))
+				ginContext.Error(err)
+				return
+			}
+		}
+		if page.AfterId > 0 && !filter.Sort.IsById() {
+			logger.Warn("after_id with sort", slog.String("sort", filter.Sort.Field))
+			ginContext.Error(tenderSrv.invalidRequest(ginContext, errAfterIdWithSort))
+			return
+		}
+
+		tenders, err := tenderSrv.tenderService.GetTenders(ctx, filter, page)
+		if err != nil {
+			if errors.Is(err, outerror.ErrTendersNotFound) {
+				message := tenderSrv.message(ginContext, msgNoTendersFound)
+				if len(filter.ServiceTypes) > 0 {
+					message = tenderSrv.message(ginContext, msgNoTendersWithServiceType, ginContext.Query("srv_type"))
+				}
 				ginContext.JSON(
 					http.StatusOK,
 					schema.GetTendersResponse{
-						Message: fmt.Sprintf(
-							"no tenders found with service type=<%s>",
-							serviceType,
-						),
-						Tenders: tenders,
+						Message: message,
+						Tenders: []models.Tender{},
 					},
 				)
 				return
-			} else {
-				logger.Error("unexpected error", slog.String("err", err.Error()))
-				ginContext.JSON(http.StatusInternalServerError, schema.GetTendersResponse{Message: "internal error", Tenders: tenders})
-				return
 			}
+			logger.Warn("cannot get tenders", slog.String("err", err.Error()))
+			if errors.Is(err, outerror.ErrTenderStatusFilterRequiresUsername) {
+				err = problem.New(err, tenderSrv.message(ginContext, msgStatusFilterRequiresAuth))
+			}
+			ginContext.Error(err)
+			return
 		}
 
 		logger.Info("send success response")
-		ginContext.JSON(http.StatusOK, schema.GetTendersResponse{Message: "ok", Tenders: tenders})
+		ginContext.JSON(
+			http.StatusOK,
+			schema.GetTendersResponse{
+				Message:    tenderSrv.message(ginContext, msgOK),
+				Tenders:    tenders.Tenders,
+				Total:      tenders.Total,
+				NextCursor: tenders.NextCursor,
+			},
+		)
 	}
 }
 
@@ -52,45 +93,113 @@ func (tenderSrv *TenderService) GetEmployeeTendersByUsername(ctx context.Context
 		logger := tenderSrv.logger.With("op", op)
 		logger.Info(fmt.Sprintf("request to %s", ginContext.Request.URL))
 
-		username := ginContext.Query("username")
-		if username == "" {
-			logger.Info("username not specified")
-			ginContext.JSON(http.StatusBadRequest, schema.GetEmployeeTendersResponse{Message: "username query parameter not specified", Tenders: []models.Tender{}})
+		username, err := middleware.ActingUsername(ginContext, ginContext.Query("username"))
+		if err != nil {
+			logger.Warn("cannot resolve acting employee", slog.String("err", err.Error()))
+			ginContext.Error(err)
+			return
+		}
+		page, err := pagequery.Parse(ginContext)
+		if err != nil {
+			logger.Warn("invalid page", slog.String("err", err.Error()))
+			ginContext.Error(tenderSrv.invalidRequest(ginContext, err))
 			return
 		}
 		logger.Info("try get employee tenders", slog.String("username", username))
-		tenders, err := tenderSrv.tenderService.GetEmployeeTendersByUsername(ctx, username)
+		tenders, err := tenderSrv.tenderService.GetEmployeeTendersByUsername(ctx, username, page)
 		if err != nil {
-			if errors.Is(err, outerror.ErrEmployeeNotFound) {
-				logger.Warn(fmt.Sprintf("employee with username=<%s> not found", username))
+			if errors.Is(err, outerror.ErrEmployeeTendersNotFound) {
+				logger.Warn(fmt.Sprintf("not found tenders for employee with username=<%s>", username))
 				ginContext.JSON(
-					http.StatusNotFound,
+					http.StatusOK,
 					schema.GetEmployeeTendersResponse{
-						Message: fmt.Sprintf("employee with username=<%s> not found", username),
+						Message: tenderSrv.message(ginContext, msgNoEmployeeTenders, username),
 						Tenders: []models.Tender{},
 					},
 				)
 				return
-			} else if errors.Is(err, outerror.ErrEmployeeTendersNotFound) {
-				logger.Warn(fmt.Sprintf("not found tenders for employee with username=<%s>", username))
+			}
+			logger.Warn("cannot get employee tenders", slog.String("username", username), slog.String("err", err.Error()))
+			ginContext.Error(err)
+			return
+		}
+		logger.Info("success get employee tenders")
+		ginContext.JSON(
+			http.StatusOK,
+			schema.GetEmployeeTendersResponse{
+				Tenders:    tenders.Tenders,
+				Total:      tenders.Total,
+				NextCursor: tenders.NextCursor,
+				Message:    tenderSrv.message(ginContext, msgOK),
+			},
+		)
+	}
+}
+
+func (tenderSrv *TenderService) GetOrganizationTenders(ctx context.Context) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		const operationPlace = "internal.api.tenderapi.GetOrganizationTenders"
+		logger := tenderSrv.logger.With("op", operationPlace)
+		logger.Info(fmt.Sprintf("request to %v", ginContext.Request.URL))
+
+		orgId, err := tenderSrv.pathId(ginContext, "organizationId", outerror.ErrOrganizationNotFound)
+		if err != nil {
+			logger.Warn("invalid organization id", slog.String("err", err.Error()))
+			ginContext.Error(err)
+			return
+		}
+
+		page, err := pagequery.Parse(ginContext)
+		if err != nil {
+			logger.Warn("invalid page", slog.String("err", err.Error()))
+			ginContext.Error(tenderSrv.invalidRequest(ginContext, err))
+			return
+		}
+		filter, err := parseTenderFilter(ginContext)
+		if err != nil {
+			logger.Warn("invalid filter", slog.String("err", err.Error()))
+			ginContext.Error(tenderSrv.invalidRequest(ginContext, err))
+			return
+		}
+		if page.AfterId > 0 && !filter.Sort.IsById() {
+			logger.Warn("after_id with sort", slog.String("sort", filter.Sort.Field))
+			ginContext.Error(tenderSrv.invalidRequest(ginContext, errAfterIdWithSort))
+			return
+		}
+		username, err := middleware.ActingUsername(ginContext, filter.Viewer)
+		if err != nil {
+			logger.Warn("cannot resolve acting employee", slog.String("err", err.Error()))
+			ginContext.Error(err)
+			return
+		}
+		filter.Viewer = ""
+
+		tenders, err := tenderSrv.tenderService.GetOrganizationTenders(ctx, orgId, username, filter, page)
+		if err != nil {
+			if errors.Is(err, outerror.ErrTendersNotFound) {
 				ginContext.JSON(
 					http.StatusOK,
-					schema.GetEmployeeTendersResponse{
-						Message: fmt.Sprintf(
-							"not found tenders for employee with username=<%s>",
-							username,
-						),
+					schema.GetTendersResponse{
+						Message: tenderSrv.message(ginContext, msgNoTendersForOrganization, strconv.Itoa(orgId)),
 						Tenders: []models.Tender{},
 					},
 				)
 				return
-			} else {
-				logger.Error("unexpected error", slog.String("err", err.Error()))
-				ginContext.JSON(http.StatusInternalServerError, schema.GetEmployeeTendersResponse{Message: "internal error", Tenders: []models.Tender{}})
-				return
 			}
+			logger.Warn("cannot get organization tenders", slog.Int("org id", orgId), slog.String("err", err.Error()))
+			ginContext.Error(err)
+			return
 		}
-		logger.Info("success get employee tenders")
-		ginContext.JSON(http.StatusOK, schema.GetEmployeeTendersResponse{Tenders: tenders, Message: "ok"})
+
+		logger.Info("send success response")
+		ginContext.JSON(
+			http.StatusOK,
+			schema.GetTendersResponse{
+				Message:    tenderSrv.message(ginContext, msgOK),
+				Tenders:    tenders.Tenders,
+				Total:      tenders.Total,
+				NextCursor: tenders.NextCursor,
+			},
+		)
 	}
 }
@@ -0,0 +1,182 @@
+package tenderapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sariya23/tender/internal/domain/models"
+	schema "github.com/sariya23/tender/internal/hanlders"
+	"github.com/sariya23/tender/internal/lib/problem"
+	"github.com/sariya23/tender/internal/lib/tenderimport"
+	"github.com/sariya23/tender/internal/middleware"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+var errInvalidDryRun = errors.New("dry_run must be true or false")
+
+// ImportTenders создает тендеры из CSV или NDJSON файла в теле запроса и
+// возвращает отчет по каждой строке. Формат определяется по Content-Type,
+// с dry_run=true строки только проверяются.
+//
+// Пустые status и creator_username заполняются значениями CREATED и
+// username сотрудника, от имени которого выполняется запрос.
+func (tenderSrv *TenderService) ImportTenders(ctx context.Context) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		const operationPlace = "internal.api.tenderapi.ImportTenders"
+		logger := tenderSrv.logger.With("op", operationPlace)
+		logger.Info(fmt.Sprintf("request to %v", ginContext.Request.URL))
+
+		body := ginContext.Request.Body
+		defer func() {
+			err := body.Close()
+			if err != nil {
+				logger.Error("cannot close body", slog.String("err", err.Error()))
+			}
+		}()
+
+		username, err := middleware.ActingUsername(ginContext, "")
+		if err != nil {
+			logger.Warn("cannot resolve acting employee", slog.String("err", err.Error()))
+			ginContext.Error(err)
+			return
+		}
+		dryRun := false
+		if value, ok := ginContext.GetQuery("dry_run"); ok {
+			dryRun, err = strconv.ParseBool(value)
+			if err != nil {
+				logger.Warn("invalid dry_run", slog.String("dry_run", value))
+				ginContext.Error(tenderSrv.invalidRequest(ginContext, errInvalidDryRun))
+				return
+			}
+		}
+		format, err := tenderimport.FormatFromContentType(ginContext.GetHeader("Content-Type"))
+		if err != nil {
+			logger.Warn("unsupported import format", slog.String("content type", ginContext.GetHeader("Content-Type")))
+			ginContext.Error(problem.New(outerror.ErrUnsupportedImportFormat, tenderSrv.message(ginContext, msgImportFormat)))
+			return
+		}
+
+		rows, err := tenderimport.Parse(format, body)
+		if err != nil {
+			logger.Warn("cannot parse import file", slog.String("err", err.Error()))
+			ginContext.Error(tenderSrv.importFileProblem(ginContext, err))
+			return
+		}
+		logger.Info("success parse import file", slog.Int("rows", len(rows)))
+		for i := range rows {
+			if rows[i].Err != nil {
+				rows[i].Err = tenderSrv.importRowProblem(ginContext, rows[i].Err)
+				continue
+			}
+			rows[i].Tender, rows[i].Err = tenderSrv.importTender(ginContext, rows[i].Tender, username)
+		}
+
+		rows, err = tenderSrv.tenderService.ImportTenders(ctx, rows, username, dryRun)
+		if err != nil && rows == nil {
+			logger.Warn("cannot import tenders", slog.String("err", err.Error()))
+			ginContext.Error(err)
+			return
+		}
+		if err != nil {
+			// Часть тендеров уже могла быть создана, поэтому
+			// отчет нужен и при отмене импорта.
+			response := importReport(rows, dryRun)
+			response.Message = tenderSrv.message(ginContext, msgImportCanceled)
+			logger.Warn("tenders import canceled", slog.Int("succeeded", response.Succeeded), slog.Int("failed", response.Failed), slog.String("err", err.Error()))
+			ginContext.JSON(http.StatusOK, response)
+			return
+		}
+		response := importReport(rows, dryRun)
+		response.Message = tenderSrv.message(ginContext, msgOK)
+		logger.Info("tenders imported", slog.Int("succeeded", response.Succeeded), slog.Int("failed", response.Failed))
+		ginContext.JSON(http.StatusOK, response)
+	}
+}
+
+// importTender заполняет пустые поля тендера из файла импорта
+// и проверяет его так же, как тело запроса на создание.
+func (tenderSrv *TenderService) importTender(ginContext *gin.Context, tender models.Tender, username string) (models.Tender, error) {
+	if tender.Status == "" {
+		tender.Status = models.TenderCreatedStatus
+	}
+	if tender.CreatorUsername == "" {
+		tender.CreatorUsername = username
+	}
+	err := tenderSrv.validate.Struct(&tender)
+	if err != nil {
+		return tender, tenderSrv.validationProblem(ginContext, err)
+	}
+	return tender, nil
+}
+
+// importFileProblem переводит ошибку разбора файла импорта, из-за
+// которой файл нельзя импортировать, в ошибку для ответа.
+func (tenderSrv *TenderService) importFileProblem(ginContext *gin.Context, err error) error {
+	var columnErr *tenderimport.ColumnError
+	var syntaxErr *tenderimport.SyntaxError
+	if errors.As(err, &columnErr) {
+		for columnProblem, key := range importColumnMessages {
+			if errors.Is(err, columnProblem) {
+				return problem.New(outerror.ErrInvalidRequest, tenderSrv.message(ginContext, key, columnErr.Column))
+			}
+		}
+	} else if errors.As(err, &syntaxErr) {
+		line := strconv.Itoa(syntaxErr.Line)
+		return problem.New(outerror.ErrInvalidRequest, tenderSrv.message(ginContext, msgImportSyntax, line, syntaxErr.Err.Error()))
+	} else if errors.Is(err, tenderimport.ErrNoRows) || errors.Is(err, tenderimport.ErrTooManyRows) {
+		return tenderSrv.invalidRequest(ginContext, err)
+	}
+	return fmt.Errorf("cannot parse import file: %w", err)
+}
+
+// importRowProblem переводит ошибку значения в строке файла импорта
+// в ошибку валидации с указателем на поле тендера.
+func (tenderSrv *TenderService) importRowProblem(ginContext *gin.Context, err error) error {
+	var columnErr *tenderimport.ColumnError
+	if !errors.As(err, &columnErr) {
+		return err
+	}
+	pointer := ""
+	if columnErr.Column != "" {
+		pointer = "/" + columnErr.Column
+	}
+	return &problem.Error{
+		Err: outerror.ErrValidationFailed,
+		Fields: []problem.FieldError{
+			{Pointer: pointer, Rule: columnErr.Rule, Message: tenderSrv.message(ginContext, importRuleMessages[columnErr.Rule])},
+		},
+	}
+}
+
+// importReport собирает отчет об импорте из строк с результатом.
+func importReport(rows []models.TenderImportRow, dryRun bool) schema.ImportTendersResponse {
+	response := schema.ImportTendersResponse{
+		DryRun: dryRun,
+		Total:  len(rows),
+		Rows:   make([]schema.ImportTenderRow, 0, len(rows)),
+	}
+	for _, row := range rows {
+		reportRow := schema.ImportTenderRow{Line: row.Line}
+		if row.Err != nil {
+			details := problem.Build(outerror.Catalog, row.Err, "")
+			reportRow.Status = schema.ImportRowFailed
+			reportRow.Error = &details
+			response.Failed++
+		} else {
+			tender := row.Tender
+			reportRow.Status = schema.ImportRowCreated
+			if dryRun {
+				reportRow.Status = schema.ImportRowValid
+			}
+			reportRow.Tender = &tender
+			response.Succeeded++
+		}
+		response.Rows = append(response.Rows, reportRow)
+	}
+	return response
+}
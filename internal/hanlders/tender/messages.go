@@ -0,0 +1,213 @@
+package tenderapi
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/lib/i18n"
+	"github.com/sariya23/tender/internal/lib/pagequery"
+	"github.com/sariya23/tender/internal/lib/problem"
+	"github.com/sariya23/tender/internal/lib/tenderimport"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+// Ключи сообщений хендлеров тендеров.
+const (
+	msgOK                       = "tender.ok"
+	msgJSONSyntax               = "tender.json_syntax"
+	msgJSONType                 = "tender.json_type"
+	msgPathNotInteger           = "tender.path_not_integer"
+	msgPathNotPositive          = "tender.path_not_positive"
+	msgFromNotPositive          = "tender.from_not_positive"
+	msgToNotPositive            = "tender.to_not_positive"
+	msgInvalidLimit             = "tender.invalid_limit"
+	msgInvalidOffset            = "tender.invalid_offset"
+	msgInvalidAfterId           = "tender.invalid_after_id"
+	msgOffsetAndAfter           = "tender.offset_and_after_id"
+	msgInvalidOrganizationId    = "tender.invalid_organization_id"
+	msgInvalidCreatedFrom       = "tender.invalid_created_from"
+	msgInvalidCreatedTo         = "tender.invalid_created_to"
+	msgInvalidCreatedRange      = "tender.invalid_created_range"
+	msgInvalidSort              = "tender.invalid_sort"
+	msgAfterIdWithSort          = "tender.after_id_with_sort"
+	msgAfterIdInSearch          = "tender.after_id_in_search"
+	msgInvalidIfMatch           = "tender.invalid_if_match"
+	msgExpectedVersionDiffer    = "tender.expected_version_differ"
+	msgSearchQueryNotSpecified  = "tender.search_query_not_specified"
+	msgStatusFilterRequiresAuth = "tender.status_filter_requires_auth"
+	msgStatusReasonRequired     = "tender.status_reason_required"
+	msgUnknownCloseVoteDecision = "tender.unknown_close_vote_decision"
+	msgNoTendersFound           = "tender.no_tenders_found"
+	msgNoTendersWithServiceType = "tender.no_tenders_with_service_type"
+	msgNoTendersForOrganization = "tender.no_tenders_for_organization"
+	msgNoEmployeeTenders        = "tender.no_employee_tenders"
+	msgInvalidDryRun            = "tender.invalid_dry_run"
+	msgImportFormat             = "tender.import_format"
+	msgImportNoRows             = "tender.import_no_rows"
+	msgImportTooManyRows        = "tender.import_too_many_rows"
+	msgImportMissingColumn      = "tender.import_missing_column"
+	msgImportUnknownColumn      = "tender.import_unknown_column"
+	msgImportDuplicateColumn    = "tender.import_duplicate_column"
+	msgImportSyntax             = "tender.import_syntax"
+	msgImportInteger            = "tender.import_integer"
+	msgImportDateTime           = "tender.import_datetime"
+	msgImportType               = "tender.import_type"
+	msgImportJSON               = "tender.import_json"
+	msgImportFieldCount         = "tender.import_field_count"
+	msgImportCanceled           = "tender.import_canceled"
+)
+
+var (
+	maxPageLimit  = strconv.Itoa(models.MaxPageLimit)
+	maxImportRows = strconv.Itoa(tenderimport.MaxRows)
+)
+
+// messages каталоги сообщений хендлеров тендеров.
+var messages = map[string]i18n.Catalog{
+	i18n.English: {
+		msgOK:                       "ok",
+		msgJSONSyntax:               "json syntax err: {0}",
+		msgJSONType:                 "json type err: {0}",
+		msgPathNotInteger:           "cannot convert {0} to integer",
+		msgPathNotPositive:          "{0} must be positive integer",
+		msgFromNotPositive:          "from query parameter must be positive integer",
+		msgToNotPositive:            "to query parameter must be positive integer",
+		msgInvalidLimit:             "limit must be integer from 1 to " + maxPageLimit,
+		msgInvalidOffset:            "offset must be non negative integer",
+		msgInvalidAfterId:           "after_id must be positive integer",
+		msgOffsetAndAfter:           "offset and after_id cannot be used together",
+		msgInvalidOrganizationId:    "organization_id must be positive integer",
+		msgInvalidCreatedFrom:       "created_from must be RFC3339 date-time",
+		msgInvalidCreatedTo:         "created_to must be RFC3339 date-time",
+		msgInvalidCreatedRange:      "created_from must be before created_to",
+		msgInvalidSort:              "sort must be one of id, name, created_at, service_type, optionally prefixed with -",
+		msgAfterIdWithSort:          "after_id can be used only with sort by id",
+		msgAfterIdInSearch:          "after_id cannot be used for search, use offset",
+		msgInvalidIfMatch:           "If-Match header must contain tender version",
+		msgExpectedVersionDiffer:    "If-Match header and expected_version differ",
+		msgSearchQueryNotSpecified:  "q query parameter not specified",
+		msgStatusFilterRequiresAuth: "authentication required to filter by status other than PUBLISHED",
+		msgStatusReasonRequired:     "reason is required for this tender status transition, use PUT /api/tenders/{0}/status",
+		msgUnknownCloseVoteDecision: "unknown decision=<{0}>. Allowed: APPROVE, REJECT",
+		msgNoTendersFound:           "no tenders found",
+		msgNoTendersWithServiceType: "no tenders found with service type=<{0}>",
+		msgNoTendersForOrganization: "no tenders found for organization with id=<{0}>",
+		msgNoEmployeeTenders:        "not found tenders for employee with username=<{0}>",
+		msgInvalidDryRun:            "dry_run must be true or false",
+		msgImportFormat:             "Content-Type must be text/csv or application/x-ndjson",
+		msgImportNoRows:             "import file has no rows",
+		msgImportTooManyRows:        "import file must have at most " + maxImportRows + " rows",
+		msgImportMissingColumn:      "required column {0} is missing",
+		msgImportUnknownColumn:      "unknown column {0}",
+		msgImportDuplicateColumn:    "column {0} is specified more than once",
+		msgImportSyntax:             "syntax error in line {0}: {1}",
+		msgImportInteger:            "must be integer",
+		msgImportDateTime:           "must be RFC3339 date-time",
+		msgImportType:               "has wrong type",
+		msgImportJSON:               "line is not valid JSON object",
+		msgImportFieldCount:         "wrong number of fields",
+		msgImportCanceled:           "import was canceled, rows with import_row_not_attempted error were not imported",
+	},
+	i18n.Russian: {
+		msgOK:                       "ок",
+		msgJSONSyntax:               "синтаксическая ошибка json: {0}",
+		msgJSONType:                 "ошибка типа в json: {0}",
+		msgPathNotInteger:           "{0} должен быть числом",
+		msgPathNotPositive:          "{0} должен быть положительным целым числом",
+		msgFromNotPositive:          "параметр from должен быть положительным целым числом",
+		msgToNotPositive:            "параметр to должен быть положительным целым числом",
+		msgInvalidLimit:             "limit должен быть целым числом от 1 до " + maxPageLimit,
+		msgInvalidOffset:            "offset должен быть неотрицательным целым числом",
+		msgInvalidAfterId:           "after_id должен быть положительным целым числом",
+		msgOffsetAndAfter:           "offset и after_id нельзя указывать вместе",
+		msgInvalidOrganizationId:    "organization_id должен быть положительным целым числом",
+		msgInvalidCreatedFrom:       "created_from должен быть датой и временем в формате RFC3339",
+		msgInvalidCreatedTo:         "created_to должен быть датой и временем в формате RFC3339",
+		msgInvalidCreatedRange:      "created_from должен быть раньше created_to",
+		msgInvalidSort:              "sort должен быть одним из id, name, created_at, service_type, перед полем можно указать -",
+		msgAfterIdWithSort:          "after_id можно указывать только при сортировке по id",
+		msgAfterIdInSearch:          "after_id нельзя использовать в поиске, используйте offset",
+		msgInvalidIfMatch:           "заголовок If-Match должен содержать версию тендера",
+		msgExpectedVersionDiffer:    "заголовок If-Match и expected_version не совпадают",
+		msgSearchQueryNotSpecified:  "не указан параметр q",
+		msgStatusFilterRequiresAuth: "фильтр по статусу, отличному от PUBLISHED, доступен только после аутентификации",
+		msgStatusReasonRequired:     "для такой смены статуса тендера нужна причина, используйте PUT /api/tenders/{0}/status",
+		msgUnknownCloseVoteDecision: "неизвестное решение=<{0}>. Допустимые: APPROVE, REJECT",
+		msgNoTendersFound:           "тендеры не найдены",
+		msgNoTendersWithServiceType: "не найдено тендеров с типом услуг=<{0}>",
+		msgNoTendersForOrganization: "не найдено тендеров организации с id=<{0}>",
+		msgNoEmployeeTenders:        "не найдено тендеров сотрудника с username=<{0}>",
+		msgInvalidDryRun:            "dry_run должен быть true или false",
+		msgImportFormat:             "Content-Type должен быть text/csv или application/x-ndjson",
+		msgImportNoRows:             "в файле импорта нет строк",
+		msgImportTooManyRows:        "в файле импорта должно быть не больше " + maxImportRows + " строк",
+		msgImportMissingColumn:      "нет обязательной колонки {0}",
+		msgImportUnknownColumn:      "неизвестная колонка {0}",
+		msgImportDuplicateColumn:    "колонка {0} указана несколько раз",
+		msgImportSyntax:             "синтаксическая ошибка в строке {0}: {1}",
+		msgImportInteger:            "должно быть целым числом",
+		msgImportDateTime:           "должно быть датой и временем в формате RFC3339",
+		msgImportType:               "неверный тип значения",
+		msgImportJSON:               "строка не является JSON объектом",
+		msgImportFieldCount:         "неверное количество полей",
+		msgImportCanceled:           "импорт отменен, строки с ошибкой import_row_not_attempted не импортированы",
+	},
+}
+
+// requestErrorMessages ключи сообщений для ошибок в параметрах запроса.
+var requestErrorMessages = map[error]string{
+	pagequery.ErrInvalidLimit:   msgInvalidLimit,
+	pagequery.ErrInvalidOffset:  msgInvalidOffset,
+	pagequery.ErrInvalidAfterId: msgInvalidAfterId,
+	pagequery.ErrOffsetAndAfter: msgOffsetAndAfter,
+	errInvalidOrganizationId:    msgInvalidOrganizationId,
+	errInvalidCreatedFrom:       msgInvalidCreatedFrom,
+	errInvalidCreatedTo:         msgInvalidCreatedTo,
+	errInvalidCreatedRange:      msgInvalidCreatedRange,
+	errInvalidSort:              msgInvalidSort,
+	errAfterIdWithSort:          msgAfterIdWithSort,
+	errAfterIdInSearch:          msgAfterIdInSearch,
+	errInvalidIfMatch:           msgInvalidIfMatch,
+	errExpectedVersionDiffer:    msgExpectedVersionDiffer,
+	errInvalidDryRun:            msgInvalidDryRun,
+	tenderimport.ErrNoRows:      msgImportNoRows,
+	tenderimport.ErrTooManyRows: msgImportTooManyRows,
+}
+
+// importColumnMessages ключи сообщений для ошибок заголовка файла импорта.
+var importColumnMessages = map[error]string{
+	tenderimport.ErrMissingColumn:   msgImportMissingColumn,
+	tenderimport.ErrUnknownColumn:   msgImportUnknownColumn,
+	tenderimport.ErrDuplicateColumn: msgImportDuplicateColumn,
+}
+
+// importRuleMessages ключи сообщений для ошибок значений в строках файла импорта.
+var importRuleMessages = map[string]string{
+	tenderimport.RuleInteger:    msgImportInteger,
+	tenderimport.RuleDateTime:   msgImportDateTime,
+	tenderimport.RuleType:       msgImportType,
+	tenderimport.RuleJSON:       msgImportJSON,
+	tenderimport.RuleFieldCount: msgImportFieldCount,
+}
+
+// translator возвращает переводчик на язык из заголовка Accept-Language.
+func (tenderSrv *TenderService) translator(ginContext *gin.Context) ut.Translator {
+	return tenderSrv.translations.Translator(ginContext.GetHeader("Accept-Language"))
+}
+
+// message возвращает сообщение key на языке клиента.
+func (tenderSrv *TenderService) message(ginContext *gin.Context, key string, params ...string) string {
+	return i18n.T(tenderSrv.translator(ginContext), key, params...)
+}
+
+// invalidRequest переводит ошибку в параметрах запроса в ошибку
+// ErrInvalidRequest с пояснением на языке клиента.
+func (tenderSrv *TenderService) invalidRequest(ginContext *gin.Context, err error) error {
+	key, ok := requestErrorMessages[err]
+	if !ok {
+		return problem.New(outerror.ErrInvalidRequest, err.Error())
+	}
+	return problem.New(outerror.ErrInvalidRequest, tenderSrv.message(ginContext, key))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/domain/tenderstatus"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -13,23 +14,53 @@ import (
 //
 // - CreateTender
 //
+// - ImportTenders
+//
 // - GetTenders
 //
 // - GetEmployeeTendersByUsername
 //
+// - GetOrganizationTenders
+//
+// - SearchTenders
+//
 // - EditTender
+//
+// - RollbackTender
+//
+// - VoteCloseTender
+//
+// - GetTenderVersions
+//
+// - GetTenderVersion
+//
+// - DiffTenderVersions
+//
+// - GetTenderStatus
+//
+// - SetTenderStatus
 type MockTenderServiceProvider struct {
 	mock.Mock
 }
 
-func (m *MockTenderServiceProvider) GetTenders(ctx context.Context, serviceType string) ([]models.Tender, error) {
-	args := m.Called(ctx, serviceType)
-	return args.Get(0).([]models.Tender), args.Error(1)
+func (m *MockTenderServiceProvider) GetTenders(ctx context.Context, filter models.TenderFilter, page models.Page) (models.TenderPage, error) {
+	args := m.Called(ctx, filter, page)
+	return args.Get(0).(models.TenderPage), args.Error(1)
+}
+
+func (m *MockTenderServiceProvider) GetEmployeeTendersByUsername(ctx context.Context, username string, page models.Page) (models.TenderPage, error) {
+	args := m.Called(ctx, username, page)
+	return args.Get(0).(models.TenderPage), args.Error(1)
 }
 
-func (m *MockTenderServiceProvider) GetEmployeeTendersByUsername(ctx context.Context, username string) ([]models.Tender, error) {
-	args := m.Called(ctx, username)
-	return args.Get(0).([]models.Tender), args.Error(1)
+func (m *MockTenderServiceProvider) GetOrganizationTenders(ctx context.Context, orgId int, username string, filter models.TenderFilter, page models.Page) (models.TenderPage, error) {
+	args := m.Called(ctx, orgId, username, filter, page)
+	return args.Get(0).(models.TenderPage), args.Error(1)
+}
+
+func (m *MockTenderServiceProvider) SearchTenders(ctx context.Context, query string, page models.Page) (models.TenderSearchPage, error) {
+	args := m.Called(ctx, query, page)
+	return args.Get(0).(models.TenderSearchPage), args.Error(1)
 }
 
 func (m *MockTenderServiceProvider) CreateTender(ctx context.Context, tender models.Tender) (models.Tender, error) {
@@ -37,12 +68,47 @@ func (m *MockTenderServiceProvider) CreateTender(ctx context.Context, tender mod
 	return args.Get(0).(models.Tender), args.Error(1)
 }
 
-func (m *MockTenderServiceProvider) EditTender(ctx context.Context, tenderId int, updateTender models.TenderToUpdate, username string) (models.Tender, error) {
-	args := m.Called(ctx, tenderId, updateTender, username)
+func (m *MockTenderServiceProvider) ImportTenders(ctx context.Context, rows []models.TenderImportRow, username string, dryRun bool) ([]models.TenderImportRow, error) {
+	args := m.Called(ctx, rows, username, dryRun)
+	return args.Get(0).([]models.TenderImportRow), args.Error(1)
+}
+
+func (m *MockTenderServiceProvider) EditTender(ctx context.Context, tenderId int, updateTender models.TenderToUpdate, username string, expectedVersion int) (models.Tender, error) {
+	args := m.Called(ctx, tenderId, updateTender, username, expectedVersion)
+	return args.Get(0).(models.Tender), args.Error(1)
+}
+
+func (m *MockTenderServiceProvider) RollbackTender(ctx context.Context, tenderId int, version int, username string, expectedVersion int) (models.Tender, error) {
+	args := m.Called(ctx, tenderId, version, username, expectedVersion)
 	return args.Get(0).(models.Tender), args.Error(1)
 }
 
-func (m *MockTenderServiceProvider) RollbackTender(ctx context.Context, tenderId int, version int, username string) (models.Tender, error) {
+func (m *MockTenderServiceProvider) VoteCloseTender(ctx context.Context, tenderId int, username string, decision string) (models.TenderCloseVoting, error) {
+	args := m.Called(ctx, tenderId, username, decision)
+	return args.Get(0).(models.TenderCloseVoting), args.Error(1)
+}
+
+func (m *MockTenderServiceProvider) GetTenderVersions(ctx context.Context, tenderId int, username string) ([]models.TenderVersion, error) {
+	args := m.Called(ctx, tenderId, username)
+	return args.Get(0).([]models.TenderVersion), args.Error(1)
+}
+
+func (m *MockTenderServiceProvider) GetTenderVersion(ctx context.Context, tenderId int, version int, username string) (models.TenderVersion, error) {
 	args := m.Called(ctx, tenderId, version, username)
+	return args.Get(0).(models.TenderVersion), args.Error(1)
+}
+
+func (m *MockTenderServiceProvider) DiffTenderVersions(ctx context.Context, tenderId int, fromVersion int, toVersion int, username string) (models.TenderDiff, error) {
+	args := m.Called(ctx, tenderId, fromVersion, toVersion, username)
+	return args.Get(0).(models.TenderDiff), args.Error(1)
+}
+
+func (m *MockTenderServiceProvider) GetTenderStatus(ctx context.Context, tenderId int, username string) (tenderstatus.State, error) {
+	args := m.Called(ctx, tenderId, username)
+	return args.Get(0).(tenderstatus.State), args.Error(1)
+}
+
+func (m *MockTenderServiceProvider) SetTenderStatus(ctx context.Context, tenderId int, status string, reason string, username string, expectedVersion int) (models.Tender, error) {
+	args := m.Called(ctx, tenderId, status, reason, username, expectedVersion)
 	return args.Get(0).(models.Tender), args.Error(1)
 }
@@ -0,0 +1,49 @@
+package tenderapi
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sariya23/tender/internal/lib/problem"
+	"github.com/sariya23/tender/internal/lib/unmarshal"
+	"github.com/sariya23/tender/internal/lib/validation"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+// Ошибки хендлеры не пишут в ответ сами, а передают в ginContext.Error.
+// Ответ application/problem+json по каталогу outerror.Catalog
+// собирает middleware.Problems.
+
+// bodyProblem переводит ошибку разбора тела запроса в ошибку для ответа.
+func (tenderSrv *TenderService) bodyProblem(ginContext *gin.Context, err error) error {
+	if errors.Is(err, unmarshal.ErrSyntax) {
+		return problem.New(outerror.ErrInvalidRequest, tenderSrv.message(ginContext, msgJSONSyntax, err.Error()))
+	} else if errors.Is(err, unmarshal.ErrType) {
+		return problem.New(outerror.ErrInvalidRequest, tenderSrv.message(ginContext, msgJSONType, err.Error()))
+	}
+	return fmt.Errorf("cannot unmarshal request: %w", err)
+}
+
+// validationProblem переводит ошибку валидатора в ошибку с ошибками полей.
+func (tenderSrv *TenderService) validationProblem(ginContext *gin.Context, err error) error {
+	return &problem.Error{
+		Err:    outerror.ErrValidationFailed,
+		Fields: validation.FieldErrors(err, tenderSrv.translator(ginContext)),
+	}
+}
+
+// pathId читает положительное число из параметра пути name. Если
+// параметр некорректный, то возвращается ошибка notFound, потому что
+// ресурса с таким id быть не может.
+func (tenderSrv *TenderService) pathId(ginContext *gin.Context, name string, notFound error) (int, error) {
+	id, err := strconv.Atoi(ginContext.Param(name))
+	if err != nil {
+		return 0, problem.New(notFound, tenderSrv.message(ginContext, msgPathNotInteger, name))
+	}
+	if id <= 0 {
+		return 0, problem.New(notFound, tenderSrv.message(ginContext, msgPathNotPositive, name))
+	}
+	return id, nil
+}
@@ -2,18 +2,15 @@ package tenderapi
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"io"
 	"log/slog"
 	"net/http"
-	"strconv"
 
 	"github.com/gin-gonic/gin"
-	"github.com/go-playground/validator/v10"
-	"github.com/sariya23/tender/internal/domain/models"
 	schema "github.com/sariya23/tender/internal/hanlders"
 	"github.com/sariya23/tender/internal/lib/unmarshal"
+	"github.com/sariya23/tender/internal/middleware"
 	outerror "github.com/sariya23/tender/internal/out_error"
 )
 
@@ -23,49 +20,16 @@ func (tenderSrv *TenderService) RollbackTender(ctx context.Context) gin.HandlerF
 		logger := tenderSrv.logger.With("op", operationPlace)
 		logger.Info(fmt.Sprintf("request to %v", ginContext.Request.URL.Path))
 
-		tenderId := ginContext.Param("tenderId")
-		convertedTenderId, err := strconv.Atoi(tenderId)
+		tenderId, err := tenderSrv.pathId(ginContext, "tenderId", outerror.ErrTenderNotFound)
 		if err != nil {
-			logger.Error(
-				"cannot convert tender id to int",
-				slog.String("tender id", tenderId),
-				slog.String("err", err.Error()),
-			)
-			ginContext.JSON(
-				http.StatusNotFound,
-				schema.RollbackTenderResponse{
-					Message: "cannot convert tenderId to integer",
-				},
-			)
+			logger.Warn("invalid tender id", slog.String("err", err.Error()))
+			ginContext.Error(err)
 			return
 		}
-
-		if convertedTenderId < 0 {
-			logger.Error("tender id is not positive integer", slog.String("tender id", tenderId))
-			ginContext.JSON(http.StatusNotFound, schema.RollbackTenderResponse{Message: "tender id must be positive integer"})
-			return
-		}
-
-		version := ginContext.Param("version")
-		convertedVersion, err := strconv.Atoi(version)
+		version, err := tenderSrv.pathId(ginContext, "version", outerror.ErrTenderVersionNotFound)
 		if err != nil {
-			logger.Error(
-				"cannot convert version to int",
-				slog.String("version", version),
-				slog.String("err", err.Error()),
-			)
-			ginContext.JSON(
-				http.StatusNotFound,
-				schema.RollbackTenderResponse{
-					Message: "cannot convert version to integer",
-				},
-			)
-			return
-		}
-
-		if convertedVersion < 0 {
-			logger.Error("version is not positive integer", slog.String("version", version))
-			ginContext.JSON(http.StatusNotFound, schema.RollbackTenderResponse{Message: "version must be positive integer"})
+			logger.Warn("invalid version", slog.String("err", err.Error()))
+			ginContext.Error(err)
 			return
 		}
 
@@ -80,92 +44,49 @@ func (tenderSrv *TenderService) RollbackTender(ctx context.Context) gin.HandlerF
 		bodyData, err := io.ReadAll(body)
 		if err != nil {
 			logger.Error("cannot read body", slog.String("err", err.Error()))
-			ginContext.JSON(http.StatusInternalServerError, schema.RollbackTenderResponse{Message: "internal error", RollbackTender: models.Tender{}})
+			ginContext.Error(fmt.Errorf("cannot read body: %w", err))
 			return
 		}
 		logger.Info("success read body")
 		rollbackReq, err := unmarshal.RollbackRequest([]byte(bodyData))
 		if err != nil {
-			if errors.Is(err, unmarshal.ErrSyntax) {
-				logger.Warn("req syntax error", slog.String("err", err.Error()))
-				ginContext.JSON(
-					http.StatusBadRequest,
-					schema.RollbackTenderResponse{
-						Message:        fmt.Sprintf("json syntax err: %s", err.Error()),
-						RollbackTender: models.Tender{},
-					},
-				)
-				return
-			} else if errors.Is(err, unmarshal.ErrType) {
-				logger.Warn("req type error", slog.String("err", err.Error()))
-				ginContext.JSON(
-					http.StatusBadRequest,
-					schema.RollbackTenderResponse{
-						Message:        fmt.Sprintf("json type err: %s", err.Error()),
-						RollbackTender: models.Tender{},
-					},
-				)
-				return
-			} else {
-				logger.Error("unexpected error", slog.String("err", err.Error()))
-				ginContext.JSON(http.StatusInternalServerError, schema.RollbackTenderResponse{Message: "internal error", RollbackTender: models.Tender{}})
-				return
-			}
+			logger.Warn("cannot unmarshal request", slog.String("err", err.Error()))
+			ginContext.Error(tenderSrv.bodyProblem(ginContext, err))
+			return
 		}
 		logger.Info("success unmarshal request")
 
-		validate := validator.New(validator.WithRequiredStructEnabled())
-		err = validate.Struct(&rollbackReq)
+		rollbackReq.Username, err = middleware.ActingUsername(ginContext, rollbackReq.Username)
 		if err != nil {
-			logger.Error("validation error", slog.String("err", err.Error()))
-			ginContext.JSON(
-				http.StatusBadRequest,
-				schema.RollbackTenderResponse{
-					Message:        fmt.Sprintf("validation failed: %s", err.Error()),
-					RollbackTender: models.Tender{},
-				},
-			)
+			logger.Warn("cannot resolve acting employee", slog.String("err", err.Error()))
+			ginContext.Error(err)
+			return
+		}
+
+		err = tenderSrv.validate.Struct(&rollbackReq)
+		if err != nil {
+			logger.Warn("validation error", slog.String("err", err.Error()))
+			ginContext.Error(tenderSrv.validationProblem(ginContext, err))
 			return
 		}
 		logger.Info("validate success")
 
-		tender, err := tenderSrv.tenderService.RollbackTender(ctx, convertedTenderId, convertedVersion, rollbackReq.Username)
+		expectedVersion, err := expectedTenderVersion(ginContext, rollbackReq.ExpectedVersion)
 		if err != nil {
-			if errors.Is(err, outerror.ErrTenderNotFound) {
-				logger.Warn(fmt.Sprintf("tender with id=<%d> not found", convertedTenderId))
-				ginContext.JSON(
-					http.StatusUnprocessableEntity,
-					schema.RollbackTenderResponse{
-						Message: fmt.Sprintf("tender with id=<%d> not found", convertedTenderId),
-					},
-				)
-				return
-			} else if errors.Is(err, outerror.ErrTenderVersionNotFound) {
-				logger.Warn(fmt.Sprintf("tender with id=<%d> doesnt have version=<%d>", convertedTenderId, convertedVersion))
-				ginContext.JSON(
-					http.StatusUnprocessableEntity,
-					schema.RollbackTenderResponse{
-						Message: fmt.Sprintf("tender with id=<%d> doesnt have version=<%d>", convertedTenderId, convertedVersion),
-					},
-				)
-				return
-			} else if errors.Is(err, outerror.ErrEmployeeNotResponsibleForTender) {
-				logger.Warn(fmt.Sprintf("employee with username=<%s> not creator of tender with id=<%d>", rollbackReq.Username, convertedTenderId))
-				ginContext.JSON(
-					http.StatusForbidden,
-					schema.RollbackTenderResponse{
-						Message: fmt.Sprintf("employee with username=<%s> not creator of tender with id=<%d>", rollbackReq.Username, convertedTenderId),
-					},
-				)
-				return
-			} else {
-				logger.Error("unexpected error", slog.String("err", err.Error()))
-				ginContext.JSON(http.StatusInternalServerError, schema.RollbackTenderResponse{Message: "internal error"})
-				return
-			}
+			logger.Warn("invalid version precondition", slog.String("err", err.Error()))
+			ginContext.Error(tenderSrv.invalidRequest(ginContext, err))
+			return
+		}
+
+		tender, err := tenderSrv.tenderService.RollbackTender(ctx, tenderId, version, rollbackReq.Username, expectedVersion)
+		if err != nil {
+			logger.Warn("cannot rollback tender", slog.Int("tender id", tenderId), slog.Int("version", version), slog.String("err", err.Error()))
+			ginContext.Error(err)
+			return
 		}
 
 		logger.Info("rollback success")
-		ginContext.JSON(http.StatusOK, schema.RollbackTenderResponse{Message: "ok", RollbackTender: tender})
+		ginContext.Header("ETag", tenderETag(tender.Version))
+		ginContext.JSON(http.StatusOK, schema.RollbackTenderResponse{Message: tenderSrv.message(ginContext, msgOK), RollbackTender: tender})
 	}
 }
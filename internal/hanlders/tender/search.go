@@ -0,0 +1,64 @@
+package tenderapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	schema "github.com/sariya23/tender/internal/hanlders"
+	"github.com/sariya23/tender/internal/lib/pagequery"
+	"github.com/sariya23/tender/internal/lib/problem"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+var errAfterIdInSearch = errors.New("after_id cannot be used for search, use offset")
+
+func (tenderSrv *TenderService) SearchTenders(ctx context.Context) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		const operationPlace = "internal.api.tenderapi.SearchTenders"
+		logger := tenderSrv.logger.With("op", operationPlace)
+		logger.Info(fmt.Sprintf("request to %v", ginContext.Request.URL))
+
+		query := strings.TrimSpace(ginContext.Query("q"))
+		if query == "" {
+			logger.Info("search query not specified")
+			ginContext.Error(problem.New(outerror.ErrEmptySearchQuery, tenderSrv.message(ginContext, msgSearchQueryNotSpecified)))
+			return
+		}
+		page, err := pagequery.Parse(ginContext)
+		if err != nil {
+			logger.Warn("invalid page", slog.String("err", err.Error()))
+			ginContext.Error(tenderSrv.invalidRequest(ginContext, err))
+			return
+		}
+		if page.AfterId > 0 {
+			logger.Warn("after_id in search")
+			ginContext.Error(tenderSrv.invalidRequest(ginContext, errAfterIdInSearch))
+			return
+		}
+
+		results, err := tenderSrv.tenderService.SearchTenders(ctx, query, page)
+		if err != nil {
+			logger.Warn("cannot search tenders", slog.String("err", err.Error()))
+			if errors.Is(err, outerror.ErrEmptySearchQuery) {
+				err = problem.New(err, tenderSrv.message(ginContext, msgSearchQueryNotSpecified))
+			}
+			ginContext.Error(err)
+			return
+		}
+
+		logger.Info("send success response")
+		ginContext.JSON(
+			http.StatusOK,
+			schema.SearchTendersResponse{
+				Message: tenderSrv.message(ginContext, msgOK),
+				Results: results.Results,
+				Total:   results.Total,
+			},
+		)
+	}
+}
@@ -4,25 +4,43 @@ import (
 	"context"
 	"log/slog"
 
+	"github.com/go-playground/validator/v10"
 	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/domain/tenderstatus"
+	"github.com/sariya23/tender/internal/lib/i18n"
+	"github.com/sariya23/tender/internal/lib/validation"
 )
 
 type TenderServiceProvider interface {
 	CreateTender(ctx context.Context, tender models.Tender) (models.Tender, error)
-	GetTenders(ctx context.Context, serviceType string) ([]models.Tender, error)
-	GetEmployeeTendersByUsername(ctx context.Context, username string) ([]models.Tender, error)
-	EditTender(ctx context.Context, tenderId int, updateTender models.TenderToUpdate, username string) (models.Tender, error)
-	RollbackTender(ctx context.Context, tenderId int, version int, username string) (models.Tender, error)
+	ImportTenders(ctx context.Context, rows []models.TenderImportRow, username string, dryRun bool) ([]models.TenderImportRow, error)
+	GetTenders(ctx context.Context, filter models.TenderFilter, page models.Page) (models.TenderPage, error)
+	GetEmployeeTendersByUsername(ctx context.Context, username string, page models.Page) (models.TenderPage, error)
+	GetOrganizationTenders(ctx context.Context, orgId int, username string, filter models.TenderFilter, page models.Page) (models.TenderPage, error)
+	SearchTenders(ctx context.Context, query string, page models.Page) (models.TenderSearchPage, error)
+	EditTender(ctx context.Context, tenderId int, updateTender models.TenderToUpdate, username string, expectedVersion int) (models.Tender, error)
+	RollbackTender(ctx context.Context, tenderId int, version int, username string, expectedVersion int) (models.Tender, error)
+	GetTenderVersions(ctx context.Context, tenderId int, username string) ([]models.TenderVersion, error)
+	GetTenderVersion(ctx context.Context, tenderId int, version int, username string) (models.TenderVersion, error)
+	DiffTenderVersions(ctx context.Context, tenderId int, fromVersion int, toVersion int, username string) (models.TenderDiff, error)
+	VoteCloseTender(ctx context.Context, tenderId int, username string, decision string) (models.TenderCloseVoting, error)
+	GetTenderStatus(ctx context.Context, tenderId int, username string) (tenderstatus.State, error)
+	SetTenderStatus(ctx context.Context, tenderId int, status string, reason string, username string, expectedVersion int) (models.Tender, error)
 }
 
 type TenderService struct {
 	logger        *slog.Logger
 	tenderService TenderServiceProvider
+	validate      *validator.Validate
+	translations  *i18n.Translations
 }
 
 func New(logger *slog.Logger, tenderService TenderServiceProvider) *TenderService {
+	translations := i18n.MustNew(messages)
 	return &TenderService{
 		logger:        logger,
 		tenderService: tenderService,
+		validate:      validation.New(translations),
+		translations:  translations,
 	}
 }
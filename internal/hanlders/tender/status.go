@@ -0,0 +1,117 @@
+package tenderapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	schema "github.com/sariya23/tender/internal/hanlders"
+	"github.com/sariya23/tender/internal/lib/unmarshal"
+	"github.com/sariya23/tender/internal/middleware"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+func (tenderSrv *TenderService) GetTenderStatus(ctx context.Context) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		const operationPlace = "internal.api.tenderapi.GetTenderStatus"
+		logger := tenderSrv.logger.With("op", operationPlace)
+		logger.Info(fmt.Sprintf("request to %v", ginContext.Request.URL))
+
+		tenderId, err := tenderSrv.pathId(ginContext, "tenderId", outerror.ErrTenderNotFound)
+		if err != nil {
+			logger.Warn("invalid tender id", slog.String("err", err.Error()))
+			ginContext.Error(err)
+			return
+		}
+
+		username, err := middleware.ActingUsername(ginContext, ginContext.Query("username"))
+		if err != nil {
+			logger.Warn("cannot resolve acting employee", slog.String("err", err.Error()))
+			ginContext.Error(err)
+			return
+		}
+
+		state, err := tenderSrv.tenderService.GetTenderStatus(ctx, tenderId, username)
+		if err != nil {
+			logger.Warn("cannot get tender status", slog.Int("tender id", tenderId), slog.String("err", err.Error()))
+			ginContext.Error(err)
+			return
+		}
+		logger.Info("success get tender status")
+		ginContext.JSON(http.StatusOK, schema.GetTenderStatusResponse{Message: tenderSrv.message(ginContext, msgOK), TenderStatus: state})
+	}
+}
+
+func (tenderSrv *TenderService) SetTenderStatus(ctx context.Context) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		const operationPlace = "internal.api.tenderapi.SetTenderStatus"
+		logger := tenderSrv.logger.With("op", operationPlace)
+		logger.Info(fmt.Sprintf("request to %v", ginContext.Request.URL.Path))
+
+		tenderId, err := tenderSrv.pathId(ginContext, "tenderId", outerror.ErrTenderNotFound)
+		if err != nil {
+			logger.Warn("invalid tender id", slog.String("err", err.Error()))
+			ginContext.Error(err)
+			return
+		}
+
+		body := ginContext.Request.Body
+		defer func() {
+			err := body.Close()
+			if err != nil {
+				logger.Error("cannot close body", slog.String("err", err.Error()))
+			}
+		}()
+
+		bodyData, err := io.ReadAll(body)
+		if err != nil {
+			logger.Error("cannot read body", slog.String("err", err.Error()))
+			ginContext.Error(fmt.Errorf("cannot read body: %w", err))
+			return
+		}
+		logger.Info("success read body")
+		statusReq, err := unmarshal.SetStatusRequest(bodyData)
+		if err != nil {
+			logger.Warn("cannot unmarshal request", slog.String("err", err.Error()))
+			ginContext.Error(tenderSrv.bodyProblem(ginContext, err))
+			return
+		}
+		logger.Info("success unmarshal request")
+
+		statusReq.Username, err = middleware.ActingUsername(ginContext, statusReq.Username)
+		if err != nil {
+			logger.Warn("cannot resolve acting employee", slog.String("err", err.Error()))
+			ginContext.Error(err)
+			return
+		}
+
+		err = tenderSrv.validate.Struct(&statusReq)
+		if err != nil {
+			logger.Warn("validation error", slog.String("err", err.Error()))
+			ginContext.Error(tenderSrv.validationProblem(ginContext, err))
+			return
+		}
+		logger.Info("validate success")
+
+		expectedVersion, err := expectedTenderVersion(ginContext, statusReq.ExpectedVersion)
+		if err != nil {
+			logger.Warn("invalid version precondition", slog.String("err", err.Error()))
+			ginContext.Error(tenderSrv.invalidRequest(ginContext, err))
+			return
+		}
+
+		tender, err := tenderSrv.tenderService.SetTenderStatus(ctx, tenderId, statusReq.Status, statusReq.Reason, statusReq.Username, expectedVersion)
+		if err != nil {
+			logger.Warn("cannot set tender status", slog.Int("tender id", tenderId), slog.String("status", statusReq.Status), slog.String("err", err.Error()))
+			ginContext.Error(err)
+			return
+		}
+
+		logger.Info("success set tender status", slog.String("status", tender.Status))
+		ginContext.Header("ETag", tenderETag(tender.Version))
+		ginContext.JSON(http.StatusOK, schema.SetTenderStatusResponse{Message: tenderSrv.message(ginContext, msgOK), Tender: tender})
+	}
+}
@@ -2,18 +2,18 @@ package tests
 
 import (
 	"context"
-	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/sariya23/tender/internal/domain/models"
-	schema "github.com/sariya23/tender/internal/hanlders"
 	tenderapi "github.com/sariya23/tender/internal/hanlders/tender"
 	"github.com/sariya23/tender/internal/hanlders/tender/mocks"
 	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	"github.com/sariya23/tender/internal/lib/problem"
 	outerror "github.com/sariya23/tender/internal/out_error"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -28,26 +28,37 @@ func TestCreateTender_Success(t *testing.T) {
 	logger := slogdiscard.NewDiscardLogger()
 	mockTenderService := new(mocks.MockTenderServiceProvider)
 	mockTender := models.Tender{
-		TenderName: "Tender 1", Description: "qwe", ServiceType: "op", Status: "open", OrganizationId: 1, CreatorUsername: "qwe",
+		TenderName: "Tender 1", Description: "qwe", ServiceType: "Construction", Status: "open", OrganizationId: 1, CreatorUsername: "qwe",
 	}
 	reqBody := `
 	{
 		"tender": {
 			"name": "Tender 1",
 			"description": "qwe",
-			"service_type": "op",
+			"service_type": "Construction",
 			"status": "open",
 			"organization_id": 1,
 			"creator_username": "qwe"
 		}
 	}`
 
+	createdAt := time.Date(2024, 12, 18, 10, 0, 0, 0, time.UTC)
+	createdTender := mockTender
+	createdTender.ID = 1
+	createdTender.Version = 1
+	createdTender.CreatedAt = createdAt
+	createdTender.UpdatedAt = createdAt
+
 	expectedBody := `
 	{
 		"tender": {
+			"id": 1,
+			"version": 1,
+			"created_at": "2024-12-18T10:00:00Z",
+			"updated_at": "2024-12-18T10:00:00Z",
 			"name": "Tender 1",
 			"description": "qwe",
-			"service_type": "op",
+			"service_type": "Construction",
 			"status": "open",
 			"organization_id": 1,
 			"creator_username": "qwe"
@@ -56,12 +67,13 @@ func TestCreateTender_Success(t *testing.T) {
 	}`
 	svc := tenderapi.New(logger, mockTenderService)
 
-	mockTenderService.On("CreateTender", ctx, mockTender).Return(mockTender, nil)
+	mockTenderService.On("CreateTender", ctx, mockTender).Return(createdTender, nil)
 	req := httptest.NewRequest(http.MethodPost, "/tenders/new", strings.NewReader(reqBody))
 	w := httptest.NewRecorder()
 
 	c, _ := gin.CreateTestContext(w)
 	c.Request = req
+	authenticatedAs("qwe")(c)
 
 	// Act
 	handler := svc.CreateTender(ctx)
@@ -87,7 +99,7 @@ func TestCreateTender_FailUnmurshalSyntaxError(t *testing.T) {
 		"tender": {
 			"name": "Tender 1",
 			"description": "qwe",
-			"service_type": "op",
+			"service_type": "Construction",
 			"status": "open",
 			"organization_id": 1
 			"creator_username": "qwe"
@@ -99,21 +111,15 @@ func TestCreateTender_FailUnmurshalSyntaxError(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPost, "/tenders/new", strings.NewReader(reqBody))
 	w := httptest.NewRecorder()
 
-	c, _ := gin.CreateTestContext(w)
-	c.Request = req
+	router := newRouter(authenticatedAs("qwe"))
+	router.POST("/tenders/new", svc.CreateTender(ctx))
 
 	// Act
-	handler := svc.CreateTender(ctx)
-	handler(c)
+	router.ServeHTTP(w, req)
 
 	// Assert
-	var resp schema.CreateTenderResponse
-	err := json.Unmarshal(w.Body.Bytes(), &resp)
-	require.NoError(t, err)
-	assert.Equal(t, http.StatusBadRequest, w.Code)
-
-	require.Equal(t, resp.Tender, models.Tender{})
-	require.Contains(t, resp.Message, "json syntax")
+	details := requireProblem(t, w, http.StatusBadRequest, "invalid_request")
+	require.Contains(t, details.Detail, "json syntax")
 }
 
 // TestCreateTender_FailUnmurshalTypeError проверяет,
@@ -131,7 +137,7 @@ func TestCreateTender_FailUnmurshalTypeError(t *testing.T) {
 		"tender": {
 			"name": "Tender 1",
 			"description": "qwe",
-			"service_type": "op",
+			"service_type": "Construction",
 			"status": "open",
 			"organization_id": "qwe",
 			"creator_username": "qwe"
@@ -143,21 +149,15 @@ func TestCreateTender_FailUnmurshalTypeError(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPost, "/tenders/new", strings.NewReader(reqBody))
 	w := httptest.NewRecorder()
 
-	c, _ := gin.CreateTestContext(w)
-	c.Request = req
+	router := newRouter(authenticatedAs("qwe"))
+	router.POST("/tenders/new", svc.CreateTender(ctx))
 
 	// Act
-	handler := svc.CreateTender(ctx)
-	handler(c)
+	router.ServeHTTP(w, req)
 
 	// Assert
-	var resp schema.CreateTenderResponse
-	err := json.Unmarshal(w.Body.Bytes(), &resp)
-	require.NoError(t, err)
-	assert.Equal(t, http.StatusBadRequest, w.Code)
-
-	require.Equal(t, resp.Tender, models.Tender{})
-	require.Contains(t, resp.Message, "json type")
+	details := requireProblem(t, w, http.StatusBadRequest, "invalid_request")
+	require.Contains(t, details.Detail, "json type")
 }
 
 // TestCreateTender_FailNegativeOrgID проверяет, что
@@ -175,7 +175,7 @@ func TestCreateTender_FailNegativeOrgID(t *testing.T) {
 		"tender": {
 			"name": "Tender 1",
 			"description": "qwe",
-			"service_type": "op",
+			"service_type": "Construction",
 			"status": "open",
 			"organization_id": -1000,
 			"creator_username": "qwe"
@@ -187,21 +187,57 @@ func TestCreateTender_FailNegativeOrgID(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPost, "/tenders/new", strings.NewReader(reqBody))
 	w := httptest.NewRecorder()
 
-	c, _ := gin.CreateTestContext(w)
-	c.Request = req
+	router := newRouter(authenticatedAs("qwe"))
+	router.POST("/tenders/new", svc.CreateTender(ctx))
 
 	// Act
-	handler := svc.CreateTender(ctx)
-	handler(c)
+	router.ServeHTTP(w, req)
 
 	// Assert
-	var resp schema.CreateTenderResponse
-	err := json.Unmarshal(w.Body.Bytes(), &resp)
-	require.NoError(t, err)
-	assert.Equal(t, http.StatusBadRequest, w.Code)
+	details := requireProblem(t, w, http.StatusBadRequest, "validation_failed")
+	require.Equal(t, []problem.FieldError{{Pointer: "/tender/organization_id", Rule: "gte", Message: "must be greater than or equal to 0"}}, details.Errors)
+}
 
-	require.Equal(t, resp.Tender, models.Tender{})
-	require.Contains(t, resp.Message, "validation failed")
+// TestCreateTender_FailValidationRussian проверяет, что ошибки полей
+// возвращаются на языке из заголовка Accept-Language.
+func TestCreateTender_FailValidationRussian(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+
+	reqBody := `
+	{
+		"tender": {
+			"name": "",
+			"description": "qwe",
+			"service_type": "",
+			"status": "CREATED",
+			"organization_id": 1,
+			"creator_username": "qwe"
+		}
+	}`
+
+	svc := tenderapi.New(logger, mockTenderService)
+
+	req := httptest.NewRequest(http.MethodPost, "/tenders/new", strings.NewReader(reqBody))
+	req.Header.Set("Accept-Language", "ru-RU,ru;q=0.9")
+	w := httptest.NewRecorder()
+
+	router := newRouter(authenticatedAs("qwe"))
+	router.POST("/tenders/new", svc.CreateTender(ctx))
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	details := requireProblem(t, w, http.StatusBadRequest, "validation_failed")
+	require.Equal(t, []problem.FieldError{
+		{Pointer: "/tender/name", Rule: "required", Message: "обязательное поле"},
+		{Pointer: "/tender/service_type", Rule: "required", Message: "обязательное поле"},
+	}, details.Errors)
+	mockTenderService.AssertNotCalled(t, "CreateTender")
 }
 
 // TestCreateTender_FailEmployeeNotFound проверяет, что
@@ -218,7 +254,7 @@ func TestCreateTender_FailEmployeeNotFound(t *testing.T) {
 	mockTender := models.Tender{
 		TenderName:      "Tender 1",
 		Description:     "qwe",
-		ServiceType:     "op",
+		ServiceType:     "Construction",
 		Status:          "open",
 		OrganizationId:  1,
 		CreatorUsername: creatorUsername,
@@ -228,7 +264,7 @@ func TestCreateTender_FailEmployeeNotFound(t *testing.T) {
 		"tender": {
 			"name": "Tender 1",
 			"description": "qwe",
-			"service_type": "op",
+			"service_type": "Construction",
 			"status": "open",
 			"organization_id": 1,
 			"creator_username": "qwe"
@@ -241,20 +277,15 @@ func TestCreateTender_FailEmployeeNotFound(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPost, "/tenders/new", strings.NewReader(reqBody))
 	w := httptest.NewRecorder()
 
-	c, _ := gin.CreateTestContext(w)
-	c.Request = req
+	router := newRouter(authenticatedAs("qwe"))
+	router.POST("/tenders/new", svc.CreateTender(ctx))
 
 	// Act
-	handler := svc.CreateTender(ctx)
-	handler(c)
+	router.ServeHTTP(w, req)
 
 	// Assert
-	var resp schema.CreateTenderResponse
-	err := json.Unmarshal(w.Body.Bytes(), &resp)
-	require.NoError(t, err)
-	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
-	require.Equal(t, models.Tender{}, resp.Tender)
-	require.Equal(t, "employee with username=<qwe> not found", resp.Message)
+	details := requireProblem(t, w, http.StatusNotFound, "employee_not_found")
+	require.Equal(t, "employee not found", details.Detail)
 }
 
 // TestCreateTender_FailOrganizationNotFound проверяет, что
@@ -271,7 +302,7 @@ func TestCreateTender_FailOrganizationNotFound(t *testing.T) {
 	mockTender := models.Tender{
 		TenderName:      "Tender 1",
 		Description:     "qwe",
-		ServiceType:     "op",
+		ServiceType:     "Construction",
 		Status:          "open",
 		OrganizationId:  orgId,
 		CreatorUsername: "qwe",
@@ -281,7 +312,7 @@ func TestCreateTender_FailOrganizationNotFound(t *testing.T) {
 		"tender": {
 			"name": "Tender 1",
 			"description": "qwe",
-			"service_type": "op",
+			"service_type": "Construction",
 			"status": "open",
 			"organization_id": 1,
 			"creator_username": "qwe"
@@ -294,20 +325,15 @@ func TestCreateTender_FailOrganizationNotFound(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPost, "/tenders/new", strings.NewReader(reqBody))
 	w := httptest.NewRecorder()
 
-	c, _ := gin.CreateTestContext(w)
-	c.Request = req
+	router := newRouter(authenticatedAs("qwe"))
+	router.POST("/tenders/new", svc.CreateTender(ctx))
 
 	// Act
-	handler := svc.CreateTender(ctx)
-	handler(c)
+	router.ServeHTTP(w, req)
 
 	// Assert
-	var resp schema.CreateTenderResponse
-	err := json.Unmarshal(w.Body.Bytes(), &resp)
-	require.NoError(t, err)
-	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
-	require.Equal(t, models.Tender{}, resp.Tender)
-	require.Equal(t, "organization with id=<1> not found", resp.Message)
+	details := requireProblem(t, w, http.StatusNotFound, "organization_not_found")
+	require.Equal(t, "organization not found", details.Detail)
 }
 
 // TestCreateTender_FailUserNotResponsibleForOrganization
@@ -322,7 +348,7 @@ func TestCreateTender_FailEmployeerNotResponsibleForOrganization(t *testing.T) {
 	mockTender := models.Tender{
 		TenderName:      "Tender 1",
 		Description:     "qwe",
-		ServiceType:     "op",
+		ServiceType:     "Construction",
 		Status:          "open",
 		OrganizationId:  1,
 		CreatorUsername: "qwe",
@@ -332,7 +358,7 @@ func TestCreateTender_FailEmployeerNotResponsibleForOrganization(t *testing.T) {
 		"tender": {
 			"name": "Tender 1",
 			"description": "qwe",
-			"service_type": "op",
+			"service_type": "Construction",
 			"status": "open",
 			"organization_id": 1,
 			"creator_username": "qwe"
@@ -345,20 +371,15 @@ func TestCreateTender_FailEmployeerNotResponsibleForOrganization(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPost, "/tenders/new", strings.NewReader(reqBody))
 	w := httptest.NewRecorder()
 
-	c, _ := gin.CreateTestContext(w)
-	c.Request = req
+	router := newRouter(authenticatedAs("qwe"))
+	router.POST("/tenders/new", svc.CreateTender(ctx))
 
 	// Act
-	handler := svc.CreateTender(ctx)
-	handler(c)
+	router.ServeHTTP(w, req)
 
 	// Assert
-	var resp schema.CreateTenderResponse
-	err := json.Unmarshal(w.Body.Bytes(), &resp)
-	require.NoError(t, err)
-	assert.Equal(t, http.StatusForbidden, w.Code)
-	require.Equal(t, models.Tender{}, resp.Tender)
-	require.Equal(t, "employee <qwe> not responsible for organization with id=<1>", resp.Message)
+	details := requireProblem(t, w, http.StatusForbidden, "employee_not_responsible_for_organization")
+	require.Equal(t, "/tenders/new", details.Instance)
 }
 
 func TestCreateTender_FailWrongTenderStatus(t *testing.T) {
@@ -372,7 +393,7 @@ func TestCreateTender_FailWrongTenderStatus(t *testing.T) {
 	mockTender := models.Tender{
 		TenderName:      "Tender 1",
 		Description:     "qwe",
-		ServiceType:     "op",
+		ServiceType:     "Construction",
 		Status:          "open",
 		OrganizationId:  1,
 		CreatorUsername: "qwe",
@@ -382,7 +403,7 @@ func TestCreateTender_FailWrongTenderStatus(t *testing.T) {
 		"tender": {
 			"name": "Tender 1",
 			"description": "qwe",
-			"service_type": "op",
+			"service_type": "Construction",
 			"status": "open",
 			"organization_id": 1,
 			"creator_username": "qwe"
@@ -395,18 +416,65 @@ func TestCreateTender_FailWrongTenderStatus(t *testing.T) {
 	req := httptest.NewRequest(http.MethodPost, "/tenders/new", strings.NewReader(reqBody))
 	w := httptest.NewRecorder()
 
-	c, _ := gin.CreateTestContext(w)
-	c.Request = req
+	router := newRouter(authenticatedAs("qwe"))
+	router.POST("/tenders/new", svc.CreateTender(ctx))
 
 	// Act
-	handler := svc.CreateTender(ctx)
-	handler(c)
+	router.ServeHTTP(w, req)
+
+	// Assert
+	requireProblem(t, w, http.StatusBadRequest, "tender_initial_status_invalid")
+}
+
+// TestCreateTender_FailDeadlineBeforePublishAt проверяет, что если дедлайн
+// раньше публикации, то возвращается код 400.
+func TestCreateTender_FailDeadlineBeforePublishAt(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+
+	publishAt := time.Date(2024, 12, 20, 10, 0, 0, 0, time.UTC)
+	deadline := time.Date(2024, 12, 19, 10, 0, 0, 0, time.UTC)
+	mockTender := models.Tender{
+		TenderName:      "Tender 1",
+		Description:     "qwe",
+		ServiceType:     "Construction",
+		Status:          "CREATED",
+		OrganizationId:  1,
+		CreatorUsername: "qwe",
+		PublishAt:       &publishAt,
+		Deadline:        &deadline,
+	}
+	reqBody := `
+	{
+		"tender": {
+			"name": "Tender 1",
+			"description": "qwe",
+			"service_type": "Construction",
+			"status": "CREATED",
+			"organization_id": 1,
+			"creator_username": "qwe",
+			"publish_at": "2024-12-20T10:00:00Z",
+			"deadline": "2024-12-19T10:00:00Z"
+		}
+	}`
+
+	svc := tenderapi.New(logger, mockTenderService)
+
+	mockTenderService.On("CreateTender", ctx, mockTender).Return(models.Tender{}, outerror.ErrTenderDeadlineBeforePublishAt)
+	req := httptest.NewRequest(http.MethodPost, "/tenders/new", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	router := newRouter(authenticatedAs("qwe"))
+	router.POST("/tenders/new", svc.CreateTender(ctx))
+
+	// Act
+	router.ServeHTTP(w, req)
 
 	// Assert
-	var resp schema.CreateTenderResponse
-	err := json.Unmarshal(w.Body.Bytes(), &resp)
-	require.NoError(t, err)
-	assert.Equal(t, http.StatusBadRequest, w.Code)
-	require.Equal(t, models.Tender{}, resp.Tender)
-	require.Equal(t, "cannot create tender with status <open>", resp.Message)
+	details := requireProblem(t, w, http.StatusBadRequest, "tender_deadline_before_publish_at")
+	require.Equal(t, "tender deadline must be after publish_at", details.Detail)
 }
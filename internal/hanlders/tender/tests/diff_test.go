@@ -0,0 +1,113 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sariya23/tender/internal/domain/models"
+	tenderapi "github.com/sariya23/tender/internal/hanlders/tender"
+	"github.com/sariya23/tender/internal/hanlders/tender/mocks"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDiffTenderVersions_Success проверяет, что
+// возвращается дифф версий и код 200.
+func TestDiffTenderVersions_Success(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	mockDiff := models.TenderDiff{
+		TenderId:    2,
+		FromVersion: 1,
+		ToVersion:   2,
+		Changes: []models.TenderFieldDiff{
+			{Field: "name", From: "qwe", To: "zxc"},
+			{Field: "organization_id", From: 1, To: 2},
+		},
+	}
+	expectedBody := `
+	{
+		"diff": {
+			"tender_id": 2,
+			"from_version": 1,
+			"to_version": 2,
+			"changes": [
+				{"field": "name", "from": "qwe", "to": "zxc"},
+				{"field": "organization_id", "from": 1, "to": 2}
+			]
+		},
+		"message": "ok"
+	}`
+	svc := tenderapi.New(logger, mockTenderService)
+
+	mockTenderService.On("DiffTenderVersions", ctx, 2, 1, 2, "qwe").Return(mockDiff, nil)
+	router := gin.New()
+	router.Use(authenticatedAs("qwe"))
+	router.GET("/api/tenders/:tenderId/diff", svc.DiffTenderVersions(ctx))
+	req := httptest.NewRequest(http.MethodGet, "/api/tenders/2/diff?from=1&to=2&username=qwe", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestDiffTenderVersions_FailFromNotSpecified проверяет, что
+// без параметра from возвращается код 400.
+func TestDiffTenderVersions_FailFromNotSpecified(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	svc := tenderapi.New(logger, mockTenderService)
+	router := newRouter(authenticatedAs("qwe"))
+	router.GET("/api/tenders/:tenderId/diff", svc.DiffTenderVersions(ctx))
+	req := httptest.NewRequest(http.MethodGet, "/api/tenders/2/diff?to=2&username=qwe", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	require.Contains(t, w.Body.String(), "from query parameter must be positive integer")
+	mockTenderService.AssertNotCalled(t, "DiffTenderVersions")
+}
+
+// TestDiffTenderVersions_FailVersionNotFound проверяет, что
+// если версии нет, то возвращается код 404.
+func TestDiffTenderVersions_FailVersionNotFound(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	svc := tenderapi.New(logger, mockTenderService)
+
+	mockTenderService.On("DiffTenderVersions", ctx, 2, 1, 5, "qwe").Return(models.TenderDiff{}, outerror.ErrTenderVersionNotFound)
+	router := newRouter(authenticatedAs("qwe"))
+	router.GET("/api/tenders/:tenderId/diff", svc.DiffTenderVersions(ctx))
+	req := httptest.NewRequest(http.MethodGet, "/api/tenders/2/diff?from=1&to=5&username=qwe", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
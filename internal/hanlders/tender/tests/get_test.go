@@ -3,9 +3,11 @@ package tests
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
@@ -29,21 +31,23 @@ func TestGetAllTenders_Success(t *testing.T) {
 
 	logger := slogdiscard.NewDiscardLogger()
 	mockTenderService := new(mocks.MockTenderServiceProvider)
+	createdAt := time.Date(2024, 12, 18, 10, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2024, 12, 18, 12, 30, 0, 0, time.UTC)
 	mockTenders := []models.Tender{
-		{TenderName: "Tender 1", Description: "qwe", ServiceType: "op", Status: "open", OrganizationId: 1, CreatorUsername: "qwe"},
-		{TenderName: "Tender 1", Description: "qwe", ServiceType: "op", Status: "open", OrganizationId: 1, CreatorUsername: "qwe"},
+		{ID: 1, Version: 1, CreatedAt: createdAt, UpdatedAt: createdAt, TenderName: "Tender 1", Description: "qwe", ServiceType: "op", Status: "open", OrganizationId: 1, CreatorUsername: "qwe"},
+		{ID: 2, Version: 3, CreatedAt: createdAt, UpdatedAt: updatedAt, TenderName: "Tender 1", Description: "qwe", ServiceType: "op", Status: "open", OrganizationId: 1, CreatorUsername: "qwe"},
 	}
 	expectedBody := `
 	{
 		"tenders":[
-			{"name":"Tender 1", "description": "qwe", "service_type": "op", "status": "open", "organization_id": 1, "creator_username": "qwe"},
-			{"name":"Tender 1", "description": "qwe", "service_type": "op", "status": "open", "organization_id": 1, "creator_username": "qwe"}
-		],"message":"ok"
+			{"id": 1, "version": 1, "created_at": "2024-12-18T10:00:00Z", "updated_at": "2024-12-18T10:00:00Z", "name":"Tender 1", "description": "qwe", "service_type": "op", "status": "open", "organization_id": 1, "creator_username": "qwe"},
+			{"id": 2, "version": 3, "created_at": "2024-12-18T10:00:00Z", "updated_at": "2024-12-18T12:30:00Z", "name":"Tender 1", "description": "qwe", "service_type": "op", "status": "open", "organization_id": 1, "creator_username": "qwe"}
+		],"total": 2, "next_cursor": null, "message":"ok"
 	}
 	`
 	svc := tenderapi.New(logger, mockTenderService)
 
-	mockTenderService.On("GetTenders", ctx, "all").Return(mockTenders, nil)
+	mockTenderService.On("GetTenders", ctx, models.TenderFilter{}, models.Page{Limit: 20}).Return(models.TenderPage{Tenders: mockTenders, Total: 2}, nil)
 	req := httptest.NewRequest(http.MethodGet, "/tenders?srv_type=all", nil)
 	w := httptest.NewRecorder()
 
@@ -73,12 +77,14 @@ func TestGetAllTenders_SuccessTendersNotFound(t *testing.T) {
 	expectedBody := `
 	{	
 		"tenders": [],
+		"total": 0,
+		"next_cursor": null,
 		"message":"no tenders found with service type=<qwe>"
 	}
 	`
 	svc := tenderapi.New(logger, mockTenderService)
 
-	mockTenderService.On("GetTenders", ctx, "qwe").Return(mockTenders, outerror.ErrTendersWithThisServiceTypeNotFound)
+	mockTenderService.On("GetTenders", ctx, models.TenderFilter{ServiceTypes: []string{"qwe"}}, models.Page{Limit: 20}).Return(models.TenderPage{Tenders: mockTenders}, outerror.ErrTendersNotFound)
 	req := httptest.NewRequest(http.MethodGet, "/tenders?srv_type=qwe", nil)
 	w := httptest.NewRecorder()
 
@@ -94,6 +100,44 @@ func TestGetAllTenders_SuccessTendersNotFound(t *testing.T) {
 	require.JSONEq(t, expectedBody, w.Body.String())
 }
 
+// TestGetAllTenders_SuccessTendersNotFoundWithoutServiceType проверяет,
+// что если тендеров нет, а тип услуг не указан, то сообщение не
+// упоминает тип услуг.
+//
+// Возвращается код 200.
+func TestGetAllTenders_SuccessTendersNotFoundWithoutServiceType(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	expectedBody := `
+	{
+		"tenders": [],
+		"total": 0,
+		"next_cursor": null,
+		"message": "no tenders found"
+	}
+	`
+	svc := tenderapi.New(logger, mockTenderService)
+
+	mockTenderService.On("GetTenders", ctx, models.TenderFilter{}, models.Page{Limit: 20}).Return(models.TenderPage{Tenders: []models.Tender{}}, outerror.ErrTendersNotFound)
+	req := httptest.NewRequest(http.MethodGet, "/tenders", nil)
+	w := httptest.NewRecorder()
+
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	// Act
+	handler := svc.GetTenders(ctx)
+	handler(c)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
 // TestGetAllTenders_FailinternalError проверяет, что
 // если произошла какая-то внутренняя ошибка, то возвращается
 // код 500 и сообщение
@@ -108,22 +152,24 @@ func TestGetAllTenders_FailinternalError(t *testing.T) {
 	someErr := errors.New("some error")
 	expectedBody := `
 	{
-		"tenders": [],
-		"message":"internal error"
+		"type": "urn:tender:problem:internal_error",
+		"title": "Internal error",
+		"status": 500,
+		"instance": "/tenders",
+		"code": "internal_error"
 	}
 	`
 	svc := tenderapi.New(logger, mockTenderService)
 
-	mockTenderService.On("GetTenders", ctx, "qwe").Return(mockTenders, someErr)
+	mockTenderService.On("GetTenders", ctx, models.TenderFilter{ServiceTypes: []string{"qwe"}}, models.Page{Limit: 20}).Return(models.TenderPage{Tenders: mockTenders}, someErr)
 	req := httptest.NewRequest(http.MethodGet, "/tenders?srv_type=qwe", nil)
 	w := httptest.NewRecorder()
 
-	c, _ := gin.CreateTestContext(w)
-	c.Request = req
+	router := newRouter()
+	router.GET("/tenders", svc.GetTenders(ctx))
 
 	// Act
-	handler := svc.GetTenders(ctx)
-	handler(c)
+	router.ServeHTTP(w, req)
 
 	// Assert
 	assert.Equal(t, http.StatusInternalServerError, w.Code)
@@ -141,26 +187,29 @@ func TestGetEmployeeTenders_Success(t *testing.T) {
 	logger := slogdiscard.NewDiscardLogger()
 	mockTenderService := new(mocks.MockTenderServiceProvider)
 	username := "qwe"
+	createdAt := time.Date(2024, 12, 18, 10, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2024, 12, 18, 12, 30, 0, 0, time.UTC)
 	mockTenders := []models.Tender{
-		{TenderName: "Tender 1", Description: "qwe", ServiceType: "op", Status: "open", OrganizationId: 1, CreatorUsername: username},
-		{TenderName: "Tender 1", Description: "qwe", ServiceType: "op", Status: "open", OrganizationId: 1, CreatorUsername: username},
+		{ID: 1, Version: 1, CreatedAt: createdAt, UpdatedAt: createdAt, TenderName: "Tender 1", Description: "qwe", ServiceType: "op", Status: "open", OrganizationId: 1, CreatorUsername: username},
+		{ID: 2, Version: 3, CreatedAt: createdAt, UpdatedAt: updatedAt, TenderName: "Tender 1", Description: "qwe", ServiceType: "op", Status: "open", OrganizationId: 1, CreatorUsername: username},
 	}
 	expectedBody := `
 	{
 		"tenders":[
-			{"name":"Tender 1", "description": "qwe", "service_type": "op", "status": "open", "organization_id": 1, "creator_username": "qwe"},
-			{"name":"Tender 1", "description": "qwe", "service_type": "op", "status": "open", "organization_id": 1, "creator_username": "qwe"}
-		],"message":"ok"
+			{"id": 1, "version": 1, "created_at": "2024-12-18T10:00:00Z", "updated_at": "2024-12-18T10:00:00Z", "name":"Tender 1", "description": "qwe", "service_type": "op", "status": "open", "organization_id": 1, "creator_username": "qwe"},
+			{"id": 2, "version": 3, "created_at": "2024-12-18T10:00:00Z", "updated_at": "2024-12-18T12:30:00Z", "name":"Tender 1", "description": "qwe", "service_type": "op", "status": "open", "organization_id": 1, "creator_username": "qwe"}
+		],"total": 2, "next_cursor": null, "message":"ok"
 	}
 	`
 	svc := tenderapi.New(logger, mockTenderService)
 
-	mockTenderService.On("GetEmployeeTendersByUsername", ctx, username).Return(mockTenders, nil)
+	mockTenderService.On("GetEmployeeTendersByUsername", ctx, username, models.Page{Limit: 20}).Return(models.TenderPage{Tenders: mockTenders, Total: 2}, nil)
 	req := httptest.NewRequest(http.MethodGet, "/tenders/my?username=qwe", nil)
 	w := httptest.NewRecorder()
 
 	c, _ := gin.CreateTestContext(w)
 	c.Request = req
+	authenticatedAs("qwe")(c)
 
 	// Act
 	handler := svc.GetEmployeeTendersByUsername(ctx)
@@ -183,22 +232,25 @@ func TestGetEmployeeTenders_FailEmployeeNotFound(t *testing.T) {
 	username := "qwe"
 	expectedBody := `
 	{
-		"tenders": [],
-		"message": "employee with username=<qwe> not found"
+		"type": "urn:tender:problem:employee_not_found",
+		"title": "Employee not found",
+		"status": 404,
+		"detail": "employee not found",
+		"instance": "/tenders/my",
+		"code": "employee_not_found"
 	}
 	`
 	svc := tenderapi.New(logger, mockTenderService)
 
-	mockTenderService.On("GetEmployeeTendersByUsername", ctx, username).Return([]models.Tender{}, outerror.ErrEmployeeNotFound)
+	mockTenderService.On("GetEmployeeTendersByUsername", ctx, username, models.Page{Limit: 20}).Return(models.TenderPage{Tenders: []models.Tender{}}, outerror.ErrEmployeeNotFound)
 	req := httptest.NewRequest(http.MethodGet, "/tenders/my?username=qwe", nil)
 	w := httptest.NewRecorder()
 
-	c, _ := gin.CreateTestContext(w)
-	c.Request = req
+	router := newRouter(authenticatedAs("qwe"))
+	router.GET("/tenders/my", svc.GetEmployeeTendersByUsername(ctx))
 
 	// Act
-	handler := svc.GetEmployeeTendersByUsername(ctx)
-	handler(c)
+	router.ServeHTTP(w, req)
 
 	// Assert
 	assert.Equal(t, http.StatusNotFound, w.Code)
@@ -218,17 +270,148 @@ func TestGetEmployeeTenders_SuccessEmployeeTendersNotFound(t *testing.T) {
 	expectedBody := `
 	{
 		"tenders": [],
+		"total": 0,
+		"next_cursor": null,
 		"message": "not found tenders for employee with username=<qwe>"
 	}
 	`
 	svc := tenderapi.New(logger, mockTenderService)
 
-	mockTenderService.On("GetEmployeeTendersByUsername", ctx, username).Return([]models.Tender{}, outerror.ErrEmployeeTendersNotFound)
+	mockTenderService.On("GetEmployeeTendersByUsername", ctx, username, models.Page{Limit: 20}).Return(models.TenderPage{Tenders: []models.Tender{}}, outerror.ErrEmployeeTendersNotFound)
 	req := httptest.NewRequest(http.MethodGet, "/tenders/my?username=qwe", nil)
 	w := httptest.NewRecorder()
 
 	c, _ := gin.CreateTestContext(w)
 	c.Request = req
+	authenticatedAs("qwe")(c)
+
+	// Act
+	handler := svc.GetEmployeeTendersByUsername(ctx)
+	handler(c)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestGetAllTenders_SuccessAfterIdPage проверяет, что параметры
+// limit и after_id передаются в сервис, а в ответе возвращается
+// общее количество тендеров и курсор следующей страницы.
+func TestGetAllTenders_SuccessAfterIdPage(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	createdAt := time.Date(2024, 12, 18, 10, 0, 0, 0, time.UTC)
+	mockTenders := []models.Tender{
+		{ID: 6, Version: 1, CreatedAt: createdAt, UpdatedAt: createdAt, TenderName: "Tender 6", Description: "qwe", ServiceType: "op", Status: "open", OrganizationId: 1, CreatorUsername: "qwe"},
+	}
+	nextCursor := 6
+	expectedBody := `
+	{
+		"tenders":[
+			{"id": 6, "version": 1, "created_at": "2024-12-18T10:00:00Z", "updated_at": "2024-12-18T10:00:00Z", "name":"Tender 6", "description": "qwe", "service_type": "op", "status": "open", "organization_id": 1, "creator_username": "qwe"}
+		],"total": 10, "next_cursor": 6, "message":"ok"
+	}
+	`
+	svc := tenderapi.New(logger, mockTenderService)
+
+	mockTenderService.On("GetTenders", ctx, models.TenderFilter{ServiceTypes: []string{"op"}}, models.Page{Limit: 1, AfterId: 5}).Return(models.TenderPage{Tenders: mockTenders, Total: 10, NextCursor: &nextCursor}, nil)
+	req := httptest.NewRequest(http.MethodGet, "/tenders?srv_type=op&limit=1&after_id=5", nil)
+	w := httptest.NewRecorder()
+
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	// Act
+	handler := svc.GetTenders(ctx)
+	handler(c)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestGetAllTenders_FailInvalidPage проверяет, что
+// при некорректных параметрах страницы возвращается код 400
+// и сервис не вызывается.
+func TestGetAllTenders_FailInvalidPage(t *testing.T) {
+	cases := []struct {
+		name    string
+		query   string
+		message string
+	}{
+		{name: "zero limit", query: "limit=0", message: "limit must be integer from 1 to 100"},
+		{name: "too big limit", query: "limit=101", message: "limit must be integer from 1 to 100"},
+		{name: "not integer limit", query: "limit=qwe", message: "limit must be integer from 1 to 100"},
+		{name: "negative offset", query: "offset=-1", message: "offset must be non negative integer"},
+		{name: "zero after_id", query: "after_id=0", message: "after_id must be positive integer"},
+		{name: "offset with after_id", query: "offset=1&after_id=2", message: "offset and after_id cannot be used together"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			gin.SetMode(gin.TestMode)
+			ctx := context.Background()
+
+			logger := slogdiscard.NewDiscardLogger()
+			mockTenderService := new(mocks.MockTenderServiceProvider)
+			expectedBody := fmt.Sprintf(`{
+				"type": "urn:tender:problem:invalid_request",
+				"title": "Invalid request",
+				"status": 400,
+				"detail": %q,
+				"instance": "/tenders",
+				"code": "invalid_request"
+			}`, tc.message)
+			svc := tenderapi.New(logger, mockTenderService)
+
+			req := httptest.NewRequest(http.MethodGet, "/tenders?"+tc.query, nil)
+			w := httptest.NewRecorder()
+
+			router := newRouter()
+			router.GET("/tenders", svc.GetTenders(ctx))
+
+			// Act
+			router.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			require.JSONEq(t, expectedBody, w.Body.String())
+			mockTenderService.AssertNotCalled(t, "GetTenders")
+		})
+	}
+}
+
+// TestGetEmployeeTenders_SuccessOffsetPage проверяет, что
+// параметры limit и offset передаются в сервис.
+func TestGetEmployeeTenders_SuccessOffsetPage(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	username := "qwe"
+	expectedBody := `
+	{
+		"tenders": [],
+		"total": 3,
+		"next_cursor": null,
+		"message": "ok"
+	}
+	`
+	svc := tenderapi.New(logger, mockTenderService)
+
+	mockTenderService.On("GetEmployeeTendersByUsername", ctx, username, models.Page{Limit: 5, Offset: 5}).Return(models.TenderPage{Tenders: []models.Tender{}, Total: 3}, nil)
+	req := httptest.NewRequest(http.MethodGet, "/tenders/my?username=qwe&limit=5&offset=5", nil)
+	w := httptest.NewRecorder()
+
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	authenticatedAs("qwe")(c)
 
 	// Act
 	handler := svc.GetEmployeeTendersByUsername(ctx)
@@ -238,3 +421,283 @@ func TestGetEmployeeTenders_SuccessEmployeeTendersNotFound(t *testing.T) {
 	assert.Equal(t, http.StatusOK, w.Code)
 	require.JSONEq(t, expectedBody, w.Body.String())
 }
+
+// TestGetTenders_SuccessFilter проверяет, что
+// все параметры фильтра и сортировки передаются в сервис.
+func TestGetTenders_SuccessFilter(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	createdFrom := time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)
+	createdTo := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+	expectedFilter := models.TenderFilter{
+		ServiceTypes:    []string{"op", "build", "clean"},
+		OrganizationId:  3,
+		CreatorUsername: "zxc",
+		Statuses:        []string{"CREATED", "PUBLISHED"},
+		NameContains:    "road",
+		CreatedFrom:     &createdFrom,
+		CreatedTo:       &createdTo,
+		Viewer:          "qwe",
+		Sort:            models.TenderSort{Field: models.TenderSortByCreatedAt, Desc: true},
+	}
+	expectedBody := `{"tenders": [], "total": 0, "next_cursor": null, "message": "ok"}`
+	svc := tenderapi.New(logger, mockTenderService)
+
+	mockTenderService.On("GetTenders", ctx, expectedFilter, models.Page{Limit: 20, Offset: 40}).Return(models.TenderPage{Tenders: []models.Tender{}}, nil)
+	req := httptest.NewRequest(
+		http.MethodGet,
+		"/tenders?srv_type=op,build&srv_type=clean&organization_id=3&creator_username=zxc&status=CREATED,PUBLISHED&name=road"+
+			"&created_from=2024-12-01T00:00:00Z&created_to=2024-12-31T00:00:00Z&username=qwe&sort=-created_at&offset=40",
+		nil,
+	)
+	w := httptest.NewRecorder()
+
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	authenticatedAs("qwe")(c)
+
+	// Act
+	handler := svc.GetTenders(ctx)
+	handler(c)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestGetTenders_FailInvalidFilter проверяет, что
+// при некорректных параметрах фильтра возвращается код 400
+// и сервис не вызывается.
+func TestGetTenders_FailInvalidFilter(t *testing.T) {
+	cases := []struct {
+		name    string
+		query   string
+		message string
+	}{
+		{name: "not integer organization", query: "organization_id=qwe", message: "organization_id must be positive integer"},
+		{name: "negative organization", query: "organization_id=-1", message: "organization_id must be positive integer"},
+		{name: "invalid created_from", query: "created_from=2024-12-01", message: "created_from must be RFC3339 date-time"},
+		{name: "invalid created_to", query: "created_to=qwe", message: "created_to must be RFC3339 date-time"},
+		{name: "reversed created range", query: "created_from=2024-12-31T00:00:00Z&created_to=2024-12-01T00:00:00Z", message: "created_from must be before created_to"},
+		{name: "unknown sort", query: "sort=status", message: "sort must be one of id, name, created_at, service_type, optionally prefixed with -"},
+		{name: "after_id with sort", query: "sort=name&after_id=3", message: "after_id can be used only with sort by id"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			gin.SetMode(gin.TestMode)
+			ctx := context.Background()
+
+			logger := slogdiscard.NewDiscardLogger()
+			mockTenderService := new(mocks.MockTenderServiceProvider)
+			expectedBody := fmt.Sprintf(`{
+				"type": "urn:tender:problem:invalid_request",
+				"title": "Invalid request",
+				"status": 400,
+				"detail": %q,
+				"instance": "/tenders",
+				"code": "invalid_request"
+			}`, tc.message)
+			svc := tenderapi.New(logger, mockTenderService)
+
+			req := httptest.NewRequest(http.MethodGet, "/tenders?"+tc.query, nil)
+			w := httptest.NewRecorder()
+
+			router := newRouter()
+			router.GET("/tenders", svc.GetTenders(ctx))
+
+			// Act
+			router.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			require.JSONEq(t, expectedBody, w.Body.String())
+			mockTenderService.AssertNotCalled(t, "GetTenders")
+		})
+	}
+}
+
+// TestGetTenders_FailStatusFilterWithoutUsername проверяет, что
+// если сервис требует аутентификации для фильтра по статусу,
+// то возвращается код 400.
+func TestGetTenders_FailStatusFilterWithoutUsername(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	expectedBody := `
+	{
+		"type": "urn:tender:problem:tender_status_filter_requires_authentication",
+		"title": "Authentication required for tender status filter",
+		"status": 400,
+		"detail": "authentication required to filter by status other than PUBLISHED",
+		"instance": "/tenders",
+		"code": "tender_status_filter_requires_authentication"
+	}
+	`
+	svc := tenderapi.New(logger, mockTenderService)
+
+	mockTenderService.On("GetTenders", ctx, models.TenderFilter{Statuses: []string{"CLOSED"}}, models.Page{Limit: 20}).Return(models.TenderPage{Tenders: []models.Tender{}}, outerror.ErrTenderStatusFilterRequiresUsername)
+	req := httptest.NewRequest(http.MethodGet, "/tenders?status=CLOSED", nil)
+	w := httptest.NewRecorder()
+
+	router := newRouter()
+	router.GET("/tenders", svc.GetTenders(ctx))
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestGetTenders_FailUsernameWithoutAuthentication проверяет, что
+// username в query без токена не дает доступа к чужим тендерам.
+//
+// Возвращается код 401.
+func TestGetTenders_FailUsernameWithoutAuthentication(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	expectedBody := `
+	{
+		"type": "urn:tender:problem:not_authenticated",
+		"title": "Authentication required",
+		"status": 401,
+		"detail": "authentication required",
+		"instance": "/tenders",
+		"code": "not_authenticated"
+	}
+	`
+	svc := tenderapi.New(logger, mockTenderService)
+	req := httptest.NewRequest(http.MethodGet, "/tenders?status=CREATED&username=qwe", nil)
+	w := httptest.NewRecorder()
+
+	router := newRouter()
+	router.GET("/tenders", svc.GetTenders(ctx))
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+	mockTenderService.AssertNotCalled(t, "GetTenders")
+}
+
+// TestGetOrganizationTenders_Success проверяет, что фильтр из query
+// передается в сервис, а в ответе тендеры организации в любом статусе.
+func TestGetOrganizationTenders_Success(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	createdAt := time.Date(2024, 12, 18, 10, 0, 0, 0, time.UTC)
+	mockTenders := []models.Tender{
+		{ID: 1, Version: 1, CreatedAt: createdAt, UpdatedAt: createdAt, TenderName: "Tender 1", Description: "qwe", ServiceType: "op", Status: "CREATED", OrganizationId: 1, CreatorUsername: "zxc"},
+	}
+	expectedBody := `
+	{
+		"tenders":[
+			{"id": 1, "version": 1, "created_at": "2024-12-18T10:00:00Z", "updated_at": "2024-12-18T10:00:00Z", "name":"Tender 1", "description": "qwe", "service_type": "op", "status": "CREATED", "organization_id": 1, "creator_username": "zxc"}
+		],"total": 1, "next_cursor": null, "message":"ok"
+	}
+	`
+	svc := tenderapi.New(logger, mockTenderService)
+	filter := models.TenderFilter{ServiceTypes: []string{"op"}, Statuses: []string{"CREATED"}}
+
+	mockTenderService.On("GetOrganizationTenders", ctx, 1, "qwe", filter, models.Page{Limit: 20}).Return(models.TenderPage{Tenders: mockTenders, Total: 1}, nil)
+	router := gin.New()
+	router.Use(authenticatedAs("qwe"))
+	router.GET("/api/organizations/:organizationId/tenders", svc.GetOrganizationTenders(ctx))
+	req := httptest.NewRequest(http.MethodGet, "/api/organizations/1/tenders?srv_type=op&status=CREATED", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestGetOrganizationTenders_FailNotResponsible проверяет, что
+// сотрудник не из организации получает код 403.
+func TestGetOrganizationTenders_FailNotResponsible(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	expectedBody := `
+	{
+		"type": "urn:tender:problem:employee_not_responsible_for_organization",
+		"title": "Employee not responsible for organization",
+		"status": 403,
+		"detail": "employee not responsible for organization",
+		"instance": "/api/organizations/1/tenders",
+		"code": "employee_not_responsible_for_organization"
+	}
+	`
+	svc := tenderapi.New(logger, mockTenderService)
+
+	mockTenderService.On("GetOrganizationTenders", ctx, 1, "zxc", models.TenderFilter{}, models.Page{Limit: 20}).Return(models.TenderPage{Tenders: []models.Tender{}}, outerror.ErrEmployeeNotResponsibleForOrganization)
+	router := newRouter(authenticatedAs("zxc"))
+	router.GET("/api/organizations/:organizationId/tenders", svc.GetOrganizationTenders(ctx))
+	req := httptest.NewRequest(http.MethodGet, "/api/organizations/1/tenders", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestGetOrganizationTenders_FailInvalidOrganizationId проверяет, что
+// при нечисловом id организации возвращается код 404.
+func TestGetOrganizationTenders_FailInvalidOrganizationId(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	expectedBody := `
+	{
+		"type": "urn:tender:problem:organization_not_found",
+		"title": "Organization not found",
+		"status": 404,
+		"detail": "cannot convert organizationId to integer",
+		"instance": "/api/organizations/qwe/tenders",
+		"code": "organization_not_found"
+	}
+	`
+	svc := tenderapi.New(logger, mockTenderService)
+	router := newRouter(authenticatedAs("qwe"))
+	router.GET("/api/organizations/:organizationId/tenders", svc.GetOrganizationTenders(ctx))
+	req := httptest.NewRequest(http.MethodGet, "/api/organizations/qwe/tenders", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+	mockTenderService.AssertNotCalled(t, "GetOrganizationTenders")
+}
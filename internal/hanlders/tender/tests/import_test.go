@@ -0,0 +1,279 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sariya23/tender/internal/domain/models"
+	schema "github.com/sariya23/tender/internal/hanlders"
+	tenderapi "github.com/sariya23/tender/internal/hanlders/tender"
+	"github.com/sariya23/tender/internal/hanlders/tender/mocks"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	"github.com/sariya23/tender/internal/lib/problem"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/stretchr/testify/require"
+)
+
+// TestImportTenders_SuccessCSV проверяет, что строки CSV файла с
+// ошибками попадают в отчет, а остальные передаются в сервис с
+// заполненными статусом и создателем.
+func TestImportTenders_SuccessCSV(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+
+	reqBody := "name,description,service_type,organization_id\n" +
+		"Тендер 1,qwe,Construction,1\n" +
+		"Тендер 2,qwe,Construction,qwe\n" +
+		",qwe,Construction,1\n"
+	tender := models.Tender{
+		TenderName: "Тендер 1", Description: "qwe", ServiceType: "Construction", Status: models.TenderCreatedStatus, OrganizationId: 1, CreatorUsername: "qwe",
+	}
+	noName := models.Tender{
+		Description: "qwe", ServiceType: "Construction", Status: models.TenderCreatedStatus, OrganizationId: 1, CreatorUsername: "qwe",
+	}
+	rows := []models.TenderImportRow{
+		{Line: 2, Tender: tender},
+		{Line: 3, Err: &problem.Error{
+			Err:    outerror.ErrValidationFailed,
+			Fields: []problem.FieldError{{Pointer: "/organization_id", Rule: "integer", Message: "must be integer"}},
+		}},
+		{Line: 4, Tender: noName, Err: &problem.Error{
+			Err:    outerror.ErrValidationFailed,
+			Fields: []problem.FieldError{{Pointer: "/name", Rule: "required", Message: "value is required"}},
+		}},
+	}
+	createdTender := tender
+	createdTender.ID = 1
+	createdTender.Version = 1
+	imported := append([]models.TenderImportRow{{Line: 2, Tender: createdTender}}, rows[1:]...)
+
+	svc := tenderapi.New(logger, mockTenderService)
+	mockTenderService.On("ImportTenders", ctx, rows, "qwe", false).Return(imported, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/tenders/import", strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "text/csv")
+	w := httptest.NewRecorder()
+
+	router := newRouter(authenticatedAs("qwe"))
+	router.POST("/tenders/import", svc.ImportTenders(ctx))
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	require.Equal(t, http.StatusOK, w.Code)
+	var response schema.ImportTendersResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.False(t, response.DryRun)
+	require.Equal(t, 3, response.Total)
+	require.Equal(t, 1, response.Succeeded)
+	require.Equal(t, 2, response.Failed)
+	require.Equal(t, schema.ImportTenderRow{Line: 2, Status: schema.ImportRowCreated, Tender: &createdTender}, response.Rows[0])
+	require.Equal(t, schema.ImportRowFailed, response.Rows[1].Status)
+	require.Equal(t, "validation_failed", response.Rows[1].Error.Code)
+	require.Equal(t, []problem.FieldError{{Pointer: "/organization_id", Rule: "integer", Message: "must be integer"}}, response.Rows[1].Error.Errors)
+	require.Equal(t, "/name", response.Rows[2].Error.Errors[0].Pointer)
+}
+
+// TestImportTenders_DryRunNDJSON проверяет, что при dry run строки,
+// прошедшие проверки сервиса, отмечаются как valid, а ошибки сервиса
+// попадают в отчет со своим кодом.
+func TestImportTenders_DryRunNDJSON(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+
+	reqBody := `{"name": "Тендер 1", "description": "qwe", "service_type": "Construction", "organization_id": 1}` + "\n" +
+		`{"name": "Тендер 2", "description": "qwe", "service_type": "Construction", "organization_id": 2, "creator_username": "asd"}` + "\n"
+	first := models.Tender{
+		TenderName: "Тендер 1", Description: "qwe", ServiceType: "Construction", Status: models.TenderCreatedStatus, OrganizationId: 1, CreatorUsername: "qwe",
+	}
+	second := models.Tender{
+		TenderName: "Тендер 2", Description: "qwe", ServiceType: "Construction", Status: models.TenderCreatedStatus, OrganizationId: 2, CreatorUsername: "asd",
+	}
+	rows := []models.TenderImportRow{{Line: 1, Tender: first}, {Line: 2, Tender: second}}
+	checked := []models.TenderImportRow{
+		{Line: 1, Tender: first},
+		{Line: 2, Err: fmt.Errorf("check: %w", outerror.ErrEmployeeNotResponsibleForTender)},
+	}
+
+	svc := tenderapi.New(logger, mockTenderService)
+	mockTenderService.On("ImportTenders", ctx, rows, "qwe", true).Return(checked, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/tenders/import?dry_run=true", strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/x-ndjson; charset=utf-8")
+	w := httptest.NewRecorder()
+
+	router := newRouter(authenticatedAs("qwe"))
+	router.POST("/tenders/import", svc.ImportTenders(ctx))
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	require.Equal(t, http.StatusOK, w.Code)
+	var response schema.ImportTendersResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.True(t, response.DryRun)
+	require.Equal(t, 1, response.Succeeded)
+	require.Equal(t, 1, response.Failed)
+	require.Equal(t, schema.ImportTenderRow{Line: 1, Status: schema.ImportRowValid, Tender: &first}, response.Rows[0])
+	require.Equal(t, schema.ImportRowFailed, response.Rows[1].Status)
+	require.Nil(t, response.Rows[1].Tender)
+	require.Equal(t, "employee_not_responsible_for_tender", response.Rows[1].Error.Code)
+}
+
+// TestImportTenders_FailRequest проверяет, что файл не импортируется,
+// если запрос или заголовок файла некорректные.
+func TestImportTenders_FailRequest(t *testing.T) {
+	cases := []struct {
+		name           string
+		target         string
+		contentType    string
+		body           string
+		acceptLanguage string
+		status         int
+		code           string
+		detail         string
+	}{
+		{
+			name:        "unsupported format",
+			target:      "/tenders/import",
+			contentType: "application/json",
+			body:        `[]`,
+			status:      http.StatusUnsupportedMediaType,
+			code:        "unsupported_import_format",
+			detail:      "Content-Type must be text/csv or application/x-ndjson",
+		},
+		{
+			name:        "invalid dry_run",
+			target:      "/tenders/import?dry_run=qwe",
+			contentType: "text/csv",
+			body:        "name\n",
+			status:      http.StatusBadRequest,
+			code:        "invalid_request",
+			detail:      "dry_run must be true or false",
+		},
+		{
+			name:           "missing column",
+			target:         "/tenders/import",
+			contentType:    "text/csv",
+			body:           "name,description,organization_id\nТендер 1,qwe,1\n",
+			acceptLanguage: "ru",
+			status:         http.StatusBadRequest,
+			code:           "invalid_request",
+			detail:         "нет обязательной колонки service_type",
+		},
+		{
+			name:        "no rows",
+			target:      "/tenders/import",
+			contentType: "application/x-ndjson",
+			body:        "\n",
+			status:      http.StatusBadRequest,
+			code:        "invalid_request",
+			detail:      "import file has no rows",
+		},
+		{
+			name:        "syntax error",
+			target:      "/tenders/import",
+			contentType: "text/csv",
+			body:        "name,description,service_type,organization_id\nТендер \"1\",qwe,Construction,1\n",
+			status:      http.StatusBadRequest,
+			code:        "invalid_request",
+			detail:      `syntax error in line 2: bare " in non-quoted-field`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			gin.SetMode(gin.TestMode)
+			ctx := context.Background()
+			logger := slogdiscard.NewDiscardLogger()
+			mockTenderService := new(mocks.MockTenderServiceProvider)
+			svc := tenderapi.New(logger, mockTenderService)
+
+			req := httptest.NewRequest(http.MethodPost, tc.target, strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", tc.contentType)
+			req.Header.Set("Accept-Language", tc.acceptLanguage)
+			w := httptest.NewRecorder()
+
+			router := newRouter(authenticatedAs("qwe"))
+			router.POST("/tenders/import", svc.ImportTenders(ctx))
+
+			// Act
+			router.ServeHTTP(w, req)
+
+			// Assert
+			details := requireProblem(t, w, tc.status, tc.code)
+			require.Equal(t, tc.detail, details.Detail)
+			mockTenderService.AssertNotCalled(t, "ImportTenders")
+		})
+	}
+}
+
+// TestImportTenders_CanceledPartialReport проверяет, что если импорт
+// отменен после созданной пачки, то возвращается отчет с созданными
+// тендерами и строками, до которых импорт не дошел.
+func TestImportTenders_CanceledPartialReport(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+
+	reqBody := "name,description,service_type,organization_id\n" +
+		"Тендер 1,qwe,Construction,1\n" +
+		"Тендер 2,qwe,Construction,1\n"
+	tender1 := models.Tender{
+		TenderName: "Тендер 1", Description: "qwe", ServiceType: "Construction", Status: models.TenderCreatedStatus, OrganizationId: 1, CreatorUsername: "qwe",
+	}
+	tender2 := tender1
+	tender2.TenderName = "Тендер 2"
+	rows := []models.TenderImportRow{
+		{Line: 2, Tender: tender1},
+		{Line: 3, Tender: tender2},
+	}
+	createdTender := tender1
+	createdTender.ID = 1
+	createdTender.Version = 1
+	imported := []models.TenderImportRow{
+		{Line: 2, Tender: createdTender},
+		{Line: 3, Tender: tender2, Err: fmt.Errorf("%w: %w", outerror.ErrImportRowNotAttempted, context.Canceled)},
+	}
+
+	svc := tenderapi.New(logger, mockTenderService)
+	mockTenderService.On("ImportTenders", ctx, rows, "qwe", false).Return(imported, context.Canceled)
+
+	req := httptest.NewRequest(http.MethodPost, "/tenders/import", strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "text/csv")
+	w := httptest.NewRecorder()
+
+	router := newRouter(authenticatedAs("qwe"))
+	router.POST("/tenders/import", svc.ImportTenders(ctx))
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	require.Equal(t, http.StatusOK, w.Code)
+	var response schema.ImportTendersResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.Equal(t, 2, response.Total)
+	require.Equal(t, 1, response.Succeeded)
+	require.Equal(t, 1, response.Failed)
+	require.Equal(t, schema.ImportTenderRow{Line: 2, Status: schema.ImportRowCreated, Tender: &createdTender}, response.Rows[0])
+	require.Equal(t, schema.ImportRowFailed, response.Rows[1].Status)
+	require.Equal(t, "import_row_not_attempted", response.Rows[1].Error.Code)
+	require.Equal(t, "import was canceled, rows with import_row_not_attempted error were not imported", response.Message)
+}
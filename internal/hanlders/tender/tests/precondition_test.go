@@ -0,0 +1,181 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sariya23/tender/internal/domain/models"
+	tenderapi "github.com/sariya23/tender/internal/hanlders/tender"
+	"github.com/sariya23/tender/internal/hanlders/tender/mocks"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEditTender_SuccessIfMatch проверяет, что версия из If-Match
+// передается в сервис, а в ответе есть ETag новой версии.
+func TestEditTender_SuccessIfMatch(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	desc := "new"
+	tenderToUpdate := models.TenderToUpdate{Description: &desc}
+	reqBody := `{"update_tender_data": {"description": "new"}, "username": "qwe"}`
+	svc := tenderapi.New(logger, mockTenderService)
+
+	mockTenderService.On("EditTender", ctx, 2, tenderToUpdate, "qwe", 3).Return(models.Tender{ID: 2, Version: 4, Description: "new"}, nil)
+	router := gin.New()
+	router.Use(authenticatedAs("qwe"))
+	router.PATCH("/api/tenders/:tenderId/edit", svc.EditTender(ctx))
+	req := httptest.NewRequest(http.MethodPatch, "/api/tenders/2/edit", strings.NewReader(reqBody))
+	req.Header.Set("If-Match", `"3"`)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, `"4"`, w.Header().Get("ETag"))
+}
+
+// TestEditTender_SuccessExpectedVersionInBody проверяет, что
+// expected_version из тела передается в сервис.
+func TestEditTender_SuccessExpectedVersionInBody(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	desc := "new"
+	tenderToUpdate := models.TenderToUpdate{Description: &desc}
+	reqBody := `{"update_tender_data": {"description": "new"}, "username": "qwe", "expected_version": 3}`
+	svc := tenderapi.New(logger, mockTenderService)
+
+	mockTenderService.On("EditTender", ctx, 2, tenderToUpdate, "qwe", 3).Return(models.Tender{ID: 2, Version: 4}, nil)
+	router := gin.New()
+	router.Use(authenticatedAs("qwe"))
+	router.PATCH("/api/tenders/:tenderId/edit", svc.EditTender(ctx))
+	req := httptest.NewRequest(http.MethodPatch, "/api/tenders/2/edit", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockTenderService.AssertExpectations(t)
+}
+
+// TestEditTender_FailIfMatchDiffersFromBody проверяет, что если
+// If-Match и expected_version не совпадают, то возвращается код 400.
+func TestEditTender_FailIfMatchDiffersFromBody(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	reqBody := `{"update_tender_data": {"description": "new"}, "username": "qwe", "expected_version": 3}`
+	svc := tenderapi.New(logger, mockTenderService)
+	router := newRouter(authenticatedAs("qwe"))
+	router.PATCH("/api/tenders/:tenderId/edit", svc.EditTender(ctx))
+	req := httptest.NewRequest(http.MethodPatch, "/api/tenders/2/edit", strings.NewReader(reqBody))
+	req.Header.Set("If-Match", `"5"`)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockTenderService.AssertNotCalled(t, "EditTender")
+}
+
+// TestEditTender_FailInvalidIfMatch проверяет, что если в If-Match
+// не номер версии, то возвращается код 400.
+func TestEditTender_FailInvalidIfMatch(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	reqBody := `{"update_tender_data": {"description": "new"}, "username": "qwe"}`
+	svc := tenderapi.New(logger, mockTenderService)
+	router := newRouter(authenticatedAs("qwe"))
+	router.PATCH("/api/tenders/:tenderId/edit", svc.EditTender(ctx))
+	req := httptest.NewRequest(http.MethodPatch, "/api/tenders/2/edit", strings.NewReader(reqBody))
+	req.Header.Set("If-Match", `"abc"`)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockTenderService.AssertNotCalled(t, "EditTender")
+}
+
+// TestEditTender_FailVersionConflict проверяет, что
+// конфликт версий возвращает код 409.
+func TestEditTender_FailVersionConflict(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	desc := "new"
+	tenderToUpdate := models.TenderToUpdate{Description: &desc}
+	reqBody := `{"update_tender_data": {"description": "new"}, "username": "qwe"}`
+	svc := tenderapi.New(logger, mockTenderService)
+
+	mockTenderService.On("EditTender", ctx, 2, tenderToUpdate, "qwe", 3).Return(models.Tender{}, outerror.ErrTenderVersionConflict)
+	router := newRouter(authenticatedAs("qwe"))
+	router.PATCH("/api/tenders/:tenderId/edit", svc.EditTender(ctx))
+	req := httptest.NewRequest(http.MethodPatch, "/api/tenders/2/edit", strings.NewReader(reqBody))
+	req.Header.Set("If-Match", `W/"3"`)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusConflict, w.Code)
+	require.Contains(t, w.Body.String(), "was changed by someone else")
+}
+
+// TestRollbackTender_FailVersionConflict проверяет, что
+// конфликт версий при откате возвращает код 409.
+func TestRollbackTender_FailVersionConflict(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	reqBody := `{"username": "qwe", "expected_version": 4}`
+	svc := tenderapi.New(logger, mockTenderService)
+
+	mockTenderService.On("RollbackTender", ctx, 2, 1, "qwe", 4).Return(models.Tender{}, outerror.ErrTenderVersionConflict)
+	router := newRouter(authenticatedAs("qwe"))
+	router.PUT("/api/tenders/:tenderId/rollback/:version", svc.RollbackTender(ctx))
+	req := httptest.NewRequest(http.MethodPut, "/api/tenders/2/rollback/1", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
@@ -0,0 +1,37 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	"github.com/sariya23/tender/internal/lib/problem"
+	"github.com/sariya23/tender/internal/middleware"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/stretchr/testify/require"
+)
+
+// newRouter возвращает роутер, который, как и приложение, отдает
+// ошибки хендлеров в формате application/problem+json.
+func newRouter(middlewares ...gin.HandlerFunc) *gin.Engine {
+	router := gin.New()
+	router.Use(middleware.Problems(slogdiscard.NewDiscardLogger(), outerror.Catalog))
+	router.Use(middlewares...)
+	return router
+}
+
+// requireProblem проверяет, что в ответе ошибка с кодом code
+// и статусом status, и возвращает тело ответа.
+func requireProblem(t *testing.T, w *httptest.ResponseRecorder, status int, code string) problem.Details {
+	t.Helper()
+	require.Equal(t, status, w.Code)
+	require.Equal(t, problem.ContentType, w.Header().Get("Content-Type"))
+	var details problem.Details
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &details))
+	require.Equal(t, code, details.Code)
+	require.Equal(t, status, details.Status)
+	require.Equal(t, problem.TypePrefix+code, details.Type)
+	return details
+}
@@ -42,6 +42,10 @@ func TestRollbackTender_Success(t *testing.T) {
 	expectedBody := `
 		{
 			"rollback_tender": {
+				"id": 0,
+				"version": 0,
+				"created_at": "0001-01-01T00:00:00Z",
+				"updated_at": "0001-01-01T00:00:00Z",
 				"name": "qwe",
 				"description": "qwe",
 				"service_type": "qwe",
@@ -53,8 +57,9 @@ func TestRollbackTender_Success(t *testing.T) {
 		}`
 	svc := tenderapi.New(logger, mockTenderService)
 
-	mockTenderService.On("RollbackTender", ctx, 2, 3, "qwe").Return(mockTender, nil)
+	mockTenderService.On("RollbackTender", ctx, 2, 3, "qwe", 0).Return(mockTender, nil)
 	router := gin.New()
+	router.Use(authenticatedAs("qwe"))
 	router.PUT("/api/tenders/:tenderId/rollback/:version", svc.RollbackTender(ctx))
 	req := httptest.NewRequest(http.MethodPut, "/api/tenders/2/rollback/3", strings.NewReader(reqBody))
 	w := httptest.NewRecorder()
@@ -70,6 +75,33 @@ func TestRollbackTender_Success(t *testing.T) {
 	require.JSONEq(t, expectedBody, w.Body.String())
 }
 
+// TestRollbackTender_SuccessWithoutUsername проверяет, что без username
+// в теле тендер откатывается от имени владельца токена.
+func TestRollbackTender_SuccessWithoutUsername(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	mockTender := models.Tender{ID: 2, Version: 4, TenderName: "qwe", CreatorUsername: "qwe"}
+	svc := tenderapi.New(logger, mockTenderService)
+
+	mockTenderService.On("RollbackTender", ctx, 2, 3, "qwe", 0).Return(mockTender, nil)
+	router := gin.New()
+	router.Use(authenticatedAs("qwe"))
+	router.PUT("/api/tenders/:tenderId/rollback/:version", svc.RollbackTender(ctx))
+	req := httptest.NewRequest(http.MethodPut, "/api/tenders/2/rollback/3", strings.NewReader(`{}`))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockTenderService.AssertCalled(t, "RollbackTender", ctx, 2, 3, "qwe", 0)
+}
+
 // TestRollbackTender_FailTenderIdIsNotInt проверяет, что
 // если параметр tenderId в пути не получается конвертировать в int, то
 // возвращается ошибка и код 404.
@@ -85,20 +117,20 @@ func TestRollbackTender_FailTenderIdIsNotInt(t *testing.T) {
 		"username": "qwe"
 	}`
 	expectedBody := `
-		{
-			"rollback_tender": {
-				"name": "",
-				"description": "",
-				"service_type": "",
-				"status": "",
-				"organization_id": 0,
-				"creator_username": ""
-			},
-			"message": "cannot convert tenderId to integer"
-		}`
+	{
+		"type": "urn:tender:problem:tender_not_found",
+		"title": "Tender not found",
+		"status": 404,
+		"detail": "cannot convert tenderId to integer",
+		"instance": "/api/tenders/2.34/rollback/3",
+		"code": "tender_not_found"
+	}
+	`
 	svc := tenderapi.New(logger, mockTenderService)
 
-	router := gin.New()
+	router := newRouter()
+
+	router.Use(authenticatedAs("qwe"))
 	router.PUT("/api/tenders/:tenderId/rollback/:version", svc.RollbackTender(ctx))
 	req := httptest.NewRequest(http.MethodPut, "/api/tenders/2.34/rollback/3", strings.NewReader(reqBody))
 	w := httptest.NewRecorder()
@@ -128,20 +160,20 @@ func TestRollbackTender_FailTenderIdIsNegative(t *testing.T) {
 		"username": "qwe"
 	}`
 	expectedBody := `
-		{
-			"rollback_tender": {
-				"name": "",
-				"description": "",
-				"service_type": "",
-				"status": "",
-				"organization_id": 0,
-				"creator_username": ""
-			},
-			"message": "version must be positive integer"
-		}`
+	{
+		"type": "urn:tender:problem:tender_version_not_found",
+		"title": "Tender version not found",
+		"status": 404,
+		"detail": "version must be positive integer",
+		"instance": "/api/tenders/2/rollback/-3",
+		"code": "tender_version_not_found"
+	}
+	`
 	svc := tenderapi.New(logger, mockTenderService)
 
-	router := gin.New()
+	router := newRouter()
+
+	router.Use(authenticatedAs("qwe"))
 	router.PUT("/api/tenders/:tenderId/rollback/:version", svc.RollbackTender(ctx))
 	req := httptest.NewRequest(http.MethodPut, "/api/tenders/2/rollback/-3", strings.NewReader(reqBody))
 	w := httptest.NewRecorder()
@@ -172,20 +204,20 @@ func TestRollbackTender_FailVersionIsNotInt(t *testing.T) {
 		"username": "qwe"
 	}`
 	expectedBody := `
-		{
-			"rollback_tender": {
-				"name": "",
-				"description": "",
-				"service_type": "",
-				"status": "",
-				"organization_id": 0,
-				"creator_username": ""
-			},
-			"message": "cannot convert version to integer"
-		}`
+	{
+		"type": "urn:tender:problem:tender_version_not_found",
+		"title": "Tender version not found",
+		"status": 404,
+		"detail": "cannot convert version to integer",
+		"instance": "/api/tenders/2/rollback/qwe",
+		"code": "tender_version_not_found"
+	}
+	`
 	svc := tenderapi.New(logger, mockTenderService)
 
-	router := gin.New()
+	router := newRouter()
+
+	router.Use(authenticatedAs("qwe"))
 	router.PUT("/api/tenders/:tenderId/rollback/:version", svc.RollbackTender(ctx))
 	req := httptest.NewRequest(http.MethodPut, "/api/tenders/2/rollback/qwe", strings.NewReader(reqBody))
 	w := httptest.NewRecorder()
@@ -215,20 +247,20 @@ func TestRollbackTender_FailVersionIsNegativeInt(t *testing.T) {
 		"username": "qwe"
 	}`
 	expectedBody := `
-		{
-			"rollback_tender": {
-				"name": "",
-				"description": "",
-				"service_type": "",
-				"status": "",
-				"organization_id": 0,
-				"creator_username": ""
-			},
-			"message": "version must be positive integer"
-		}`
+	{
+		"type": "urn:tender:problem:tender_version_not_found",
+		"title": "Tender version not found",
+		"status": 404,
+		"detail": "version must be positive integer",
+		"instance": "/api/tenders/2/rollback/-2",
+		"code": "tender_version_not_found"
+	}
+	`
 	svc := tenderapi.New(logger, mockTenderService)
 
-	router := gin.New()
+	router := newRouter()
+
+	router.Use(authenticatedAs("qwe"))
 	router.PUT("/api/tenders/:tenderId/rollback/:version", svc.RollbackTender(ctx))
 	req := httptest.NewRequest(http.MethodPut, "/api/tenders/2/rollback/-2", strings.NewReader(reqBody))
 	w := httptest.NewRecorder()
@@ -246,7 +278,7 @@ func TestRollbackTender_FailVersionIsNegativeInt(t *testing.T) {
 
 // TestRollbackTender_FailTenderNotFound проверяет, что
 // если нет тендера с id, который указан в пути, то возвращается
-// ошибка и код 422.
+// ошибка и код 404.
 func TestRollbackTender_FailTenderNotFound(t *testing.T) {
 	// Arrange
 	gin.SetMode(gin.TestMode)
@@ -259,21 +291,19 @@ func TestRollbackTender_FailTenderNotFound(t *testing.T) {
 		"username": "qwe"
 	}`
 	expectedBody := `
-		{
-			"rollback_tender": {
-				"name": "",
-				"description": "",
-				"service_type": "",
-				"status": "",
-				"organization_id": 0,
-				"creator_username": ""
-			},
-			"message": "tender with id=<2> not found"
-		}`
+	{
+		"type": "urn:tender:problem:tender_not_found",
+		"title": "Tender not found",
+		"status": 404,
+		"detail": "tender not found",
+		"instance": "/api/tenders/2/rollback/3",
+		"code": "tender_not_found"
+	}
+	`
 	svc := tenderapi.New(logger, mockTenderService)
 
-	mockTenderService.On("RollbackTender", ctx, 2, 3, "qwe").Return(models.Tender{}, outerror.ErrTenderNotFound)
-	router := gin.New()
+	mockTenderService.On("RollbackTender", ctx, 2, 3, "qwe", 0).Return(models.Tender{}, outerror.ErrTenderNotFound)
+	router := newRouter(authenticatedAs("qwe"))
 	router.PUT("/api/tenders/:tenderId/rollback/:version", svc.RollbackTender(ctx))
 	req := httptest.NewRequest(http.MethodPut, "/api/tenders/2/rollback/3", strings.NewReader(reqBody))
 	w := httptest.NewRecorder()
@@ -285,13 +315,13 @@ func TestRollbackTender_FailTenderNotFound(t *testing.T) {
 	router.ServeHTTP(w, req)
 
 	// Assert
-	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Equal(t, http.StatusNotFound, w.Code)
 	require.JSONEq(t, expectedBody, w.Body.String())
 }
 
 // TestRollbackTender_FailVersionNotFound проверяет, что
 // если у тендера нет версии, которая указана в пути, то
-// возвращается ошибка и код 422.
+// возвращается ошибка и код 404.
 func TestRollbackTender_FailVersionNotFound(t *testing.T) {
 	// Arrange
 	gin.SetMode(gin.TestMode)
@@ -304,21 +334,19 @@ func TestRollbackTender_FailVersionNotFound(t *testing.T) {
 		"username": "qwe"
 	}`
 	expectedBody := `
-		{
-			"rollback_tender": {
-				"name": "",
-				"description": "",
-				"service_type": "",
-				"status": "",
-				"organization_id": 0,
-				"creator_username": ""
-			},
-			"message": "tender with id=<2> doesnt have version=<3>"
-		}`
+	{
+		"type": "urn:tender:problem:tender_version_not_found",
+		"title": "Tender version not found",
+		"status": 404,
+		"detail": "tender version not found",
+		"instance": "/api/tenders/2/rollback/3",
+		"code": "tender_version_not_found"
+	}
+	`
 	svc := tenderapi.New(logger, mockTenderService)
 
-	mockTenderService.On("RollbackTender", ctx, 2, 3, "qwe").Return(models.Tender{}, outerror.ErrTenderVersionNotFound)
-	router := gin.New()
+	mockTenderService.On("RollbackTender", ctx, 2, 3, "qwe", 0).Return(models.Tender{}, outerror.ErrTenderVersionNotFound)
+	router := newRouter(authenticatedAs("qwe"))
 	router.PUT("/api/tenders/:tenderId/rollback/:version", svc.RollbackTender(ctx))
 	req := httptest.NewRequest(http.MethodPut, "/api/tenders/2/rollback/3", strings.NewReader(reqBody))
 	w := httptest.NewRecorder()
@@ -330,7 +358,7 @@ func TestRollbackTender_FailVersionNotFound(t *testing.T) {
 	router.ServeHTTP(w, req)
 
 	// Assert
-	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Equal(t, http.StatusNotFound, w.Code)
 	require.JSONEq(t, expectedBody, w.Body.String())
 }
 
@@ -349,21 +377,18 @@ func TestRollbackTender_FailInternalError(t *testing.T) {
 		"username": "qwe"
 	}`
 	expectedBody := `
-		{
-			"rollback_tender": {
-				"name": "",
-				"description": "",
-				"service_type": "",
-				"status": "",
-				"organization_id": 0,
-				"creator_username": ""
-			},
-			"message": "internal error"
-		}`
+	{
+		"type": "urn:tender:problem:internal_error",
+		"title": "Internal error",
+		"status": 500,
+		"instance": "/api/tenders/2/rollback/3",
+		"code": "internal_error"
+	}
+	`
 	svc := tenderapi.New(logger, mockTenderService)
 	someErr := errors.New("some err")
-	mockTenderService.On("RollbackTender", ctx, 2, 3, "qwe").Return(models.Tender{}, someErr)
-	router := gin.New()
+	mockTenderService.On("RollbackTender", ctx, 2, 3, "qwe", 0).Return(models.Tender{}, someErr)
+	router := newRouter(authenticatedAs("qwe"))
 	router.PUT("/api/tenders/:tenderId/rollback/:version", svc.RollbackTender(ctx))
 	req := httptest.NewRequest(http.MethodPut, "/api/tenders/2/rollback/3", strings.NewReader(reqBody))
 	w := httptest.NewRecorder()
@@ -391,20 +416,18 @@ func TestRollbackTender_FailEmployeeNotCreatorOfTender(t *testing.T) {
 		"username": "qwe"
 	}`
 	expectedBody := `
-		{
-			"rollback_tender": {
-				"name": "",
-				"description": "",
-				"service_type": "",
-				"status": "",
-				"organization_id": 0,
-				"creator_username": ""
-			},
-			"message": "employee with username=<qwe> not creator of tender with id=<2>"
-		}`
+	{
+		"type": "urn:tender:problem:employee_not_responsible_for_tender",
+		"title": "Employee not responsible for tender",
+		"status": 403,
+		"detail": "employee not respobsible for this tender",
+		"instance": "/api/tenders/2/rollback/3",
+		"code": "employee_not_responsible_for_tender"
+	}
+	`
 	svc := tenderapi.New(logger, mockTenderService)
-	mockTenderService.On("RollbackTender", ctx, 2, 3, "qwe").Return(models.Tender{}, outerror.ErrEmployeeNotResponsibleForTender)
-	router := gin.New()
+	mockTenderService.On("RollbackTender", ctx, 2, 3, "qwe", 0).Return(models.Tender{}, outerror.ErrEmployeeNotResponsibleForTender)
+	router := newRouter(authenticatedAs("qwe"))
 	router.PUT("/api/tenders/:tenderId/rollback/:version", svc.RollbackTender(ctx))
 	req := httptest.NewRequest(http.MethodPut, "/api/tenders/2/rollback/3", strings.NewReader(reqBody))
 	w := httptest.NewRecorder()
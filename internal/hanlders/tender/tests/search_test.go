@@ -0,0 +1,187 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	tenderapi "github.com/sariya23/tender/internal/hanlders/tender"
+	"github.com/sariya23/tender/internal/hanlders/tender/mocks"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSearchTenders_Success проверяет, что запрос и страница
+// передаются в сервис, а в ответе возвращаются найденные
+// тендеры с релевантностью и фрагментом текста.
+//
+// Возвращается код 200.
+func TestSearchTenders_Success(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	createdAt := time.Date(2024, 12, 18, 10, 0, 0, 0, time.UTC)
+	expectedPage := models.TenderSearchPage{
+		Results: []models.TenderSearchResult{
+			{
+				Tender:  models.Tender{ID: 1, Version: 1, CreatedAt: createdAt, UpdatedAt: createdAt, TenderName: "Ремонт дороги", Description: "qwe", ServiceType: "op", Status: "PUBLISHED", OrganizationId: 1, CreatorUsername: "qwe"},
+				Rank:    0.5,
+				Snippet: "<mark>Ремонт</mark> дороги qwe",
+			},
+		},
+		Total: 3,
+	}
+	expectedBody := `
+	{
+		"results": [
+			{
+				"tender": {"id": 1, "version": 1, "created_at": "2024-12-18T10:00:00Z", "updated_at": "2024-12-18T10:00:00Z", "name": "Ремонт дороги", "description": "qwe", "service_type": "op", "status": "PUBLISHED", "organization_id": 1, "creator_username": "qwe"},
+				"rank": 0.5,
+				"snippet": "<mark>Ремонт</mark> дороги qwe"
+			}
+		],
+		"total": 3,
+		"message": "ok"
+	}
+	`
+	svc := tenderapi.New(logger, mockTenderService)
+
+	mockTenderService.On("SearchTenders", ctx, "ремонт дороги", models.Page{Limit: 1, Offset: 2}).Return(expectedPage, nil)
+	req := httptest.NewRequest(http.MethodGet, "/tenders/search?q="+url.QueryEscape("ремонт дороги")+"&limit=1&offset=2", nil)
+	w := httptest.NewRecorder()
+
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	// Act
+	handler := svc.SearchTenders(ctx)
+	handler(c)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestSearchTenders_FailBadRequest проверяет, что
+// без запроса и с некорректной страницей возвращается код 400
+// и сервис не вызывается.
+func TestSearchTenders_FailBadRequest(t *testing.T) {
+	cases := []struct {
+		name    string
+		query   string
+		code    string
+		title   string
+		message string
+	}{
+		{name: "no q", query: "", code: "empty_search_query", title: "Search query is empty", message: "q query parameter not specified"},
+		{name: "blank q", query: "q=%20%20", code: "empty_search_query", title: "Search query is empty", message: "q query parameter not specified"},
+		{name: "invalid limit", query: "q=qwe&limit=0", code: "invalid_request", title: "Invalid request", message: "limit must be integer from 1 to 100"},
+		{name: "after_id", query: "q=qwe&after_id=3", code: "invalid_request", title: "Invalid request", message: "after_id cannot be used for search, use offset"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			gin.SetMode(gin.TestMode)
+			ctx := context.Background()
+
+			logger := slogdiscard.NewDiscardLogger()
+			mockTenderService := new(mocks.MockTenderServiceProvider)
+			expectedBody := fmt.Sprintf(`{
+				"type": "urn:tender:problem:%s",
+				"title": "%s",
+				"status": 400,
+				"detail": %q,
+				"instance": "/tenders/search",
+				"code": "%s"
+			}`, tc.code, tc.title, tc.message, tc.code)
+			svc := tenderapi.New(logger, mockTenderService)
+
+			req := httptest.NewRequest(http.MethodGet, "/tenders/search?"+tc.query, nil)
+			w := httptest.NewRecorder()
+
+			router := newRouter()
+			router.GET("/tenders/search", svc.SearchTenders(ctx))
+
+			// Act
+			router.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			require.JSONEq(t, expectedBody, w.Body.String())
+			mockTenderService.AssertNotCalled(t, "SearchTenders")
+		})
+	}
+}
+
+// TestSearchTenders_FailBadRequestRussian проверяет, что пояснение
+// ошибки возвращается на языке из заголовка Accept-Language.
+func TestSearchTenders_FailBadRequestRussian(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	svc := tenderapi.New(logger, mockTenderService)
+
+	req := httptest.NewRequest(http.MethodGet, "/tenders/search?q=qwe&limit=0", nil)
+	req.Header.Set("Accept-Language", "ru")
+	w := httptest.NewRecorder()
+	router := newRouter()
+	router.GET("/tenders/search", svc.SearchTenders(ctx))
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	details := requireProblem(t, w, http.StatusBadRequest, "invalid_request")
+	require.Equal(t, "limit должен быть целым числом от 1 до 100", details.Detail)
+	mockTenderService.AssertNotCalled(t, "SearchTenders")
+}
+
+// TestSearchTenders_FailInternalError проверяет, что
+// при неожиданной ошибке возвращается код 500.
+func TestSearchTenders_FailInternalError(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	expectedBody := `
+	{
+		"type": "urn:tender:problem:internal_error",
+		"title": "Internal error",
+		"status": 500,
+		"instance": "/tenders/search",
+		"code": "internal_error"
+	}
+	`
+	svc := tenderapi.New(logger, mockTenderService)
+
+	mockTenderService.On("SearchTenders", ctx, "qwe", models.Page{Limit: 20}).Return(models.TenderSearchPage{}, errors.New("some err"))
+	req := httptest.NewRequest(http.MethodGet, "/tenders/search?q=qwe", nil)
+	w := httptest.NewRecorder()
+
+	router := newRouter()
+	router.GET("/tenders/search", svc.SearchTenders(ctx))
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
@@ -0,0 +1,300 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/domain/tenderstatus"
+	tenderapi "github.com/sariya23/tender/internal/hanlders/tender"
+	"github.com/sariya23/tender/internal/hanlders/tender/mocks"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetTenderStatus_Success проверяет, что возвращается
+// статус тендера с доступными переходами и код 200.
+func TestGetTenderStatus_Success(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	mockState := tenderstatus.State{
+		TenderId: 2,
+		Version:  3,
+		Status:   "CREATED",
+		Allowed: []tenderstatus.Transition{
+			{From: "CREATED", To: "PUBLISHED"},
+			{From: "CREATED", To: "CLOSED"},
+		},
+	}
+	expectedBody := `
+	{
+		"tender_status": {
+			"tender_id": 2,
+			"version": 3,
+			"status": "CREATED",
+			"allowed_transitions": [
+				{"from": "CREATED", "to": "PUBLISHED", "requires_reason": false},
+				{"from": "CREATED", "to": "CLOSED", "requires_reason": false}
+			]
+		},
+		"message": "ok"
+	}`
+	svc := tenderapi.New(logger, mockTenderService)
+
+	mockTenderService.On("GetTenderStatus", ctx, 2, "qwe").Return(mockState, nil)
+	router := gin.New()
+	router.Use(authenticatedAs("qwe"))
+	router.GET("/api/tenders/:tenderId/status", svc.GetTenderStatus(ctx))
+	req := httptest.NewRequest(http.MethodGet, "/api/tenders/2/status?username=qwe", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestGetTenderStatus_SuccessQuorumTransitions проверяет, что переходы,
+// доступные только голосованием, возвращаются в quorum_transitions.
+func TestGetTenderStatus_SuccessQuorumTransitions(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	mockState := tenderstatus.State{
+		TenderId: 2,
+		Version:  3,
+		Status:   "PUBLISHED",
+		Allowed:  []tenderstatus.Transition{},
+		QuorumOnly: []tenderstatus.Transition{
+			{From: "PUBLISHED", To: "CLOSED"},
+		},
+	}
+	expectedBody := `
+	{
+		"tender_status": {
+			"tender_id": 2,
+			"version": 3,
+			"status": "PUBLISHED",
+			"allowed_transitions": [],
+			"quorum_transitions": [
+				{"from": "PUBLISHED", "to": "CLOSED", "requires_reason": false}
+			]
+		},
+		"message": "ok"
+	}`
+	svc := tenderapi.New(logger, mockTenderService)
+
+	mockTenderService.On("GetTenderStatus", ctx, 2, "qwe").Return(mockState, nil)
+	router := gin.New()
+	router.Use(authenticatedAs("qwe"))
+	router.GET("/api/tenders/:tenderId/status", svc.GetTenderStatus(ctx))
+	req := httptest.NewRequest(http.MethodGet, "/api/tenders/2/status", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestGetTenderStatus_FailTenderNotFound проверяет, что
+// для несуществующего тендера возвращается код 404.
+func TestGetTenderStatus_FailTenderNotFound(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	svc := tenderapi.New(logger, mockTenderService)
+
+	mockTenderService.On("GetTenderStatus", ctx, 2, "qwe").Return(tenderstatus.State{}, outerror.ErrTenderNotFound)
+	router := newRouter(authenticatedAs("qwe"))
+	router.GET("/api/tenders/:tenderId/status", svc.GetTenderStatus(ctx))
+	req := httptest.NewRequest(http.MethodGet, "/api/tenders/2/status?username=qwe", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	details := requireProblem(t, w, http.StatusNotFound, "tender_not_found")
+	require.Equal(t, "/api/tenders/2/status", details.Instance)
+}
+
+// TestSetTenderStatus_Success проверяет, что статус тендера
+// меняется, возвращается код 200 и ETag новой версии.
+func TestSetTenderStatus_Success(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	createdAt := time.Date(2024, 12, 18, 10, 0, 0, 0, time.UTC)
+	mockTender := models.Tender{ID: 2, Version: 4, CreatedAt: createdAt, UpdatedAt: createdAt, TenderName: "qwe", Description: "qwe", ServiceType: "qwe", Status: "PUBLISHED", OrganizationId: 1, CreatorUsername: "qwe"}
+	reqBody := `{"status": "PUBLISHED", "reason": "deadline extended", "username": "qwe"}`
+	expectedBody := `
+	{
+		"tender": {"id": 2, "version": 4, "created_at": "2024-12-18T10:00:00Z", "updated_at": "2024-12-18T10:00:00Z", "name": "qwe", "description": "qwe", "service_type": "qwe", "status": "PUBLISHED", "organization_id": 1, "creator_username": "qwe"},
+		"message": "ok"
+	}`
+	svc := tenderapi.New(logger, mockTenderService)
+
+	mockTenderService.On("SetTenderStatus", ctx, 2, "PUBLISHED", "deadline extended", "qwe", 3).Return(mockTender, nil)
+	router := gin.New()
+	router.Use(authenticatedAs("qwe"))
+	router.PUT("/api/tenders/:tenderId/status", svc.SetTenderStatus(ctx))
+	req := httptest.NewRequest(http.MethodPut, "/api/tenders/2/status", strings.NewReader(reqBody))
+	req.Header.Set("If-Match", `"3"`)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+	require.Equal(t, `"4"`, w.Header().Get("ETag"))
+}
+
+// TestSetTenderStatus_SuccessWithoutUsername проверяет, что без username
+// в теле статус меняется от имени владельца токена.
+func TestSetTenderStatus_SuccessWithoutUsername(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	mockTender := models.Tender{ID: 2, Version: 4, Status: "PUBLISHED", CreatorUsername: "qwe"}
+	svc := tenderapi.New(logger, mockTenderService)
+
+	mockTenderService.On("SetTenderStatus", ctx, 2, "PUBLISHED", "", "qwe", 0).Return(mockTender, nil)
+	router := gin.New()
+	router.Use(authenticatedAs("qwe"))
+	router.PUT("/api/tenders/:tenderId/status", svc.SetTenderStatus(ctx))
+	req := httptest.NewRequest(http.MethodPut, "/api/tenders/2/status", strings.NewReader(`{"status": "PUBLISHED"}`))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockTenderService.AssertCalled(t, "SetTenderStatus", ctx, 2, "PUBLISHED", "", "qwe", 0)
+}
+
+// TestSetTenderStatus_FailReasonRequired проверяет, что если
+// для перехода нужна причина, а ее нет, то возвращается код 400.
+func TestSetTenderStatus_FailReasonRequired(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	reqBody := `{"status": "PUBLISHED", "username": "qwe"}`
+	expectedBody := `
+	{
+		"type": "urn:tender:problem:tender_status_reason_required",
+		"title": "Reason required for tender status transition",
+		"status": 400,
+		"detail": "reason is required for this tender status transition",
+		"instance": "/api/tenders/2/status",
+		"code": "tender_status_reason_required"
+	}
+	`
+	svc := tenderapi.New(logger, mockTenderService)
+
+	mockTenderService.On("SetTenderStatus", ctx, 2, "PUBLISHED", "", "qwe", 0).Return(models.Tender{}, outerror.ErrTenderStatusReasonRequired)
+	router := newRouter(authenticatedAs("qwe"))
+	router.PUT("/api/tenders/:tenderId/status", svc.SetTenderStatus(ctx))
+	req := httptest.NewRequest(http.MethodPut, "/api/tenders/2/status", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestSetTenderStatus_FailTransitionNotAllowed проверяет, что
+// на запрещенный переход статуса возвращается код 400.
+func TestSetTenderStatus_FailTransitionNotAllowed(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	reqBody := `{"status": "CREATED", "username": "qwe"}`
+	expectedBody := `
+	{
+		"type": "urn:tender:problem:tender_status_transition_not_allowed",
+		"title": "Tender status transition not allowed",
+		"status": 400,
+		"detail": "tender status transition is not allowed",
+		"instance": "/api/tenders/2/status",
+		"code": "tender_status_transition_not_allowed"
+	}
+	`
+	svc := tenderapi.New(logger, mockTenderService)
+
+	mockTenderService.On("SetTenderStatus", ctx, 2, "CREATED", "", "qwe", 0).Return(models.Tender{}, outerror.ErrCannotSetThisTenderStatus)
+	router := newRouter(authenticatedAs("qwe"))
+	router.PUT("/api/tenders/:tenderId/status", svc.SetTenderStatus(ctx))
+	req := httptest.NewRequest(http.MethodPut, "/api/tenders/2/status", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestSetTenderStatus_FailValidation проверяет, что
+// без статуса возвращается код 400 и сервис не вызывается.
+func TestSetTenderStatus_FailValidation(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	reqBody := `{"username": "qwe"}`
+	svc := tenderapi.New(logger, mockTenderService)
+	router := newRouter(authenticatedAs("qwe"))
+	router.PUT("/api/tenders/:tenderId/status", svc.SetTenderStatus(ctx))
+	req := httptest.NewRequest(http.MethodPut, "/api/tenders/2/status", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockTenderService.AssertNotCalled(t, "SetTenderStatus")
+}
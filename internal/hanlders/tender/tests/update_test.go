@@ -28,7 +28,7 @@ func TestEditTender_SuccessAllFiedsUpdate(t *testing.T) {
 
 	tenderName := "update Tender 1"
 	description := "update qwe"
-	serviceType := "update op"
+	serviceType := "Delivery"
 	status := "update open"
 	organizationId := 2
 	creatorUsername := "update qwe"
@@ -36,7 +36,7 @@ func TestEditTender_SuccessAllFiedsUpdate(t *testing.T) {
 	mockTender := models.Tender{
 		TenderName:      "update Tender 1",
 		Description:     "update qwe",
-		ServiceType:     "update op",
+		ServiceType:     "Delivery",
 		Status:          "update open",
 		OrganizationId:  2,
 		CreatorUsername: "update qwe",
@@ -54,7 +54,7 @@ func TestEditTender_SuccessAllFiedsUpdate(t *testing.T) {
 			"update_tender_data": {
 				"name": "update Tender 1",
 				"description": "update qwe",
-				"service_type": "update op",
+				"service_type": "Delivery",
 				"status": "update open",
 				"organization_id": 2,
 				"creator_username": "update qwe"
@@ -65,9 +65,13 @@ func TestEditTender_SuccessAllFiedsUpdate(t *testing.T) {
 	expectedBody := `
 		{
 			"updated_tender": {
+				"id": 0,
+				"version": 0,
+				"created_at": "0001-01-01T00:00:00Z",
+				"updated_at": "0001-01-01T00:00:00Z",
 				"name": "update Tender 1",
 				"description": "update qwe",
-				"service_type": "update op",
+				"service_type": "Delivery",
 				"status": "update open",
 				"organization_id": 2,
 				"creator_username": "update qwe"
@@ -76,8 +80,9 @@ func TestEditTender_SuccessAllFiedsUpdate(t *testing.T) {
 		}`
 	svc := tenderapi.New(logger, mockTenderService)
 
-	mockTenderService.On("EditTender", ctx, 2, tenderToUpdate, "qwe").Return(mockTender, nil)
+	mockTenderService.On("EditTender", ctx, 2, tenderToUpdate, "qwe", 0).Return(mockTender, nil)
 	router := gin.New()
+	router.Use(authenticatedAs("qwe"))
 	router.PATCH("/api/tenders/:tenderId/edit", svc.EditTender(ctx))
 	req := httptest.NewRequest(http.MethodPatch, "/api/tenders/2/edit", strings.NewReader(reqBody))
 	w := httptest.NewRecorder()
@@ -110,7 +115,7 @@ func TestEditTender_SuccessPartFiedsUpdate(t *testing.T) {
 	mockTender := models.Tender{
 		TenderName:      "Tender 1",
 		Description:     "update qwe",
-		ServiceType:     "op",
+		ServiceType:     "Construction",
 		Status:          "open",
 		OrganizationId:  1,
 		CreatorUsername: "update qwe",
@@ -132,9 +137,13 @@ func TestEditTender_SuccessPartFiedsUpdate(t *testing.T) {
 	expectedBody := `
 		{
 			"updated_tender": {
+				"id": 0,
+				"version": 0,
+				"created_at": "0001-01-01T00:00:00Z",
+				"updated_at": "0001-01-01T00:00:00Z",
 				"name": "Tender 1",
 				"description": "update qwe",
-				"service_type": "op",
+				"service_type": "Construction",
 				"status": "open",
 				"organization_id": 1,
 				"creator_username": "update qwe"
@@ -142,8 +151,9 @@ func TestEditTender_SuccessPartFiedsUpdate(t *testing.T) {
 			"message": "ok"
 		}`
 	svc := tenderapi.New(logger, mockTenderService)
-	mockTenderService.On("EditTender", ctx, 2, tenderToUpdate, "qwe").Return(mockTender, nil)
+	mockTenderService.On("EditTender", ctx, 2, tenderToUpdate, "qwe", 0).Return(mockTender, nil)
 	router := gin.New()
+	router.Use(authenticatedAs("qwe"))
 	router.PATCH("/api/tenders/:tenderId/edit", svc.EditTender(ctx))
 	req := httptest.NewRequest(http.MethodPatch, "/api/tenders/2/edit", strings.NewReader(reqBody))
 	w := httptest.NewRecorder()
@@ -159,6 +169,35 @@ func TestEditTender_SuccessPartFiedsUpdate(t *testing.T) {
 	require.JSONEq(t, expectedBody, w.Body.String())
 }
 
+// TestEditTender_SuccessWithoutUsername проверяет, что без username
+// в теле тендер обновляется от имени владельца токена.
+func TestEditTender_SuccessWithoutUsername(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	description := "update qwe"
+	mockTender := models.Tender{ID: 2, Version: 2, Description: "update qwe", CreatorUsername: "qwe"}
+	tenderToUpdate := models.TenderToUpdate{Description: &description}
+	reqBody := `{"update_tender_data": {"description": "update qwe"}}`
+	svc := tenderapi.New(logger, mockTenderService)
+	mockTenderService.On("EditTender", ctx, 2, tenderToUpdate, "qwe", 0).Return(mockTender, nil)
+	router := gin.New()
+	router.Use(authenticatedAs("qwe"))
+	router.PATCH("/api/tenders/:tenderId/edit", svc.EditTender(ctx))
+	req := httptest.NewRequest(http.MethodPatch, "/api/tenders/2/edit", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockTenderService.AssertCalled(t, "EditTender", ctx, 2, tenderToUpdate, "qwe", 0)
+}
+
 // TestEditTender_SuccessUndefinedFields проверяет, что
 // если в json положить какие-то другие поля, то ошибки при валидации
 // не будет.
@@ -176,7 +215,7 @@ func TestEditTender_SuccessUndefinedFields(t *testing.T) {
 	mockTender := models.Tender{
 		TenderName:      "Tender 1",
 		Description:     "update qwe",
-		ServiceType:     "op",
+		ServiceType:     "Construction",
 		Status:          "open",
 		OrganizationId:  1,
 		CreatorUsername: "update qwe",
@@ -199,9 +238,13 @@ func TestEditTender_SuccessUndefinedFields(t *testing.T) {
 	expectedBody := `
 		{
 			"updated_tender": {
+				"id": 0,
+				"version": 0,
+				"created_at": "0001-01-01T00:00:00Z",
+				"updated_at": "0001-01-01T00:00:00Z",
 				"name": "Tender 1",
 				"description": "update qwe",
-				"service_type": "op",
+				"service_type": "Construction",
 				"status": "open",
 				"organization_id": 1,
 				"creator_username": "update qwe"
@@ -209,8 +252,9 @@ func TestEditTender_SuccessUndefinedFields(t *testing.T) {
 			"message": "ok"
 		}`
 	svc := tenderapi.New(logger, mockTenderService)
-	mockTenderService.On("EditTender", ctx, 2, tenderToUpdate, "qwe").Return(mockTender, nil)
+	mockTenderService.On("EditTender", ctx, 2, tenderToUpdate, "qwe", 0).Return(mockTender, nil)
 	router := gin.New()
+	router.Use(authenticatedAs("qwe"))
 	router.PATCH("/api/tenders/:tenderId/edit", svc.EditTender(ctx))
 	req := httptest.NewRequest(http.MethodPatch, "/api/tenders/2/edit", strings.NewReader(reqBody))
 	w := httptest.NewRecorder()
@@ -242,7 +286,7 @@ func TestEditTender_FailInvalidTenderId(t *testing.T) {
 			"update_tender_data": {
 				"name": "update Tender 1",
 				"description": "update qwe",
-				"service_type": "update op",
+				"service_type": "Delivery",
 				"status": "update open",
 				"organization_id": 2,
 				"creator_username": "update qwe"
@@ -251,20 +295,20 @@ func TestEditTender_FailInvalidTenderId(t *testing.T) {
 		}`
 
 	expectedBody := `
-		{
-			"updated_tender": {
-				"name": "",
-				"description": "",
-				"service_type": "",
-				"status": "",
-				"organization_id": 0,
-				"creator_username": ""
-			},
-			"message": "cannot convert tender id to integer"
-		}`
+	{
+		"type": "urn:tender:problem:tender_not_found",
+		"title": "Tender not found",
+		"status": 404,
+		"detail": "cannot convert tenderId to integer",
+		"instance": "/api/tenders/qwe/edit",
+		"code": "tender_not_found"
+	}
+	`
 	svc := tenderapi.New(logger, mockTenderService)
 
-	router := gin.New()
+	router := newRouter()
+
+	router.Use(authenticatedAs("qwe"))
 	router.PATCH("/api/tenders/:tenderId/edit", svc.EditTender(ctx))
 	req := httptest.NewRequest(http.MethodPatch, "/api/tenders/qwe/edit", strings.NewReader(reqBody))
 	w := httptest.NewRecorder()
@@ -296,7 +340,7 @@ func TestEditTender_FailTenderIdNegativeInt(t *testing.T) {
 			"update_tender_data": {
 				"name": "update Tender 1",
 				"description": "update qwe",
-				"service_type": "update op",
+				"service_type": "Delivery",
 				"status": "update open",
 				"organization_id": 2,
 				"creator_username": "update qwe"
@@ -305,20 +349,20 @@ func TestEditTender_FailTenderIdNegativeInt(t *testing.T) {
 		}`
 
 	expectedBody := `
-		{
-			"updated_tender": {
-				"name": "",
-				"description": "",
-				"service_type": "",
-				"status": "",
-				"organization_id": 0,
-				"creator_username": ""
-			},
-			"message": "tender id must me positive integer"
-		}`
+	{
+		"type": "urn:tender:problem:tender_not_found",
+		"title": "Tender not found",
+		"status": 404,
+		"detail": "tenderId must be positive integer",
+		"instance": "/api/tenders/-1/edit",
+		"code": "tender_not_found"
+	}
+	`
 	svc := tenderapi.New(logger, mockTenderService)
 
-	router := gin.New()
+	router := newRouter()
+
+	router.Use(authenticatedAs("qwe"))
 	router.PATCH("/api/tenders/:tenderId/edit", svc.EditTender(ctx))
 	req := httptest.NewRequest(http.MethodPatch, "/api/tenders/-1/edit", strings.NewReader(reqBody))
 	w := httptest.NewRecorder()
@@ -349,7 +393,7 @@ func TestEditTender_FailJsonSyntaxError(t *testing.T) {
 			"update_tender_data": {
 				"name": "update Tender 1",
 				"description": "update qwe"
-				"service_type": "update op",
+				"service_type": "Delivery",
 				"status": "update open",
 				"organization_id": 2,
 				"creator_username": "update qwe"
@@ -359,7 +403,9 @@ func TestEditTender_FailJsonSyntaxError(t *testing.T) {
 
 	svc := tenderapi.New(logger, mockTenderService)
 
-	router := gin.New()
+	router := newRouter()
+
+	router.Use(authenticatedAs("qwe"))
 	router.PATCH("/api/tenders/:tenderId/edit", svc.EditTender(ctx))
 	req := httptest.NewRequest(http.MethodPatch, "/api/tenders/2/edit", strings.NewReader(reqBody))
 	w := httptest.NewRecorder()
@@ -400,7 +446,9 @@ func TestEditTender_FailJsonTypeError(t *testing.T) {
 
 	svc := tenderapi.New(logger, mockTenderService)
 
-	router := gin.New()
+	router := newRouter()
+
+	router.Use(authenticatedAs("qwe"))
 	router.PATCH("/api/tenders/:tenderId/edit", svc.EditTender(ctx))
 	req := httptest.NewRequest(http.MethodPatch, "/api/tenders/2/edit", strings.NewReader(reqBody))
 	w := httptest.NewRecorder()
@@ -432,7 +480,7 @@ func TestEditTender_FailValidationError(t *testing.T) {
 			"update_tender_data": {
 				"name": "update Tender 1",
 				"description": "update qwe",
-				"service_type": "qwe",
+				"service_type": "Construction",
 				"status": "qwe",
 				"organization_id": -2,
 				"creator_username": "update qwe"
@@ -442,7 +490,9 @@ func TestEditTender_FailValidationError(t *testing.T) {
 
 	svc := tenderapi.New(logger, mockTenderService)
 
-	router := gin.New()
+	router := newRouter()
+
+	router.Use(authenticatedAs("qwe"))
 	router.PATCH("/api/tenders/:tenderId/edit", svc.EditTender(ctx))
 	req := httptest.NewRequest(http.MethodPatch, "/api/tenders/2/edit", strings.NewReader(reqBody))
 	w := httptest.NewRecorder()
@@ -471,7 +521,7 @@ func TestEditTender_FailTenderNotFound(t *testing.T) {
 
 	tenderName := "update Tender 1"
 	description := "update qwe"
-	serviceType := "update op"
+	serviceType := "Delivery"
 	status := "update open"
 	organizationId := 2
 	creatorUsername := "update qwe"
@@ -489,7 +539,7 @@ func TestEditTender_FailTenderNotFound(t *testing.T) {
 			"update_tender_data": {
 				"name": "update Tender 1",
 				"description": "update qwe",
-				"service_type": "update op",
+				"service_type": "Delivery",
 				"status": "update open",
 				"organization_id": 2,
 				"creator_username": "update qwe"
@@ -498,21 +548,19 @@ func TestEditTender_FailTenderNotFound(t *testing.T) {
 		}`
 
 	expectedBody := `
-		{
-			"updated_tender": {
-				"name": "",
-				"description": "",
-				"service_type": "",
-				"status": "",
-				"organization_id": 0,
-				"creator_username": ""
-			},
-			"message": "tender with id=<2> not found"
-		}`
+	{
+		"type": "urn:tender:problem:tender_not_found",
+		"title": "Tender not found",
+		"status": 404,
+		"detail": "tender not found",
+		"instance": "/api/tenders/2/edit",
+		"code": "tender_not_found"
+	}
+	`
 	svc := tenderapi.New(logger, mockTenderService)
 
-	mockTenderService.On("EditTender", ctx, 2, tenderToUpdate, "qwe").Return(models.Tender{}, outerror.ErrTenderNotFound)
-	router := gin.New()
+	mockTenderService.On("EditTender", ctx, 2, tenderToUpdate, "qwe", 0).Return(models.Tender{}, outerror.ErrTenderNotFound)
+	router := newRouter(authenticatedAs("qwe"))
 	router.PATCH("/api/tenders/:tenderId/edit", svc.EditTender(ctx))
 	req := httptest.NewRequest(http.MethodPatch, "/api/tenders/2/edit", strings.NewReader(reqBody))
 	w := httptest.NewRecorder()
@@ -524,13 +572,13 @@ func TestEditTender_FailTenderNotFound(t *testing.T) {
 	router.ServeHTTP(w, req)
 
 	// Assert
-	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Equal(t, http.StatusNotFound, w.Code)
 	require.JSONEq(t, expectedBody, w.Body.String())
 }
 
 // TestEditTender_FailEmployeeNotFound проверяет, что
 // если сотрудника с таким username не существует, то возвращается
-// ошибка и код 422.
+// ошибка и код 404.
 func TestEditTender_FailEmployeeNotFound(t *testing.T) {
 	// Arrange
 	gin.SetMode(gin.TestMode)
@@ -541,7 +589,7 @@ func TestEditTender_FailEmployeeNotFound(t *testing.T) {
 
 	tenderName := "update Tender 1"
 	description := "update qwe"
-	serviceType := "update op"
+	serviceType := "Delivery"
 	status := "update open"
 	organizationId := 2
 	creatorUsername := "update qwe"
@@ -559,7 +607,7 @@ func TestEditTender_FailEmployeeNotFound(t *testing.T) {
 			"update_tender_data": {
 				"name": "update Tender 1",
 				"description": "update qwe",
-				"service_type": "update op",
+				"service_type": "Delivery",
 				"status": "update open",
 				"organization_id": 2,
 				"creator_username": "update qwe"
@@ -568,21 +616,19 @@ func TestEditTender_FailEmployeeNotFound(t *testing.T) {
 		}`
 
 	expectedBody := `
-		{
-			"updated_tender": {
-				"name": "",
-				"description": "",
-				"service_type": "",
-				"status": "",
-				"organization_id": 0,
-				"creator_username": ""
-			},
-			"message": "updated employee with username=<update qwe> not found"
-		}`
+	{
+		"type": "urn:tender:problem:employee_not_found",
+		"title": "Employee not found",
+		"status": 404,
+		"detail": "employee not found",
+		"instance": "/api/tenders/2/edit",
+		"code": "employee_not_found"
+	}
+	`
 	svc := tenderapi.New(logger, mockTenderService)
 
-	mockTenderService.On("EditTender", ctx, 2, tenderToUpdate, "qwe").Return(models.Tender{}, outerror.ErrEmployeeNotFound)
-	router := gin.New()
+	mockTenderService.On("EditTender", ctx, 2, tenderToUpdate, "qwe", 0).Return(models.Tender{}, outerror.ErrEmployeeNotFound)
+	router := newRouter(authenticatedAs("qwe"))
 	router.PATCH("/api/tenders/:tenderId/edit", svc.EditTender(ctx))
 	req := httptest.NewRequest(http.MethodPatch, "/api/tenders/2/edit", strings.NewReader(reqBody))
 	w := httptest.NewRecorder()
@@ -594,13 +640,13 @@ func TestEditTender_FailEmployeeNotFound(t *testing.T) {
 	router.ServeHTTP(w, req)
 
 	// Assert
-	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Equal(t, http.StatusNotFound, w.Code)
 	require.JSONEq(t, expectedBody, w.Body.String())
 }
 
 // TestEditTender_FailOrgNotFound проверяет, что
 // если организации с таким id не существует, то возвращается
-// ошибка и код 422.
+// ошибка и код 404.
 func TestEditTender_FailOrgNotFound(t *testing.T) {
 	// Arrange
 	gin.SetMode(gin.TestMode)
@@ -611,7 +657,7 @@ func TestEditTender_FailOrgNotFound(t *testing.T) {
 
 	tenderName := "update Tender 1"
 	description := "update qwe"
-	serviceType := "update op"
+	serviceType := "Delivery"
 	status := "update open"
 	organizationId := 2
 	creatorUsername := "update qwe"
@@ -629,7 +675,7 @@ func TestEditTender_FailOrgNotFound(t *testing.T) {
 			"update_tender_data": {
 				"name": "update Tender 1",
 				"description": "update qwe",
-				"service_type": "update op",
+				"service_type": "Delivery",
 				"status": "update open",
 				"organization_id": 2,
 				"creator_username": "update qwe"
@@ -638,21 +684,19 @@ func TestEditTender_FailOrgNotFound(t *testing.T) {
 		}`
 
 	expectedBody := `
-		{
-			"updated_tender": {
-				"name": "",
-				"description": "",
-				"service_type": "",
-				"status": "",
-				"organization_id": 0,
-				"creator_username": ""
-			},
-			"message": "updated organization with id=<2> not found"
-		}`
+	{
+		"type": "urn:tender:problem:organization_not_found",
+		"title": "Organization not found",
+		"status": 404,
+		"detail": "organization not found",
+		"instance": "/api/tenders/2/edit",
+		"code": "organization_not_found"
+	}
+	`
 	svc := tenderapi.New(logger, mockTenderService)
 
-	mockTenderService.On("EditTender", ctx, 2, tenderToUpdate, "qwe").Return(models.Tender{}, outerror.ErrOrganizationNotFound)
-	router := gin.New()
+	mockTenderService.On("EditTender", ctx, 2, tenderToUpdate, "qwe", 0).Return(models.Tender{}, outerror.ErrOrganizationNotFound)
+	router := newRouter(authenticatedAs("qwe"))
 	router.PATCH("/api/tenders/:tenderId/edit", svc.EditTender(ctx))
 	req := httptest.NewRequest(http.MethodPatch, "/api/tenders/2/edit", strings.NewReader(reqBody))
 	w := httptest.NewRecorder()
@@ -664,7 +708,7 @@ func TestEditTender_FailOrgNotFound(t *testing.T) {
 	router.ServeHTTP(w, req)
 
 	// Assert
-	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Equal(t, http.StatusNotFound, w.Code)
 	require.JSONEq(t, expectedBody, w.Body.String())
 }
 
@@ -681,7 +725,7 @@ func TestEditTender_FailNewEmployeeNotResponsibleForNewOrg(t *testing.T) {
 
 	tenderName := "update Tender 1"
 	description := "update qwe"
-	serviceType := "update op"
+	serviceType := "Delivery"
 	status := "update open"
 	organizationId := 2
 	creatorUsername := "update qwe"
@@ -699,7 +743,7 @@ func TestEditTender_FailNewEmployeeNotResponsibleForNewOrg(t *testing.T) {
 			"update_tender_data": {
 				"name": "update Tender 1",
 				"description": "update qwe",
-				"service_type": "update op",
+				"service_type": "Delivery",
 				"status": "update open",
 				"organization_id": 2,
 				"creator_username": "update qwe"
@@ -708,21 +752,19 @@ func TestEditTender_FailNewEmployeeNotResponsibleForNewOrg(t *testing.T) {
 		}`
 
 	expectedBody := `
-		{
-			"updated_tender": {
-				"name": "",
-				"description": "",
-				"service_type": "",
-				"status": "",
-				"organization_id": 0,
-				"creator_username": ""
-			},
-			"message": "new employee with username=<update qwe> not responsible for new organization with id=<2>"
-		}`
+	{
+		"type": "urn:tender:problem:updated_employee_not_responsible_for_updated_organization",
+		"title": "Updated employee not responsible for updated organization",
+		"status": 403,
+		"detail": "updated employee not responsible for updated organization",
+		"instance": "/api/tenders/2/edit",
+		"code": "updated_employee_not_responsible_for_updated_organization"
+	}
+	`
 	svc := tenderapi.New(logger, mockTenderService)
 
-	mockTenderService.On("EditTender", ctx, 2, tenderToUpdate, "qwe").Return(models.Tender{}, outerror.ErrUpdatedEmployeeNotResponsibleForUpdatedOrg)
-	router := gin.New()
+	mockTenderService.On("EditTender", ctx, 2, tenderToUpdate, "qwe", 0).Return(models.Tender{}, outerror.ErrUpdatedEmployeeNotResponsibleForUpdatedOrg)
+	router := newRouter(authenticatedAs("qwe"))
 	router.PATCH("/api/tenders/:tenderId/edit", svc.EditTender(ctx))
 	req := httptest.NewRequest(http.MethodPatch, "/api/tenders/2/edit", strings.NewReader(reqBody))
 	w := httptest.NewRecorder()
@@ -751,7 +793,7 @@ func TestEditTender_FailNewEmployeeNotRespobsibleForCurrentOrg(t *testing.T) {
 
 	tenderName := "update Tender 1"
 	description := "update qwe"
-	serviceType := "update op"
+	serviceType := "Delivery"
 	status := "update open"
 	organizationId := 2
 	creatorUsername := "update qwe"
@@ -769,7 +811,7 @@ func TestEditTender_FailNewEmployeeNotRespobsibleForCurrentOrg(t *testing.T) {
 			"update_tender_data": {
 				"name": "update Tender 1",
 				"description": "update qwe",
-				"service_type": "update op",
+				"service_type": "Delivery",
 				"status": "update open",
 				"organization_id": 2,
 				"creator_username": "update qwe"
@@ -778,21 +820,19 @@ func TestEditTender_FailNewEmployeeNotRespobsibleForCurrentOrg(t *testing.T) {
 		}`
 
 	expectedBody := `
-		{
-			"updated_tender": {
-				"name": "",
-				"description": "",
-				"service_type": "",
-				"status": "",
-				"organization_id": 0,
-				"creator_username": ""
-			},
-			"message": "new employee with username=<update qwe> not responsible for current organization"
-		}`
+	{
+		"type": "urn:tender:problem:updated_employee_not_responsible_for_current_organization",
+		"title": "Updated employee not responsible for current organization",
+		"status": 403,
+		"detail": "updated employee not responsible for current organization",
+		"instance": "/api/tenders/2/edit",
+		"code": "updated_employee_not_responsible_for_current_organization"
+	}
+	`
 	svc := tenderapi.New(logger, mockTenderService)
 
-	mockTenderService.On("EditTender", ctx, 2, tenderToUpdate, "qwe").Return(models.Tender{}, outerror.ErrUpdatedEmployeeNotResponsibleForCurrentOrg)
-	router := gin.New()
+	mockTenderService.On("EditTender", ctx, 2, tenderToUpdate, "qwe", 0).Return(models.Tender{}, outerror.ErrUpdatedEmployeeNotResponsibleForCurrentOrg)
+	router := newRouter(authenticatedAs("qwe"))
 	router.PATCH("/api/tenders/:tenderId/edit", svc.EditTender(ctx))
 	req := httptest.NewRequest(http.MethodPatch, "/api/tenders/2/edit", strings.NewReader(reqBody))
 	w := httptest.NewRecorder()
@@ -821,7 +861,7 @@ func TestEditTender_FailCurrEmployeeNotRespobsibleForNewOrg(t *testing.T) {
 
 	tenderName := "update Tender 1"
 	description := "update qwe"
-	serviceType := "update op"
+	serviceType := "Delivery"
 	status := "update open"
 	organizationId := 2
 	creatorUsername := "update qwe"
@@ -839,7 +879,7 @@ func TestEditTender_FailCurrEmployeeNotRespobsibleForNewOrg(t *testing.T) {
 			"update_tender_data": {
 				"name": "update Tender 1",
 				"description": "update qwe",
-				"service_type": "update op",
+				"service_type": "Delivery",
 				"status": "update open",
 				"organization_id": 2,
 				"creator_username": "update qwe"
@@ -848,21 +888,19 @@ func TestEditTender_FailCurrEmployeeNotRespobsibleForNewOrg(t *testing.T) {
 		}`
 
 	expectedBody := `
-		{
-			"updated_tender": {
-				"name": "",
-				"description": "",
-				"service_type": "",
-				"status": "",
-				"organization_id": 0,
-				"creator_username": ""
-			},
-			"message": "current employee not responsible for updated organization with id=<2>"
-		}`
+	{
+		"type": "urn:tender:problem:current_employee_not_responsible_for_updated_organization",
+		"title": "Current employee not responsible for updated organization",
+		"status": 403,
+		"detail": "current employee not responsible for updated organization",
+		"instance": "/api/tenders/2/edit",
+		"code": "current_employee_not_responsible_for_updated_organization"
+	}
+	`
 	svc := tenderapi.New(logger, mockTenderService)
 
-	mockTenderService.On("EditTender", ctx, 2, tenderToUpdate, "qwe").Return(models.Tender{}, outerror.ErrCurrentEmployeeNotResponsibleForUpdatedOrg)
-	router := gin.New()
+	mockTenderService.On("EditTender", ctx, 2, tenderToUpdate, "qwe", 0).Return(models.Tender{}, outerror.ErrCurrentEmployeeNotResponsibleForUpdatedOrg)
+	router := newRouter(authenticatedAs("qwe"))
 	router.PATCH("/api/tenders/:tenderId/edit", svc.EditTender(ctx))
 	req := httptest.NewRequest(http.MethodPatch, "/api/tenders/2/edit", strings.NewReader(reqBody))
 	w := httptest.NewRecorder()
@@ -891,7 +929,7 @@ func TestEditTender_FailUnknownTenderStatus(t *testing.T) {
 
 	tenderName := "update Tender 1"
 	description := "update qwe"
-	serviceType := "update op"
+	serviceType := "Delivery"
 	status := "update open"
 	organizationId := 2
 	creatorUsername := "update qwe"
@@ -909,7 +947,7 @@ func TestEditTender_FailUnknownTenderStatus(t *testing.T) {
 			"update_tender_data": {
 				"name": "update Tender 1",
 				"description": "update qwe",
-				"service_type": "update op",
+				"service_type": "Delivery",
 				"status": "update open",
 				"organization_id": 2,
 				"creator_username": "update qwe"
@@ -918,21 +956,19 @@ func TestEditTender_FailUnknownTenderStatus(t *testing.T) {
 		}`
 
 	expectedBody := `
-		{
-			"updated_tender": {
-				"name": "",
-				"description": "",
-				"service_type": "",
-				"status": "",
-				"organization_id": 0,
-				"creator_username": ""
-			},
-			"message": "tender status=<update open> is unknown"
-		}`
+	{
+		"type": "urn:tender:problem:unknown_tender_status",
+		"title": "Unknown tender status",
+		"status": 400,
+		"detail": "unknown tender status",
+		"instance": "/api/tenders/2/edit",
+		"code": "unknown_tender_status"
+	}
+	`
 	svc := tenderapi.New(logger, mockTenderService)
 
-	mockTenderService.On("EditTender", ctx, 2, tenderToUpdate, "qwe").Return(models.Tender{}, outerror.ErrUnknownTenderStatus)
-	router := gin.New()
+	mockTenderService.On("EditTender", ctx, 2, tenderToUpdate, "qwe", 0).Return(models.Tender{}, outerror.ErrUnknownTenderStatus)
+	router := newRouter(authenticatedAs("qwe"))
 	router.PATCH("/api/tenders/:tenderId/edit", svc.EditTender(ctx))
 	req := httptest.NewRequest(http.MethodPatch, "/api/tenders/2/edit", strings.NewReader(reqBody))
 	w := httptest.NewRecorder()
@@ -966,7 +1002,7 @@ func TestEditTender_FailCannotUpdateTenderStatus(t *testing.T) {
 
 	tenderName := "update Tender 1"
 	description := "update qwe"
-	serviceType := "update op"
+	serviceType := "Delivery"
 	status := "CLOSED"
 	organizationId := 2
 	creatorUsername := "update qwe"
@@ -984,7 +1020,7 @@ func TestEditTender_FailCannotUpdateTenderStatus(t *testing.T) {
 			"update_tender_data": {
 				"name": "update Tender 1",
 				"description": "update qwe",
-				"service_type": "update op",
+				"service_type": "Delivery",
 				"status": "CLOSED",
 				"organization_id": 2,
 				"creator_username": "update qwe"
@@ -993,21 +1029,19 @@ func TestEditTender_FailCannotUpdateTenderStatus(t *testing.T) {
 		}`
 
 	expectedBody := `
-		{
-			"updated_tender": {
-				"name": "",
-				"description": "",
-				"service_type": "",
-				"status": "",
-				"organization_id": 0,
-				"creator_username": ""
-			},
-			"message": "cannot set this tender status. Cannot set tender status from PUBLISHED to CREATED and from CLOSED to CREATED"
-		}`
+	{
+		"type": "urn:tender:problem:tender_status_transition_not_allowed",
+		"title": "Tender status transition not allowed",
+		"status": 400,
+		"detail": "tender status transition is not allowed",
+		"instance": "/api/tenders/2/edit",
+		"code": "tender_status_transition_not_allowed"
+	}
+	`
 	svc := tenderapi.New(logger, mockTenderService)
 
-	mockTenderService.On("EditTender", ctx, 2, tenderToUpdate, "qwe").Return(models.Tender{}, outerror.ErrCannotSetThisTenderStatus)
-	router := gin.New()
+	mockTenderService.On("EditTender", ctx, 2, tenderToUpdate, "qwe", 0).Return(models.Tender{}, outerror.ErrCannotSetThisTenderStatus)
+	router := newRouter(authenticatedAs("qwe"))
 	router.PATCH("/api/tenders/:tenderId/edit", svc.EditTender(ctx))
 	req := httptest.NewRequest(http.MethodPatch, "/api/tenders/2/edit", strings.NewReader(reqBody))
 	w := httptest.NewRecorder()
@@ -1045,21 +1079,19 @@ func TestEditTender_FailEmployeeNotRespobsibleForTender(t *testing.T) {
 		}`
 
 	expectedBody := `
-		{
-			"updated_tender": {
-				"name": "",
-				"description": "",
-				"service_type": "",
-				"status": "",
-				"organization_id": 0,
-				"creator_username": ""
-			},
-			"message": "employee with username=<qwe> not creator of tender with id=<2>"
-		}`
+	{
+		"type": "urn:tender:problem:employee_not_responsible_for_tender",
+		"title": "Employee not responsible for tender",
+		"status": 403,
+		"detail": "employee not respobsible for this tender",
+		"instance": "/api/tenders/2/edit",
+		"code": "employee_not_responsible_for_tender"
+	}
+	`
 	svc := tenderapi.New(logger, mockTenderService)
 
-	mockTenderService.On("EditTender", ctx, 2, tenderToUpdate, "qwe").Return(models.Tender{}, outerror.ErrEmployeeNotResponsibleForTender)
-	router := gin.New()
+	mockTenderService.On("EditTender", ctx, 2, tenderToUpdate, "qwe", 0).Return(models.Tender{}, outerror.ErrEmployeeNotResponsibleForTender)
+	router := newRouter(authenticatedAs("qwe"))
 	router.PATCH("/api/tenders/:tenderId/edit", svc.EditTender(ctx))
 	req := httptest.NewRequest(http.MethodPatch, "/api/tenders/2/edit", strings.NewReader(reqBody))
 	w := httptest.NewRecorder()
@@ -1085,7 +1117,7 @@ func TestEditTender_FailCannotUpdateTender(t *testing.T) {
 
 	tenderName := "update Tender 1"
 	description := "update qwe"
-	serviceType := "update op"
+	serviceType := "Delivery"
 	status := "update open"
 	organizationId := 2
 	creatorUsername := "update qwe"
@@ -1103,7 +1135,7 @@ func TestEditTender_FailCannotUpdateTender(t *testing.T) {
 			"update_tender_data": {
 				"name": "update Tender 1",
 				"description": "update qwe",
-				"service_type": "update op",
+				"service_type": "Delivery",
 				"status": "update open",
 				"organization_id": 2,
 				"creator_username": "update qwe"
@@ -1112,21 +1144,18 @@ func TestEditTender_FailCannotUpdateTender(t *testing.T) {
 		}`
 
 	expectedBody := `
-		{
-			"updated_tender": {
-				"name": "",
-				"description": "",
-				"service_type": "",
-				"status": "",
-				"organization_id": 0,
-				"creator_username": ""
-			},
-			"message": "internal error"
-		}`
+	{
+		"type": "urn:tender:problem:internal_error",
+		"title": "Internal error",
+		"status": 500,
+		"instance": "/api/tenders/2/edit",
+		"code": "internal_error"
+	}
+	`
 	svc := tenderapi.New(logger, mockTenderService)
 	someErr := errors.New("some error")
-	mockTenderService.On("EditTender", ctx, 2, tenderToUpdate, "qwe").Return(models.Tender{}, someErr)
-	router := gin.New()
+	mockTenderService.On("EditTender", ctx, 2, tenderToUpdate, "qwe", 0).Return(models.Tender{}, someErr)
+	router := newRouter(authenticatedAs("qwe"))
 	router.PATCH("/api/tenders/:tenderId/edit", svc.EditTender(ctx))
 	req := httptest.NewRequest(http.MethodPatch, "/api/tenders/2/edit", strings.NewReader(reqBody))
 	w := httptest.NewRecorder()
@@ -0,0 +1,181 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sariya23/tender/internal/domain/models"
+	tenderapi "github.com/sariya23/tender/internal/hanlders/tender"
+	"github.com/sariya23/tender/internal/hanlders/tender/mocks"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetTenderVersions_Success проверяет, что
+// возвращается список версий тендера и код 200.
+func TestGetTenderVersions_Success(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	createdAt := time.Date(2024, 12, 18, 10, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2024, 12, 18, 12, 30, 0, 0, time.UTC)
+	mockVersions := []models.TenderVersion{
+		{
+			Tender:     models.Tender{ID: 2, Version: 1, CreatedAt: createdAt, UpdatedAt: createdAt, TenderName: "qwe", Description: "qwe", ServiceType: "qwe", Status: "CREATED", OrganizationId: 1, CreatorUsername: "qwe"},
+			ModifiedBy: "qwe",
+		},
+		{
+			Tender:          models.Tender{ID: 2, Version: 2, CreatedAt: createdAt, UpdatedAt: updatedAt, TenderName: "qwe", Description: "qwe", ServiceType: "qwe", Status: "PUBLISHED", OrganizationId: 1, CreatorUsername: "qwe"},
+			IsActiveVersion: true,
+			ModifiedBy:      "qwe",
+		},
+	}
+	expectedBody := `
+	{
+		"versions": [
+			{"id": 2, "version": 1, "created_at": "2024-12-18T10:00:00Z", "updated_at": "2024-12-18T10:00:00Z", "name": "qwe", "description": "qwe", "service_type": "qwe", "status": "CREATED", "organization_id": 1, "creator_username": "qwe", "is_active_version": false, "modified_by": "qwe"},
+			{"id": 2, "version": 2, "created_at": "2024-12-18T10:00:00Z", "updated_at": "2024-12-18T12:30:00Z", "name": "qwe", "description": "qwe", "service_type": "qwe", "status": "PUBLISHED", "organization_id": 1, "creator_username": "qwe", "is_active_version": true, "modified_by": "qwe"}
+		],
+		"message": "ok"
+	}`
+	svc := tenderapi.New(logger, mockTenderService)
+
+	mockTenderService.On("GetTenderVersions", ctx, 2, "qwe").Return(mockVersions, nil)
+	router := gin.New()
+	router.Use(authenticatedAs("qwe"))
+	router.GET("/api/tenders/:tenderId/versions", svc.GetTenderVersions(ctx))
+	req := httptest.NewRequest(http.MethodGet, "/api/tenders/2/versions?username=qwe", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestGetTenderVersions_FailNotAuthenticated проверяет, что
+// без аутентификации возвращается код 401.
+func TestGetTenderVersions_FailNotAuthenticated(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	expectedBody := `
+	{
+		"type": "urn:tender:problem:not_authenticated",
+		"title": "Authentication required",
+		"status": 401,
+		"detail": "authentication required",
+		"instance": "/api/tenders/2/versions",
+		"code": "not_authenticated"
+	}
+	`
+	svc := tenderapi.New(logger, mockTenderService)
+	router := newRouter()
+	router.GET("/api/tenders/:tenderId/versions", svc.GetTenderVersions(ctx))
+	req := httptest.NewRequest(http.MethodGet, "/api/tenders/2/versions", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestGetTenderVersions_FailNotCreator проверяет, что
+// если сотрудник не создатель тендера, то возвращается код 403.
+func TestGetTenderVersions_FailNotCreator(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	expectedBody := `
+	{
+		"type": "urn:tender:problem:employee_not_responsible_for_tender",
+		"title": "Employee not responsible for tender",
+		"status": 403,
+		"detail": "employee not respobsible for this tender",
+		"instance": "/api/tenders/2/versions",
+		"code": "employee_not_responsible_for_tender"
+	}
+	`
+	svc := tenderapi.New(logger, mockTenderService)
+
+	mockTenderService.On("GetTenderVersions", ctx, 2, "zxc").Return([]models.TenderVersion{}, outerror.ErrEmployeeNotResponsibleForTender)
+	router := newRouter(authenticatedAs("zxc"))
+	router.GET("/api/tenders/:tenderId/versions", svc.GetTenderVersions(ctx))
+	req := httptest.NewRequest(http.MethodGet, "/api/tenders/2/versions?username=zxc", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestGetTenderVersion_FailVersionNotFound проверяет, что
+// если у тендера нет такой версии, то возвращается код 404.
+func TestGetTenderVersion_FailVersionNotFound(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	svc := tenderapi.New(logger, mockTenderService)
+
+	mockTenderService.On("GetTenderVersion", ctx, 2, 7, "qwe").Return(models.TenderVersion{}, outerror.ErrTenderVersionNotFound)
+	router := newRouter(authenticatedAs("qwe"))
+	router.GET("/api/tenders/:tenderId/versions/:version", svc.GetTenderVersion(ctx))
+	req := httptest.NewRequest(http.MethodGet, "/api/tenders/2/versions/7?username=qwe", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	details := requireProblem(t, w, http.StatusNotFound, "tender_version_not_found")
+	require.Equal(t, "tender version not found", details.Detail)
+}
+
+// TestGetTenderVersion_FailVersionIsNotInt проверяет, что
+// если версия не число, то возвращается код 404.
+func TestGetTenderVersion_FailVersionIsNotInt(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	svc := tenderapi.New(logger, mockTenderService)
+	router := newRouter(authenticatedAs("qwe"))
+	router.GET("/api/tenders/:tenderId/versions/:version", svc.GetTenderVersion(ctx))
+	req := httptest.NewRequest(http.MethodGet, "/api/tenders/2/versions/abc?username=qwe", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	mockTenderService.AssertNotCalled(t, "GetTenderVersion")
+}
@@ -0,0 +1,157 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sariya23/tender/internal/domain/models"
+	tenderapi "github.com/sariya23/tender/internal/hanlders/tender"
+	"github.com/sariya23/tender/internal/hanlders/tender/mocks"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestVoteCloseTender_SuccessPending проверяет, что
+// при успешном голосовании возвращается состояние голосования и код 200.
+func TestVoteCloseTender_SuccessPending(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	reqBody := `
+	{
+		"username": "qwe",
+		"decision": "APPROVE"
+	}`
+	expectedBody := `
+		{
+			"voting": {
+				"status": "PENDING",
+				"approvals": 1,
+				"quorum": 3
+			},
+			"message": "ok"
+		}`
+	svc := tenderapi.New(logger, mockTenderService)
+
+	mockTenderService.On("VoteCloseTender", ctx, 2, "qwe", "APPROVE").Return(
+		models.TenderCloseVoting{Status: models.CloseVotingPending, Approvals: 1, Quorum: 3},
+		nil,
+	)
+	router := gin.New()
+	router.Use(authenticatedAs("qwe"))
+	router.PUT("/api/tenders/:tenderId/close/vote", svc.VoteCloseTender(ctx))
+	req := httptest.NewRequest(http.MethodPut, "/api/tenders/2/close/vote", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestVoteCloseTender_FailValidation проверяет, что
+// без решения в запросе возвращается код 400.
+func TestVoteCloseTender_FailValidation(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	reqBody := `
+	{
+		"username": "qwe"
+	}`
+	svc := tenderapi.New(logger, mockTenderService)
+	router := newRouter(authenticatedAs("qwe"))
+	router.PUT("/api/tenders/:tenderId/close/vote", svc.VoteCloseTender(ctx))
+	req := httptest.NewRequest(http.MethodPut, "/api/tenders/2/close/vote", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockTenderService.AssertNotCalled(t, "VoteCloseTender")
+}
+
+// TestVoteCloseTender_FailAlreadyVoted проверяет, что
+// при повторном голосе возвращается код 409.
+func TestVoteCloseTender_FailAlreadyVoted(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	reqBody := `
+	{
+		"username": "qwe",
+		"decision": "APPROVE"
+	}`
+	expectedBody := `
+	{
+		"type": "urn:tender:problem:employee_already_voted",
+		"title": "Employee already voted",
+		"status": 409,
+		"detail": "employee already voted for closing this tender version",
+		"instance": "/api/tenders/2/close/vote",
+		"code": "employee_already_voted"
+	}
+	`
+	svc := tenderapi.New(logger, mockTenderService)
+
+	mockTenderService.On("VoteCloseTender", ctx, 2, "qwe", "APPROVE").Return(models.TenderCloseVoting{}, outerror.ErrEmployeeAlreadyVoted)
+	router := newRouter(authenticatedAs("qwe"))
+	router.PUT("/api/tenders/:tenderId/close/vote", svc.VoteCloseTender(ctx))
+	req := httptest.NewRequest(http.MethodPut, "/api/tenders/2/close/vote", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusConflict, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestVoteCloseTender_FailEmployeeNotResponsible проверяет, что
+// если сотрудник не ответственный за организацию тендера, то возвращается код 403.
+func TestVoteCloseTender_FailEmployeeNotResponsible(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+
+	logger := slogdiscard.NewDiscardLogger()
+	mockTenderService := new(mocks.MockTenderServiceProvider)
+	reqBody := `
+	{
+		"username": "qwe",
+		"decision": "REJECT"
+	}`
+	svc := tenderapi.New(logger, mockTenderService)
+
+	mockTenderService.On("VoteCloseTender", ctx, 2, "qwe", "REJECT").Return(models.TenderCloseVoting{}, outerror.ErrEmployeeNotResponsibleForOrganization)
+	router := newRouter(authenticatedAs("qwe"))
+	router.PUT("/api/tenders/:tenderId/close/vote", svc.VoteCloseTender(ctx))
+	req := httptest.NewRequest(http.MethodPut, "/api/tenders/2/close/vote", strings.NewReader(reqBody))
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
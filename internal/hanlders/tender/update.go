@@ -10,10 +10,10 @@ import (
 	"strconv"
 
 	"github.com/gin-gonic/gin"
-	"github.com/go-playground/validator/v10"
-	"github.com/sariya23/tender/internal/domain/models"
 	schema "github.com/sariya23/tender/internal/hanlders"
+	"github.com/sariya23/tender/internal/lib/problem"
 	"github.com/sariya23/tender/internal/lib/unmarshal"
+	"github.com/sariya23/tender/internal/middleware"
 	outerror "github.com/sariya23/tender/internal/out_error"
 )
 
@@ -23,20 +23,10 @@ func (tenderSrv *TenderService) EditTender(ctx context.Context) gin.HandlerFunc
 		logger := tenderSrv.logger.With("op", opeartionPlace)
 		logger.Info(fmt.Sprintf("request to %v", ginContext.Request.URL.Path))
 
-		tenderId := ginContext.Param("tenderId")
-		convertedTenderId, err := strconv.Atoi(tenderId)
+		tenderId, err := tenderSrv.pathId(ginContext, "tenderId", outerror.ErrTenderNotFound)
 		if err != nil {
-			logger.Error(
-				"cannot convert tender id to int",
-				slog.String("tender id", tenderId),
-				slog.String("err", err.Error()),
-			)
-			ginContext.JSON(http.StatusNotFound, schema.EditTenderResponse{Message: "cannot convert tender id to integer", UpdatedTender: models.Tender{}})
-			return
-		}
-		if convertedTenderId < 0 {
-			logger.Error("tender id is not positive integer", slog.String("tender id", tenderId))
-			ginContext.JSON(http.StatusNotFound, schema.EditTenderResponse{Message: "tender id must me positive integer", UpdatedTender: models.Tender{}})
+			logger.Warn("invalid tender id", slog.String("err", err.Error()))
+			ginContext.Error(err)
 			return
 		}
 
@@ -51,209 +41,52 @@ func (tenderSrv *TenderService) EditTender(ctx context.Context) gin.HandlerFunc
 		bodyData, err := io.ReadAll(body)
 		if err != nil {
 			logger.Error("cannot read body", slog.String("err", err.Error()))
-			ginContext.JSON(http.StatusInternalServerError, schema.EditTenderResponse{Message: "internal error", UpdatedTender: models.Tender{}})
+			ginContext.Error(fmt.Errorf("cannot read body: %w", err))
 			return
 		}
 		logger.Info("success read body")
 
 		updatedReq, err := unmarshal.EditRequest(bodyData)
 		if err != nil {
-			if errors.Is(err, unmarshal.ErrSyntax) {
-				logger.Warn("req syntax error", slog.String("err", err.Error()))
-				ginContext.JSON(
-					http.StatusBadRequest,
-					schema.EditTenderResponse{
-						Message:       fmt.Sprintf("json syntax err: %s", err.Error()),
-						UpdatedTender: models.Tender{},
-					},
-				)
-				return
-			} else if errors.Is(err, unmarshal.ErrType) {
-				logger.Warn("req type error", slog.String("err", err.Error()))
-				ginContext.JSON(
-					http.StatusBadRequest,
-					schema.EditTenderResponse{
-						Message:       fmt.Sprintf("json type err: %s", err.Error()),
-						UpdatedTender: models.Tender{},
-					},
-				)
-				return
-			} else {
-				logger.Error("unexpected error", slog.String("err", err.Error()))
-				ginContext.JSON(
-					http.StatusInternalServerError,
-					schema.EditTenderResponse{
-						Message:       "internal error",
-						UpdatedTender: models.Tender{},
-					},
-				)
-				return
-			}
+			logger.Warn("cannot unmarshal request", slog.String("err", err.Error()))
+			ginContext.Error(tenderSrv.bodyProblem(ginContext, err))
+			return
 		}
 		logger.Info("success unmarshal request")
 
-		validate := validator.New(validator.WithRequiredStructEnabled())
-		err = validate.Struct(&updatedReq)
+		updatedReq.Username, err = middleware.ActingUsername(ginContext, updatedReq.Username)
 		if err != nil {
-			logger.Error("validation error", slog.String("err", err.Error()))
-			ginContext.JSON(
-				http.StatusBadRequest,
-				schema.EditTenderResponse{
-					Message:       fmt.Sprintf("validation failed: %s", err.Error()),
-					UpdatedTender: models.Tender{},
-				},
-			)
+			logger.Warn("cannot resolve acting employee", slog.String("err", err.Error()))
+			ginContext.Error(err)
 			return
 		}
-		logger.Info("validate success")
 
-		tender, err := tenderSrv.tenderService.EditTender(ctx, convertedTenderId, updatedReq.UpdateTenderData, updatedReq.Username)
+		err = tenderSrv.validate.Struct(&updatedReq)
+		if err != nil {
+			logger.Warn("validation error", slog.String("err", err.Error()))
+			ginContext.Error(tenderSrv.validationProblem(ginContext, err))
+			return
+		}
+		logger.Info("validate success")
 
+		expectedVersion, err := expectedTenderVersion(ginContext, updatedReq.ExpectedVersion)
 		if err != nil {
-			if errors.Is(err, outerror.ErrUnknownTenderStatus) {
-				logger.Warn(fmt.Sprintf("tender status <%s> is unknown", *updatedReq.UpdateTenderData.Status))
-				ginContext.JSON(
-					http.StatusBadRequest,
-					schema.EditTenderResponse{
-						Message:       fmt.Sprintf("tender status=<%s> is unknown", *updatedReq.UpdateTenderData.Status),
-						UpdatedTender: models.Tender{},
-					},
-				)
-				return
-			} else if errors.Is(err, outerror.ErrCannotSetThisTenderStatus) {
-				logger.Warn("cannot set this tender status")
-				ginContext.JSON(
-					http.StatusBadRequest,
-					schema.EditTenderResponse{
-						Message:       "cannot set this tender status. Cannot set tender status from PUBLISHED to CREATED and from CLOSED to CREATED",
-						UpdatedTender: models.Tender{},
-					},
-				)
-				return
+			logger.Warn("invalid version precondition", slog.String("err", err.Error()))
+			ginContext.Error(tenderSrv.invalidRequest(ginContext, err))
+			return
+		}
 
-			} else if errors.Is(err, outerror.ErrEmployeeNotFound) {
-				logger.Warn(
-					fmt.Sprintf(
-						"updated employee with username=<%s> not found",
-						*updatedReq.UpdateTenderData.CreatorUsername,
-					),
-				)
-				ginContext.JSON(
-					http.StatusUnprocessableEntity,
-					schema.EditTenderResponse{
-						Message: fmt.Sprintf(
-							"updated employee with username=<%s> not found",
-							*updatedReq.UpdateTenderData.CreatorUsername,
-						),
-						UpdatedTender: models.Tender{},
-					},
-				)
-				return
-			} else if errors.Is(err, outerror.ErrEmployeeNotResponsibleForTender) {
-				logger.Warn("employee not respobsible for tender")
-				ginContext.JSON(
-					http.StatusForbidden,
-					schema.EditTenderResponse{
-						Message: fmt.Sprintf(
-							"employee with username=<%s> not creator of tender with id=<%d>",
-							updatedReq.Username,
-							convertedTenderId,
-						),
-						UpdatedTender: models.Tender{},
-					},
-				)
-				return
-			} else if errors.Is(err, outerror.ErrTenderNotFound) {
-				logger.Warn(fmt.Sprintf("tender with id=<%d> not found", convertedTenderId))
-				ginContext.JSON(
-					http.StatusUnprocessableEntity,
-					schema.EditTenderResponse{
-						Message:       fmt.Sprintf("tender with id=<%d> not found", convertedTenderId),
-						UpdatedTender: models.Tender{},
-					},
-				)
-				return
-			} else if errors.Is(err, outerror.ErrOrganizationNotFound) {
-				logger.Warn(
-					fmt.Sprintf(
-						"updated organization with id=<%d> not found",
-						*updatedReq.UpdateTenderData.OrganizationId,
-					),
-				)
-				ginContext.JSON(
-					http.StatusUnprocessableEntity,
-					schema.EditTenderResponse{
-						Message: fmt.Sprintf(
-							"updated organization with id=<%d> not found",
-							*updatedReq.UpdateTenderData.OrganizationId,
-						),
-						UpdatedTender: models.Tender{},
-					},
-				)
-				return
-			} else if errors.Is(err, outerror.ErrUpdatedEmployeeNotResponsibleForUpdatedOrg) {
-				logger.Warn(
-					fmt.Sprintf(
-						"new employee with username=<%s> not responsible for new organization with id=<%d>",
-						*updatedReq.UpdateTenderData.CreatorUsername,
-						*updatedReq.UpdateTenderData.OrganizationId,
-					),
-				)
-				ginContext.JSON(
-					http.StatusForbidden,
-					schema.EditTenderResponse{
-						Message: fmt.Sprintf(
-							"new employee with username=<%s> not responsible for new organization with id=<%d>",
-							*updatedReq.UpdateTenderData.CreatorUsername,
-							*updatedReq.UpdateTenderData.OrganizationId,
-						),
-						UpdatedTender: models.Tender{},
-					},
-				)
-				return
-			} else if errors.Is(err, outerror.ErrUpdatedEmployeeNotResponsibleForCurrentOrg) {
-				logger.Warn(
-					fmt.Sprintf(
-						"new employee with username=<%s> not responsible for current organization",
-						*updatedReq.UpdateTenderData.CreatorUsername,
-					),
-				)
-				ginContext.JSON(
-					http.StatusForbidden,
-					schema.EditTenderResponse{
-						Message: fmt.Sprintf(
-							"new employee with username=<%s> not responsible for current organization",
-							*updatedReq.UpdateTenderData.CreatorUsername,
-						),
-						UpdatedTender: models.Tender{},
-					},
-				)
-				return
-			} else if errors.Is(err, outerror.ErrCurrentEmployeeNotResponsibleForUpdatedOrg) {
-				logger.Warn(
-					fmt.Sprintf(
-						"current employee not responsible for updated organization with id=<%d>",
-						*updatedReq.UpdateTenderData.OrganizationId,
-					),
-				)
-				ginContext.JSON(
-					http.StatusForbidden,
-					schema.EditTenderResponse{
-						Message: fmt.Sprintf(
-							"current employee not responsible for updated organization with id=<%d>",
-							*updatedReq.UpdateTenderData.OrganizationId,
-						),
-						UpdatedTender: models.Tender{},
-					},
-				)
-				return
-			} else {
-				logger.Error("unexpected error", slog.String("err", err.Error()))
-				ginContext.JSON(http.StatusInternalServerError, schema.EditTenderResponse{Message: "internal error", UpdatedTender: models.Tender{}})
-				return
+		tender, err := tenderSrv.tenderService.EditTender(ctx, tenderId, updatedReq.UpdateTenderData, updatedReq.Username, expectedVersion)
+		if err != nil {
+			logger.Warn("cannot edit tender", slog.Int("tender id", tenderId), slog.String("err", err.Error()))
+			if errors.Is(err, outerror.ErrTenderStatusReasonRequired) {
+				err = problem.New(err, tenderSrv.message(ginContext, msgStatusReasonRequired, strconv.Itoa(tenderId)))
 			}
+			ginContext.Error(err)
+			return
 		}
 		logger.Info("tender updated success")
-		ginContext.JSON(http.StatusOK, schema.EditTenderResponse{Message: "ok", UpdatedTender: tender})
+		ginContext.Header("ETag", tenderETag(tender.Version))
+		ginContext.JSON(http.StatusOK, schema.EditTenderResponse{Message: tenderSrv.message(ginContext, msgOK), UpdatedTender: tender})
 	}
 }
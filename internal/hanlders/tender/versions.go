@@ -0,0 +1,81 @@
+package tenderapi
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	schema "github.com/sariya23/tender/internal/hanlders"
+	"github.com/sariya23/tender/internal/middleware"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+func (tenderSrv *TenderService) GetTenderVersions(ctx context.Context) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		const operationPlace = "internal.api.tenderapi.GetTenderVersions"
+		logger := tenderSrv.logger.With("op", operationPlace)
+		logger.Info(fmt.Sprintf("request to %v", ginContext.Request.URL))
+
+		tenderId, err := tenderSrv.pathId(ginContext, "tenderId", outerror.ErrTenderNotFound)
+		if err != nil {
+			logger.Warn("invalid tender id", slog.String("err", err.Error()))
+			ginContext.Error(err)
+			return
+		}
+
+		username, err := middleware.ActingUsername(ginContext, ginContext.Query("username"))
+		if err != nil {
+			logger.Warn("cannot resolve acting employee", slog.String("err", err.Error()))
+			ginContext.Error(err)
+			return
+		}
+
+		versions, err := tenderSrv.tenderService.GetTenderVersions(ctx, tenderId, username)
+		if err != nil {
+			logger.Warn("cannot get tender versions", slog.Int("tender id", tenderId), slog.String("err", err.Error()))
+			ginContext.Error(err)
+			return
+		}
+		logger.Info("success get tender versions")
+		ginContext.JSON(http.StatusOK, schema.GetTenderVersionsResponse{Message: tenderSrv.message(ginContext, msgOK), Versions: versions})
+	}
+}
+
+func (tenderSrv *TenderService) GetTenderVersion(ctx context.Context) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		const operationPlace = "internal.api.tenderapi.GetTenderVersion"
+		logger := tenderSrv.logger.With("op", operationPlace)
+		logger.Info(fmt.Sprintf("request to %v", ginContext.Request.URL))
+
+		tenderId, err := tenderSrv.pathId(ginContext, "tenderId", outerror.ErrTenderNotFound)
+		if err != nil {
+			logger.Warn("invalid tender id", slog.String("err", err.Error()))
+			ginContext.Error(err)
+			return
+		}
+		version, err := tenderSrv.pathId(ginContext, "version", outerror.ErrTenderVersionNotFound)
+		if err != nil {
+			logger.Warn("invalid version", slog.String("err", err.Error()))
+			ginContext.Error(err)
+			return
+		}
+
+		username, err := middleware.ActingUsername(ginContext, ginContext.Query("username"))
+		if err != nil {
+			logger.Warn("cannot resolve acting employee", slog.String("err", err.Error()))
+			ginContext.Error(err)
+			return
+		}
+
+		tenderVersion, err := tenderSrv.tenderService.GetTenderVersion(ctx, tenderId, version, username)
+		if err != nil {
+			logger.Warn("cannot get tender version", slog.Int("tender id", tenderId), slog.Int("version", version), slog.String("err", err.Error()))
+			ginContext.Error(err)
+			return
+		}
+		logger.Info("success get tender version")
+		ginContext.JSON(http.StatusOK, schema.GetTenderVersionResponse{Message: tenderSrv.message(ginContext, msgOK), TenderVersion: tenderVersion})
+	}
+}
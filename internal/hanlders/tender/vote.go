@@ -0,0 +1,83 @@
+package tenderapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	schema "github.com/sariya23/tender/internal/hanlders"
+	"github.com/sariya23/tender/internal/lib/problem"
+	"github.com/sariya23/tender/internal/lib/unmarshal"
+	"github.com/sariya23/tender/internal/middleware"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+func (tenderSrv *TenderService) VoteCloseTender(ctx context.Context) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		const operationPlace = "internal.api.tenderapi.VoteCloseTender"
+		logger := tenderSrv.logger.With("op", operationPlace)
+		logger.Info(fmt.Sprintf("request to %v", ginContext.Request.URL.Path))
+
+		tenderId, err := tenderSrv.pathId(ginContext, "tenderId", outerror.ErrTenderNotFound)
+		if err != nil {
+			logger.Warn("invalid tender id", slog.String("err", err.Error()))
+			ginContext.Error(err)
+			return
+		}
+
+		body := ginContext.Request.Body
+		defer func() {
+			err := body.Close()
+			if err != nil {
+				logger.Error("cannot close body", slog.String("err", err.Error()))
+			}
+		}()
+
+		bodyData, err := io.ReadAll(body)
+		if err != nil {
+			logger.Error("cannot read body", slog.String("err", err.Error()))
+			ginContext.Error(fmt.Errorf("cannot read body: %w", err))
+			return
+		}
+		logger.Info("success read body")
+		voteReq, err := unmarshal.VoteCloseRequest(bodyData)
+		if err != nil {
+			logger.Warn("cannot unmarshal request", slog.String("err", err.Error()))
+			ginContext.Error(tenderSrv.bodyProblem(ginContext, err))
+			return
+		}
+		logger.Info("success unmarshal request")
+
+		voteReq.Username, err = middleware.ActingUsername(ginContext, voteReq.Username)
+		if err != nil {
+			logger.Warn("cannot resolve acting employee", slog.String("err", err.Error()))
+			ginContext.Error(err)
+			return
+		}
+
+		err = tenderSrv.validate.Struct(&voteReq)
+		if err != nil {
+			logger.Warn("validation error", slog.String("err", err.Error()))
+			ginContext.Error(tenderSrv.validationProblem(ginContext, err))
+			return
+		}
+		logger.Info("validate success")
+
+		voting, err := tenderSrv.tenderService.VoteCloseTender(ctx, tenderId, voteReq.Username, voteReq.Decision)
+		if err != nil {
+			logger.Warn("cannot vote for tender close", slog.Int("tender id", tenderId), slog.String("err", err.Error()))
+			if errors.Is(err, outerror.ErrUnknownCloseVoteDecision) {
+				err = problem.New(err, tenderSrv.message(ginContext, msgUnknownCloseVoteDecision, voteReq.Decision))
+			}
+			ginContext.Error(err)
+			return
+		}
+
+		logger.Info("vote success", slog.String("voting status", voting.Status))
+		ginContext.JSON(http.StatusOK, schema.VoteCloseTenderResponse{Message: tenderSrv.message(ginContext, msgOK), Voting: voting})
+	}
+}
@@ -0,0 +1,97 @@
+package i18n
+
+import (
+	"fmt"
+
+	"github.com/go-playground/locales/en"
+	"github.com/go-playground/locales/ru"
+	ut "github.com/go-playground/universal-translator"
+	"golang.org/x/text/language"
+)
+
+// Поддерживаемые языки. Английский - язык по умолчанию.
+const (
+	English = "en"
+	Russian = "ru"
+)
+
+// Catalog сообщения одного языка: ключ сообщения и его текст.
+// Параметры в тексте обозначаются {0}, {1} и т.д.
+type Catalog map[string]string
+
+// Translations переводы сообщений API на поддерживаемые языки.
+type Translations struct {
+	universal *ut.UniversalTranslator
+}
+
+// New создает переводы из каталогов catalogs, ключ - язык.
+// Каталог должен быть у каждого поддерживаемого языка.
+func New(catalogs map[string]Catalog) (*Translations, error) {
+	universal := ut.New(en.New(), en.New(), ru.New())
+	for _, locale := range Locales() {
+		catalog, ok := catalogs[locale]
+		if !ok {
+			return nil, fmt.Errorf("no catalog for locale %s", locale)
+		}
+		translator, _ := universal.GetTranslator(locale)
+		for key, text := range catalog {
+			if err := translator.Add(key, text, false); err != nil {
+				return nil, fmt.Errorf("cannot add message %s for locale %s: %w", key, locale, err)
+			}
+		}
+	}
+	return &Translations{universal: universal}, nil
+}
+
+// MustNew как New, но паникует при ошибке.
+func MustNew(catalogs map[string]Catalog) *Translations {
+	translations, err := New(catalogs)
+	if err != nil {
+		panic(err)
+	}
+	return translations
+}
+
+// Locales возвращает поддерживаемые языки.
+func Locales() []string {
+	return []string{English, Russian}
+}
+
+// Translator возвращает переводчик на язык из заголовка Accept-Language.
+// Языки перебираются по убыванию веса q, у языка учитывается только
+// основная часть (ru-RU - ru). Если ни один язык не поддерживается или
+// заголовок пустой, то возвращается переводчик на английский.
+func (translations *Translations) Translator(acceptLanguage string) ut.Translator {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil {
+		return translations.universal.GetFallback()
+	}
+	bases := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		base, _ := tag.Base()
+		bases = append(bases, base.String())
+	}
+	translator, _ := translations.universal.FindTranslator(bases...)
+	return translator
+}
+
+// Each вызывает fn для переводчика каждого поддерживаемого языка.
+func (translations *Translations) Each(fn func(translator ut.Translator) error) error {
+	for _, locale := range Locales() {
+		translator, _ := translations.universal.GetTranslator(locale)
+		if err := fn(translator); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// T возвращает текст сообщения key с параметрами params. Если сообщения
+// нет в каталоге, то возвращается сам ключ.
+func T(translator ut.Translator, key string, params ...string) string {
+	text, err := translator.T(key, params...)
+	if err != nil {
+		return key
+	}
+	return text
+}
@@ -0,0 +1,52 @@
+package i18n_test
+
+import (
+	"testing"
+
+	"github.com/sariya23/tender/internal/lib/i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTranslator проверяет выбор языка по заголовку Accept-Language.
+func TestTranslator(t *testing.T) {
+	translations := i18n.MustNew(map[string]i18n.Catalog{
+		i18n.English: {"greeting": "hello, {0}"},
+		i18n.Russian: {"greeting": "привет, {0}"},
+	})
+	cases := []struct {
+		name           string
+		acceptLanguage string
+		expected       string
+	}{
+		{name: "empty header", acceptLanguage: "", expected: "hello, qwe"},
+		{name: "russian", acceptLanguage: "ru", expected: "привет, qwe"},
+		{name: "russian region", acceptLanguage: "ru-RU", expected: "привет, qwe"},
+		{name: "weights", acceptLanguage: "en;q=0.5, ru;q=0.9", expected: "привет, qwe"},
+		{name: "unsupported first", acceptLanguage: "de-DE, ru;q=0.8", expected: "привет, qwe"},
+		{name: "unsupported", acceptLanguage: "fr", expected: "hello, qwe"},
+		{name: "malformed", acceptLanguage: ";;q=x", expected: "hello, qwe"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			translator := translations.Translator(tc.acceptLanguage)
+
+			assert.Equal(t, tc.expected, i18n.T(translator, "greeting", "qwe"))
+		})
+	}
+}
+
+// TestT_UnknownKey проверяет, что для неизвестного
+// сообщения возвращается его ключ.
+func TestT_UnknownKey(t *testing.T) {
+	translations := i18n.MustNew(map[string]i18n.Catalog{i18n.English: {}, i18n.Russian: {}})
+
+	assert.Equal(t, "unknown", i18n.T(translations.Translator("ru"), "unknown"))
+}
+
+// TestNew_FailNoCatalog проверяет, что каталог нужен для каждого языка.
+func TestNew_FailNoCatalog(t *testing.T) {
+	_, err := i18n.New(map[string]i18n.Catalog{i18n.English: {}})
+
+	require.Error(t, err)
+}
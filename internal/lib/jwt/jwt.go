@@ -0,0 +1,168 @@
+// Package jwt проверяет JWT, подписанные HMAC (HS256, HS384, HS512)
+// или RSA (RS256, RS384, RS512). Разбор и проверку подписи выполняет
+// github.com/golang-jwt/jwt/v5, пакет задает ключи и допустимые алгоритмы.
+package jwt
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	jwtlib "github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	ErrMalformedToken       = errors.New("malformed token")
+	ErrUnsupportedAlgorithm = errors.New("unsupported signing algorithm")
+	ErrInvalidSignature     = errors.New("invalid token signature")
+	ErrTokenExpired         = errors.New("token is expired")
+	ErrMissingExpiration    = errors.New("token has no expiration time")
+	ErrTokenNotValidYet     = errors.New("token is not valid yet")
+	ErrEmptySubject         = errors.New("token subject is empty")
+)
+
+// Claims утверждения токена, которые использует приложение.
+// Subject - username сотрудника.
+type Claims struct {
+	Subject   string
+	ExpiresAt time.Time
+	NotBefore time.Time
+}
+
+// algorithms алгоритмы подписи, которые принимает Verifier.
+var algorithms = []string{"HS256", "HS384", "HS512", "RS256", "RS384", "RS512"}
+
+// Verifier проверяет подпись и сроки действия токенов.
+// Срок действия (exp) обязателен.
+//
+// Токены HS* принимаются, только если задан hmacSecret,
+// токены RS* - только если задан rsaKey.
+type Verifier struct {
+	hmacSecret []byte
+	rsaKey     *rsa.PublicKey
+	now        func() time.Time
+}
+
+func NewVerifier(hmacSecret []byte, rsaKey *rsa.PublicKey) *Verifier {
+	return &Verifier{
+		hmacSecret: hmacSecret,
+		rsaKey:     rsaKey,
+		now:        time.Now,
+	}
+}
+
+// MustNewVerifier создает Verifier по секрету HMAC и пути к публичному
+// ключу RSA в формате PEM. Пустые значения отключают соответствующий алгоритм,
+// но хотя бы один ключ должен быть задан.
+func MustNewVerifier(hmacSecret string, rsaPublicKeyPath string) *Verifier {
+	const op = "lib.jwt.MustNewVerifier"
+	if hmacSecret == "" && rsaPublicKeyPath == "" {
+		panic(op + ": neither hmac secret nor rsa public key is configured")
+	}
+	var rsaKey *rsa.PublicKey
+	if rsaPublicKeyPath != "" {
+		pemData, err := os.ReadFile(rsaPublicKeyPath)
+		if err != nil {
+			panic(fmt.Sprintf("%s: cannot read rsa public key: %v", op, err))
+		}
+		rsaKey, err = ParseRSAPublicKey(pemData)
+		if err != nil {
+			panic(fmt.Sprintf("%s: %v", op, err))
+		}
+	}
+	var secret []byte
+	if hmacSecret != "" {
+		secret = []byte(hmacSecret)
+	}
+	return NewVerifier(secret, rsaKey)
+}
+
+// ParseRSAPublicKey разбирает публичный ключ RSA в формате PEM
+// (PUBLIC KEY или RSA PUBLIC KEY).
+func ParseRSAPublicKey(pemData []byte) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode(pemData)
+	if block == nil {
+		return nil, errors.New("cannot decode pem block")
+	}
+	if block.Type == "RSA PUBLIC KEY" {
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse public key: %w", err)
+	}
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("public key is not rsa key")
+	}
+	return rsaKey, nil
+}
+
+// Verify проверяет токен и возвращает его утверждения. Токен без
+// срока действия (exp) не принимается.
+func (verifier *Verifier) Verify(token string) (Claims, error) {
+	parser := jwtlib.NewParser(jwtlib.WithExpirationRequired(), jwtlib.WithTimeFunc(verifier.now))
+	var claims jwtlib.RegisteredClaims
+	_, err := parser.ParseWithClaims(token, &claims, verifier.key)
+	if err != nil {
+		return Claims{}, verifyError(err)
+	}
+	if claims.Subject == "" {
+		return Claims{}, ErrEmptySubject
+	}
+
+	result := Claims{Subject: claims.Subject, ExpiresAt: claims.ExpiresAt.Time}
+	if claims.NotBefore != nil {
+		result.NotBefore = claims.NotBefore.Time
+	}
+	return result, nil
+}
+
+// key возвращает ключ для проверки подписи токена. Алгоритмы, для
+// которых ключ не задан, не принимаются.
+func (verifier *Verifier) key(token *jwtlib.Token) (any, error) {
+	algorithm := token.Method.Alg()
+	if !slices.Contains(algorithms, algorithm) {
+		return nil, ErrUnsupportedAlgorithm
+	}
+	switch token.Method.(type) {
+	case *jwtlib.SigningMethodHMAC:
+		if verifier.hmacSecret == nil {
+			return nil, ErrUnsupportedAlgorithm
+		}
+		return verifier.hmacSecret, nil
+	case *jwtlib.SigningMethodRSA:
+		if verifier.rsaKey == nil {
+			return nil, ErrUnsupportedAlgorithm
+		}
+		return verifier.rsaKey, nil
+	default:
+		return nil, ErrUnsupportedAlgorithm
+	}
+}
+
+// verifyError переводит ошибку библиотеки в ошибку пакета.
+func verifyError(err error) error {
+	switch {
+	case errors.Is(err, ErrUnsupportedAlgorithm):
+		return ErrUnsupportedAlgorithm
+	case errors.Is(err, jwtlib.ErrTokenMalformed):
+		return ErrMalformedToken
+	case errors.Is(err, jwtlib.ErrTokenUnverifiable):
+		return ErrUnsupportedAlgorithm
+	case errors.Is(err, jwtlib.ErrTokenSignatureInvalid):
+		return ErrInvalidSignature
+	case errors.Is(err, jwtlib.ErrTokenRequiredClaimMissing):
+		return ErrMissingExpiration
+	case errors.Is(err, jwtlib.ErrTokenExpired):
+		return ErrTokenExpired
+	case errors.Is(err, jwtlib.ErrTokenNotValidYet):
+		return ErrTokenNotValidYet
+	}
+	return ErrMalformedToken
+}
@@ -0,0 +1,145 @@
+package jwt_test
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/sariya23/tender/internal/lib/jwt"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeSegment(t *testing.T, v any) string {
+	t.Helper()
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func signHS256(t *testing.T, secret []byte, claims map[string]any) string {
+	t.Helper()
+	signingInput := encodeSegment(t, map[string]string{"alg": "HS256", "typ": "JWT"}) + "." + encodeSegment(t, claims)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(signingInput))
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func signRS256(t *testing.T, key *rsa.PrivateKey, claims map[string]any) string {
+	t.Helper()
+	signingInput := encodeSegment(t, map[string]string{"alg": "RS256", "typ": "JWT"}) + "." + encodeSegment(t, claims)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+// TestVerify_SuccessHMAC проверяет, что токен, подписанный
+// секретом HMAC, проходит проверку.
+func TestVerify_SuccessHMAC(t *testing.T) {
+	// Arrange
+	secret := []byte("secret")
+	expiresAt := time.Now().Add(time.Hour).Unix()
+	token := signHS256(t, secret, map[string]any{"sub": "qwe", "exp": expiresAt})
+	verifier := jwt.NewVerifier(secret, nil)
+
+	// Act
+	claims, err := verifier.Verify(token)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, "qwe", claims.Subject)
+	require.Equal(t, expiresAt, claims.ExpiresAt.Unix())
+}
+
+// TestVerify_SuccessRSA проверяет, что токен, подписанный
+// ключом RSA, проходит проверку публичным ключом из PEM.
+func TestVerify_SuccessRSA(t *testing.T) {
+	// Arrange
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	publicKeyDer, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	require.NoError(t, err)
+	publicKey, err := jwt.ParseRSAPublicKey(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyDer}))
+	require.NoError(t, err)
+	token := signRS256(t, privateKey, map[string]any{"sub": "qwe", "exp": time.Now().Add(time.Hour).Unix()})
+	verifier := jwt.NewVerifier(nil, publicKey)
+
+	// Act
+	claims, err := verifier.Verify(token)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, "qwe", claims.Subject)
+}
+
+// TestVerify_Fail проверяет, что некорректные токены
+// не проходят проверку.
+func TestVerify_Fail(t *testing.T) {
+	secret := []byte("secret")
+	cases := []struct {
+		name        string
+		token       string
+		expectedErr error
+	}{
+		{
+			name:        "malformed",
+			token:       "qwe",
+			expectedErr: jwt.ErrMalformedToken,
+		},
+		{
+			name:        "wrong secret",
+			token:       signHS256(t, []byte("other"), map[string]any{"sub": "qwe"}),
+			expectedErr: jwt.ErrInvalidSignature,
+		},
+		{
+			name:        "expired",
+			token:       signHS256(t, secret, map[string]any{"sub": "qwe", "exp": time.Now().Add(-time.Minute).Unix()}),
+			expectedErr: jwt.ErrTokenExpired,
+		},
+		{
+			name:        "without expiration",
+			token:       signHS256(t, secret, map[string]any{"sub": "qwe"}),
+			expectedErr: jwt.ErrMissingExpiration,
+		},
+		{
+			name:        "not valid yet",
+			token:       signHS256(t, secret, map[string]any{"sub": "qwe", "exp": time.Now().Add(2 * time.Hour).Unix(), "nbf": time.Now().Add(time.Hour).Unix()}),
+			expectedErr: jwt.ErrTokenNotValidYet,
+		},
+		{
+			name:        "empty subject",
+			token:       signHS256(t, secret, map[string]any{"exp": time.Now().Add(time.Hour).Unix()}),
+			expectedErr: jwt.ErrEmptySubject,
+		},
+		{
+			name:        "alg none",
+			token:       encodeSegment(t, map[string]string{"alg": "none"}) + "." + encodeSegment(t, map[string]any{"sub": "qwe"}) + ".",
+			expectedErr: jwt.ErrUnsupportedAlgorithm,
+		},
+		{
+			name:        "rsa key not configured",
+			token:       encodeSegment(t, map[string]string{"alg": "RS256"}) + "." + encodeSegment(t, map[string]any{"sub": "qwe"}) + ".c2ln",
+			expectedErr: jwt.ErrUnsupportedAlgorithm,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			verifier := jwt.NewVerifier(secret, nil)
+
+			// Act
+			_, err := verifier.Verify(tc.token)
+
+			// Assert
+			require.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package keygen
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+const (
+	apiKeyPrefix = "tnd_"
+	// PrefixLength сколько первых символов ключа хранится открыто.
+	PrefixLength = 12
+)
+
+// NewAPIKey генерирует случайный API ключ и возвращает его вместе с префиксом.
+func NewAPIKey() (key string, prefix string, err error) {
+	randomBytes := make([]byte, 32)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return "", "", fmt.Errorf("cannot generate api key: %w", err)
+	}
+	key = apiKeyPrefix + hex.EncodeToString(randomBytes)
+	return key, key[:PrefixLength], nil
+}
+
+// Hash возвращает hex sha256 ключа. В хранилище попадает только хеш.
+func Hash(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,34 @@
+package keygen_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sariya23/tender/internal/lib/keygen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewAPIKey проверяет, что ключи случайные, а префикс
+// совпадает с началом ключа.
+func TestNewAPIKey(t *testing.T) {
+	firstKey, firstPrefix, err := keygen.NewAPIKey()
+	require.NoError(t, err)
+	secondKey, _, err := keygen.NewAPIKey()
+	require.NoError(t, err)
+
+	assert.NotEqual(t, firstKey, secondKey)
+	assert.Len(t, firstPrefix, keygen.PrefixLength)
+	assert.True(t, strings.HasPrefix(firstKey, firstPrefix))
+}
+
+// TestHash проверяет, что хеш ключа детерминирован и не содержит сам ключ.
+func TestHash(t *testing.T) {
+	key, _, err := keygen.NewAPIKey()
+	require.NoError(t, err)
+
+	assert.Equal(t, keygen.Hash(key), keygen.Hash(key))
+	assert.Len(t, keygen.Hash(key), 64)
+	assert.NotContains(t, keygen.Hash(key), key)
+	assert.NotEqual(t, keygen.Hash(key), keygen.Hash(key+"x"))
+}
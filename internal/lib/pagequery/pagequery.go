@@ -0,0 +1,53 @@
+package pagequery
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sariya23/tender/internal/domain/models"
+)
+
+var (
+	ErrInvalidLimit   = fmt.Errorf("limit must be integer from 1 to %d", models.MaxPageLimit)
+	ErrInvalidOffset  = errors.New("offset must be non negative integer")
+	ErrInvalidAfterId = errors.New("after_id must be positive integer")
+	ErrOffsetAndAfter = errors.New("offset and after_id cannot be used together")
+)
+
+// Parse читает параметры страницы из query параметров
+// limit, offset и after_id. Если limit не указан, то он равен
+// models.DefaultPageLimit.
+func Parse(ginContext *gin.Context) (models.Page, error) {
+	page := models.Page{Limit: models.DefaultPageLimit}
+
+	if limit, ok := ginContext.GetQuery("limit"); ok {
+		convertedLimit, err := strconv.Atoi(limit)
+		if err != nil || convertedLimit <= 0 || convertedLimit > models.MaxPageLimit {
+			return models.Page{}, ErrInvalidLimit
+		}
+		page.Limit = convertedLimit
+	}
+
+	offset, hasOffset := ginContext.GetQuery("offset")
+	if hasOffset {
+		convertedOffset, err := strconv.Atoi(offset)
+		if err != nil || convertedOffset < 0 {
+			return models.Page{}, ErrInvalidOffset
+		}
+		page.Offset = convertedOffset
+	}
+
+	if afterId, ok := ginContext.GetQuery("after_id"); ok {
+		if hasOffset {
+			return models.Page{}, ErrOffsetAndAfter
+		}
+		convertedAfterId, err := strconv.Atoi(afterId)
+		if err != nil || convertedAfterId <= 0 {
+			return models.Page{}, ErrInvalidAfterId
+		}
+		page.AfterId = convertedAfterId
+	}
+	return page, nil
+}
@@ -0,0 +1,136 @@
+package problem
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ContentType тип содержимого ответа с ошибкой (RFC 7807).
+const ContentType = "application/problem+json"
+
+// TypePrefix префикс URI типа ошибки. Тип ошибки - префикс и ее код.
+const TypePrefix = "urn:tender:problem:"
+
+// Details тело ответа с ошибкой (RFC 7807).
+//
+// Code - стабильный код ошибки, на который может опираться клиент.
+// Errors - ошибки отдельных полей запроса.
+type Details struct {
+	Type     string       `json:"type"`
+	Title    string       `json:"title"`
+	Status   int          `json:"status"`
+	Detail   string       `json:"detail,omitempty"`
+	Instance string       `json:"instance,omitempty"`
+	Code     string       `json:"code"`
+	Errors   []FieldError `json:"errors,omitempty"`
+}
+
+// FieldError ошибка в поле запроса.
+//
+// Pointer - JSON pointer (RFC 6901) на поле в теле запроса,
+// Rule - нарушенное правило, Message - описание ошибки для клиента.
+type FieldError struct {
+	Pointer string `json:"pointer"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// Entry описание ошибки: код, HTTP статус и короткий заголовок.
+type Entry struct {
+	Code   string
+	Status int
+	Title  string
+}
+
+// Type возвращает URI типа ошибки.
+func (entry Entry) Type() string {
+	return TypePrefix + entry.Code
+}
+
+// InternalError описание ошибки, которой нет в каталоге.
+var InternalError = Entry{Code: "internal_error", Status: http.StatusInternalServerError, Title: "Internal error"}
+
+// Mapping сопоставляет ошибке Err ее описание.
+type Mapping struct {
+	Err   error
+	Entry Entry
+}
+
+// Catalog каталог ошибок. Ошибка ищется через errors.Is
+// в том порядке, в котором описания перечислены в каталоге.
+type Catalog []Mapping
+
+// Lookup возвращает описание ошибки err. Если ошибки
+// нет в каталоге, то второе значение false.
+func (catalog Catalog) Lookup(err error) (Mapping, bool) {
+	for _, mapping := range catalog {
+		if errors.Is(err, mapping.Err) {
+			return mapping, true
+		}
+	}
+	return Mapping{}, false
+}
+
+// Error ошибка запроса с пояснением для клиента.
+//
+// Err - ошибка из каталога, Detail - пояснение, которое попадет в ответ,
+// Fields - ошибки отдельных полей запроса.
+type Error struct {
+	Err    error
+	Detail string
+	Fields []FieldError
+}
+
+// New создает ошибку err с пояснением detail.
+func New(err error, detail string) *Error {
+	return &Error{Err: err, Detail: detail}
+}
+
+// Newf создает ошибку err с пояснением по формату.
+func Newf(err error, format string, args ...any) *Error {
+	return New(err, fmt.Sprintf(format, args...))
+}
+
+func (problemErr *Error) Error() string {
+	if problemErr.Detail == "" {
+		return problemErr.Err.Error()
+	}
+	return fmt.Sprintf("%s: %s", problemErr.Err.Error(), problemErr.Detail)
+}
+
+func (problemErr *Error) Unwrap() error {
+	return problemErr.Err
+}
+
+// Build собирает тело ответа для ошибки err по каталогу catalog.
+//
+// Если err содержит *Error, то пояснение и ошибки полей берутся из него.
+// Иначе пояснением становится текст ошибки из каталога. Текст неизвестной
+// ошибки в ответ не попадает.
+func Build(catalog Catalog, err error, instance string) Details {
+	entry := InternalError
+	detail := ""
+	mapping, found := catalog.Lookup(err)
+	if found {
+		entry = mapping.Entry
+		detail = mapping.Err.Error()
+	}
+	details := Details{
+		Type:     entry.Type(),
+		Title:    entry.Title,
+		Status:   entry.Status,
+		Instance: instance,
+		Code:     entry.Code,
+	}
+
+	var problemErr *Error
+	if found && errors.As(err, &problemErr) {
+		if problemErr.Detail != "" {
+			detail = problemErr.Detail
+		}
+		details.Errors = problemErr.Fields
+	}
+	details.Detail = detail
+	return details
+}
@@ -0,0 +1,314 @@
+package tenderimport
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sariya23/tender/internal/domain/models"
+)
+
+// Форматы файлов импорта.
+const (
+	FormatCSV    = "csv"
+	FormatNDJSON = "ndjson"
+)
+
+// MaxRows максимальное количество строк в одном файле импорта.
+const MaxRows = 10000
+
+// maxLineSize максимальная длина строки NDJSON в байтах.
+const maxLineSize = 1 << 20
+
+// Колонки CSV файла. Названия совпадают с полями тендера в JSON.
+const (
+	ColumnName            = "name"
+	ColumnDescription     = "description"
+	ColumnServiceType     = "service_type"
+	ColumnStatus          = "status"
+	ColumnOrganizationId  = "organization_id"
+	ColumnCreatorUsername = "creator_username"
+	ColumnPublishAt       = "publish_at"
+	ColumnDeadline        = "deadline"
+)
+
+// Columns колонки, которые могут быть в CSV файле.
+var Columns = []string{
+	ColumnName,
+	ColumnDescription,
+	ColumnServiceType,
+	ColumnStatus,
+	ColumnOrganizationId,
+	ColumnCreatorUsername,
+	ColumnPublishAt,
+	ColumnDeadline,
+}
+
+// RequiredColumns колонки, без которых CSV файл не принимается.
+var RequiredColumns = []string{ColumnName, ColumnDescription, ColumnServiceType, ColumnOrganizationId}
+
+// Правила, которые нарушает значение в строке файла.
+const (
+	RuleInteger    = "integer"
+	RuleDateTime   = "datetime"
+	RuleType       = "type"
+	RuleJSON       = "json"
+	RuleFieldCount = "field_count"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported import format")
+	ErrNoRows            = errors.New("import file has no rows")
+	ErrTooManyRows       = fmt.Errorf("import file has more than %d rows", MaxRows)
+	ErrMissingColumn     = errors.New("required column is missing")
+	ErrUnknownColumn     = errors.New("unknown column")
+	ErrDuplicateColumn   = errors.New("duplicate column")
+)
+
+// ColumnError ошибка в колонке Column файла импорта.
+//
+// Ошибки заголовка CSV (ErrMissingColumn, ErrUnknownColumn, ErrDuplicateColumn)
+// не дают импортировать файл. Ошибки значений лежат в Err строки, в них
+// указано нарушенное правило Rule, а для ошибок всей строки Column пустой.
+type ColumnError struct {
+	Column string
+	Rule   string
+	Err    error
+}
+
+func (columnErr *ColumnError) Error() string {
+	if columnErr.Column == "" {
+		return columnErr.Err.Error()
+	}
+	return fmt.Sprintf("%s: %s", columnErr.Column, columnErr.Err.Error())
+}
+
+func (columnErr *ColumnError) Unwrap() error {
+	return columnErr.Err
+}
+
+// SyntaxError ошибка разбора файла в строке Line, после которой
+// файл дальше прочитать нельзя.
+type SyntaxError struct {
+	Line int
+	Err  error
+}
+
+func (syntaxErr *SyntaxError) Error() string {
+	return fmt.Sprintf("line %d: %s", syntaxErr.Line, syntaxErr.Err.Error())
+}
+
+func (syntaxErr *SyntaxError) Unwrap() error {
+	return syntaxErr.Err
+}
+
+// FormatFromContentType определяет формат файла по заголовку Content-Type:
+// text/csv - CSV, application/x-ndjson, application/jsonl и
+// application/x-jsonlines - NDJSON. Иначе возвращается ErrUnsupportedFormat.
+func FormatFromContentType(contentType string) (string, error) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return "", ErrUnsupportedFormat
+	}
+	switch mediaType {
+	case "text/csv":
+		return FormatCSV, nil
+	case "application/x-ndjson", "application/jsonl", "application/x-jsonlines":
+		return FormatNDJSON, nil
+	default:
+		return "", ErrUnsupportedFormat
+	}
+}
+
+// Parse читает тендеры из файла body в формате format. Ошибки в
+// отдельных строках не прерывают чтение и возвращаются в Err строки.
+func Parse(format string, body io.Reader) ([]models.TenderImportRow, error) {
+	var rows []models.TenderImportRow
+	var err error
+	switch format {
+	case FormatCSV:
+		rows, err = parseCSV(body)
+	case FormatNDJSON:
+		rows, err = parseNDJSON(body)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, ErrNoRows
+	}
+	return rows, nil
+}
+
+// parseCSV читает CSV файл, первая строка которого - заголовок с колонками.
+func parseCSV(body io.Reader) ([]models.TenderImportRow, error) {
+	reader := csv.NewReader(body)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, ErrNoRows
+	} else if err != nil {
+		return nil, csvSyntaxError(err, 1)
+	}
+	columns, err := csvColumns(header)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []models.TenderImportRow{}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil && !errors.Is(err, csv.ErrFieldCount) {
+			return nil, csvSyntaxError(err, len(rows)+2)
+		}
+		if len(rows) == MaxRows {
+			return nil, ErrTooManyRows
+		}
+		line, _ := reader.FieldPos(0)
+		row := models.TenderImportRow{Line: line}
+		if err != nil {
+			row.Err = &ColumnError{Rule: RuleFieldCount, Err: csv.ErrFieldCount}
+		} else {
+			row.Tender, row.Err = csvTender(columns, record)
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// csvSyntaxError оборачивает ошибку чтения CSV в SyntaxError. Если
+// номер строки неизвестен, то берется line.
+func csvSyntaxError(err error, line int) error {
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return &SyntaxError{Line: parseErr.Line, Err: parseErr.Err}
+	}
+	return &SyntaxError{Line: line, Err: err}
+}
+
+// csvColumns проверяет заголовок CSV файла и возвращает названия колонок.
+// Регистр и пробелы вокруг названий не учитываются.
+func csvColumns(header []string) ([]string, error) {
+	columns := make([]string, 0, len(header))
+	for i, column := range header {
+		// Excel сохраняет CSV в UTF-8 с BOM в начале файла.
+		if i == 0 {
+			column = strings.TrimPrefix(column, "\ufeff")
+		}
+		column = strings.ToLower(strings.TrimSpace(column))
+		if !slices.Contains(Columns, column) {
+			return nil, &ColumnError{Column: column, Err: ErrUnknownColumn}
+		}
+		if slices.Contains(columns, column) {
+			return nil, &ColumnError{Column: column, Err: ErrDuplicateColumn}
+		}
+		columns = append(columns, column)
+	}
+	for _, column := range RequiredColumns {
+		if !slices.Contains(columns, column) {
+			return nil, &ColumnError{Column: column, Err: ErrMissingColumn}
+		}
+	}
+	return columns, nil
+}
+
+// csvTender собирает тендер из значений record в колонках columns.
+func csvTender(columns []string, record []string) (models.Tender, error) {
+	var tender models.Tender
+	for i, value := range record {
+		column := columns[i]
+		switch column {
+		case ColumnName:
+			tender.TenderName = value
+		case ColumnDescription:
+			tender.Description = value
+		case ColumnServiceType:
+			tender.ServiceType = value
+		case ColumnStatus:
+			tender.Status = value
+		case ColumnCreatorUsername:
+			tender.CreatorUsername = value
+		case ColumnOrganizationId:
+			if value == "" {
+				continue
+			}
+			orgId, err := strconv.Atoi(value)
+			if err != nil {
+				return models.Tender{}, &ColumnError{Column: column, Rule: RuleInteger, Err: err}
+			}
+			tender.OrganizationId = orgId
+		case ColumnPublishAt, ColumnDeadline:
+			if value == "" {
+				continue
+			}
+			moment, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return models.Tender{}, &ColumnError{Column: column, Rule: RuleDateTime, Err: err}
+			}
+			if column == ColumnPublishAt {
+				tender.PublishAt = &moment
+			} else {
+				tender.Deadline = &moment
+			}
+		}
+	}
+	return tender, nil
+}
+
+// parseNDJSON читает файл, в каждой непустой строке которого тендер в JSON.
+func parseNDJSON(body io.Reader) ([]models.TenderImportRow, error) {
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+
+	rows := []models.TenderImportRow{}
+	line := 0
+	for scanner.Scan() {
+		line++
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+		if len(rows) == MaxRows {
+			return nil, ErrTooManyRows
+		}
+		row := models.TenderImportRow{Line: line}
+		row.Tender, row.Err = jsonTender(data)
+		rows = append(rows, row)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, &SyntaxError{Line: line + 1, Err: err}
+	}
+	return rows, nil
+}
+
+// jsonTender разбирает тендер из строки NDJSON.
+func jsonTender(data []byte) (models.Tender, error) {
+	var tender models.Tender
+	err := json.Unmarshal(data, &tender)
+	if err != nil {
+		var typeErr *json.UnmarshalTypeError
+		var timeErr *time.ParseError
+		if errors.As(err, &typeErr) && typeErr.Field != "" {
+			return models.Tender{}, &ColumnError{Column: typeErr.Field, Rule: RuleType, Err: err}
+		} else if errors.As(err, &timeErr) {
+			return models.Tender{}, &ColumnError{Rule: RuleDateTime, Err: err}
+		}
+		return models.Tender{}, &ColumnError{Rule: RuleJSON, Err: err}
+	}
+	return tender, nil
+}
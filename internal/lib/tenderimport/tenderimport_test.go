@@ -0,0 +1,148 @@
+package tenderimport_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/lib/tenderimport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFormatFromContentType проверяет определение формата по Content-Type.
+func TestFormatFromContentType(t *testing.T) {
+	cases := []struct {
+		contentType string
+		expected    string
+		err         error
+	}{
+		{contentType: "text/csv", expected: tenderimport.FormatCSV},
+		{contentType: "text/csv; charset=utf-8", expected: tenderimport.FormatCSV},
+		{contentType: "application/x-ndjson", expected: tenderimport.FormatNDJSON},
+		{contentType: "application/jsonl", expected: tenderimport.FormatNDJSON},
+		{contentType: "application/json", err: tenderimport.ErrUnsupportedFormat},
+		{contentType: "", err: tenderimport.ErrUnsupportedFormat},
+	}
+	for _, tc := range cases {
+		t.Run(tc.contentType, func(t *testing.T) {
+			format, err := tenderimport.FormatFromContentType(tc.contentType)
+
+			assert.ErrorIs(t, err, tc.err)
+			assert.Equal(t, tc.expected, format)
+		})
+	}
+}
+
+// TestParse_CSV проверяет, что колонки берутся по заголовку, а ошибки
+// значений остаются в своих строках и не прерывают чтение.
+func TestParse_CSV(t *testing.T) {
+	body := "\ufeffName, Service_Type,description,organization_id,deadline\n" +
+		"Тендер 1,Construction,\"Первый, тендер\",1,2024-12-27T10:00:00Z\n" +
+		"Тендер 2,Delivery,Второй тендер,qwe,\n" +
+		"Тендер 3,Delivery\n" +
+		"Тендер 4,Delivery,Четвертый тендер,2,\n"
+	deadline := time.Date(2024, 12, 27, 10, 0, 0, 0, time.UTC)
+
+	rows, err := tenderimport.Parse(tenderimport.FormatCSV, strings.NewReader(body))
+
+	require.NoError(t, err)
+	require.Len(t, rows, 4)
+	assert.Equal(t, models.TenderImportRow{Line: 2, Tender: models.Tender{
+		TenderName: "Тендер 1", ServiceType: "Construction", Description: "Первый, тендер", OrganizationId: 1, Deadline: &deadline,
+	}}, rows[0])
+
+	var columnErr *tenderimport.ColumnError
+	require.ErrorAs(t, rows[1].Err, &columnErr)
+	assert.Equal(t, 3, rows[1].Line)
+	assert.Equal(t, tenderimport.ColumnOrganizationId, columnErr.Column)
+	assert.Equal(t, tenderimport.RuleInteger, columnErr.Rule)
+
+	require.ErrorAs(t, rows[2].Err, &columnErr)
+	assert.Equal(t, tenderimport.RuleFieldCount, columnErr.Rule)
+
+	assert.NoError(t, rows[3].Err)
+	assert.Equal(t, 5, rows[3].Line)
+}
+
+// TestParse_CSVHeader проверяет, что файл с неправильным
+// заголовком не принимается.
+func TestParse_CSVHeader(t *testing.T) {
+	cases := []struct {
+		name   string
+		header string
+		column string
+		err    error
+	}{
+		{name: "missing", header: "name,description,organization_id", column: "service_type", err: tenderimport.ErrMissingColumn},
+		{name: "unknown", header: "name,description,service_type,organization_id,budget", column: "budget", err: tenderimport.ErrUnknownColumn},
+		{name: "duplicate", header: "name,name,description,service_type,organization_id", column: "name", err: tenderimport.ErrDuplicateColumn},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			rows, err := tenderimport.Parse(tenderimport.FormatCSV, strings.NewReader(tc.header+"\n"))
+
+			var columnErr *tenderimport.ColumnError
+			require.ErrorAs(t, err, &columnErr)
+			assert.ErrorIs(t, err, tc.err)
+			assert.Equal(t, tc.column, columnErr.Column)
+			assert.Nil(t, rows)
+		})
+	}
+}
+
+// TestParse_CSVSyntax проверяет, что на синтаксической ошибке
+// чтение прерывается и возвращается номер строки.
+func TestParse_CSVSyntax(t *testing.T) {
+	body := "name,description,service_type,organization_id\n" +
+		"Тендер 1,qwe,Construction,1\n" +
+		"Тендер \"2\",qwe,Construction,1\n"
+
+	rows, err := tenderimport.Parse(tenderimport.FormatCSV, strings.NewReader(body))
+
+	var syntaxErr *tenderimport.SyntaxError
+	require.ErrorAs(t, err, &syntaxErr)
+	assert.Equal(t, 3, syntaxErr.Line)
+	assert.Nil(t, rows)
+}
+
+// TestParse_NDJSON проверяет, что пустые строки пропускаются, а
+// номер строки в файле сохраняется.
+func TestParse_NDJSON(t *testing.T) {
+	body := `{"name": "Тендер 1", "description": "qwe", "service_type": "Construction", "organization_id": 1}` + "\n" +
+		"\n" +
+		`{"name": "Тендер 2", "organization_id": "1"}` + "\n" +
+		`{"name": ` + "\n"
+
+	rows, err := tenderimport.Parse(tenderimport.FormatNDJSON, strings.NewReader(body))
+
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	assert.Equal(t, models.TenderImportRow{Line: 1, Tender: models.Tender{
+		TenderName: "Тендер 1", Description: "qwe", ServiceType: "Construction", OrganizationId: 1,
+	}}, rows[0])
+
+	var columnErr *tenderimport.ColumnError
+	require.ErrorAs(t, rows[1].Err, &columnErr)
+	assert.Equal(t, 3, rows[1].Line)
+	assert.Equal(t, "organization_id", columnErr.Column)
+	assert.Equal(t, tenderimport.RuleType, columnErr.Rule)
+
+	require.ErrorAs(t, rows[2].Err, &columnErr)
+	assert.Equal(t, 4, rows[2].Line)
+	assert.Equal(t, tenderimport.RuleJSON, columnErr.Rule)
+}
+
+// TestParse_Limits проверяет пустой файл и файл с лишними строками.
+func TestParse_Limits(t *testing.T) {
+	_, err := tenderimport.Parse(tenderimport.FormatNDJSON, strings.NewReader("\n\n"))
+	assert.ErrorIs(t, err, tenderimport.ErrNoRows)
+
+	_, err = tenderimport.Parse(tenderimport.FormatCSV, strings.NewReader("name,description,service_type,organization_id\n"))
+	assert.ErrorIs(t, err, tenderimport.ErrNoRows)
+
+	body := strings.Repeat(`{"name": "qwe"}`+"\n", tenderimport.MaxRows+1)
+	_, err = tenderimport.Parse(tenderimport.FormatNDJSON, strings.NewReader(body))
+	assert.ErrorIs(t, err, tenderimport.ErrTooManyRows)
+}
@@ -0,0 +1,29 @@
+package unmarshal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	schema "github.com/sariya23/tender/internal/hanlders"
+)
+
+func IssueAPIKeyRequest(body []byte) (schema.IssueAPIKeyRequest, error) {
+	var req schema.IssueAPIKeyRequest
+	err := json.Unmarshal(body, &req)
+
+	if err != nil {
+		var syntaxErr *json.SyntaxError
+		var typeErr *json.UnmarshalTypeError
+
+		if errors.As(err, &syntaxErr) {
+			return schema.IssueAPIKeyRequest{}, fmt.Errorf("%s: %w", err.Error(), ErrSyntax)
+		} else if errors.As(err, &typeErr) {
+			return schema.IssueAPIKeyRequest{}, fmt.Errorf("%s: %w", err.Error(), ErrType)
+		} else {
+			return schema.IssueAPIKeyRequest{}, fmt.Errorf("%s: %w", err.Error(), ErrUnknown)
+		}
+	}
+
+	return req, nil
+}
@@ -0,0 +1,69 @@
+package unmarshal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	schema "github.com/sariya23/tender/internal/hanlders"
+)
+
+func CreateBidRequest(body []byte) (schema.CreateBidRequest, error) {
+	var req schema.CreateBidRequest
+	err := json.Unmarshal(body, &req)
+
+	if err != nil {
+		var syntaxErr *json.SyntaxError
+		var typeErr *json.UnmarshalTypeError
+
+		if errors.As(err, &syntaxErr) {
+			return schema.CreateBidRequest{}, fmt.Errorf("%s: %w", err.Error(), ErrSyntax)
+		} else if errors.As(err, &typeErr) {
+			return schema.CreateBidRequest{}, fmt.Errorf("%s: %w", err.Error(), ErrType)
+		} else {
+			return schema.CreateBidRequest{}, fmt.Errorf("%s: %w", err.Error(), ErrUnknown)
+		}
+	}
+
+	return req, nil
+}
+
+func EditBidRequest(body []byte) (schema.EditBidRequest, error) {
+	var req schema.EditBidRequest
+	err := json.Unmarshal(body, &req)
+
+	if err != nil {
+		var syntaxErr *json.SyntaxError
+		var typeErr *json.UnmarshalTypeError
+
+		if errors.As(err, &syntaxErr) {
+			return schema.EditBidRequest{}, fmt.Errorf("%s: %w", err.Error(), ErrSyntax)
+		} else if errors.As(err, &typeErr) {
+			return schema.EditBidRequest{}, fmt.Errorf("%s: %w", err.Error(), ErrType)
+		} else {
+			return schema.EditBidRequest{}, fmt.Errorf("%s: %w", err.Error(), ErrUnknown)
+		}
+	}
+
+	return req, nil
+}
+
+func RollbackBidRequest(body []byte) (schema.RollbackBidRequest, error) {
+	var req schema.RollbackBidRequest
+	err := json.Unmarshal(body, &req)
+
+	if err != nil {
+		var syntaxErr *json.SyntaxError
+		var typeErr *json.UnmarshalTypeError
+
+		if errors.As(err, &syntaxErr) {
+			return schema.RollbackBidRequest{}, fmt.Errorf("%s: %w", err.Error(), ErrSyntax)
+		} else if errors.As(err, &typeErr) {
+			return schema.RollbackBidRequest{}, fmt.Errorf("%s: %w", err.Error(), ErrType)
+		} else {
+			return schema.RollbackBidRequest{}, fmt.Errorf("%s: %w", err.Error(), ErrUnknown)
+		}
+	}
+
+	return req, nil
+}
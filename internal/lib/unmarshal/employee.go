@@ -0,0 +1,49 @@
+package unmarshal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	schema "github.com/sariya23/tender/internal/hanlders"
+)
+
+func CreateEmployeeRequest(body []byte) (schema.CreateEmployeeRequest, error) {
+	var req schema.CreateEmployeeRequest
+	err := json.Unmarshal(body, &req)
+
+	if err != nil {
+		var syntaxErr *json.SyntaxError
+		var typeErr *json.UnmarshalTypeError
+
+		if errors.As(err, &syntaxErr) {
+			return schema.CreateEmployeeRequest{}, fmt.Errorf("%s: %w", err.Error(), ErrSyntax)
+		} else if errors.As(err, &typeErr) {
+			return schema.CreateEmployeeRequest{}, fmt.Errorf("%s: %w", err.Error(), ErrType)
+		} else {
+			return schema.CreateEmployeeRequest{}, fmt.Errorf("%s: %w", err.Error(), ErrUnknown)
+		}
+	}
+
+	return req, nil
+}
+
+func EditEmployeeRequest(body []byte) (schema.EditEmployeeRequest, error) {
+	var req schema.EditEmployeeRequest
+	err := json.Unmarshal(body, &req)
+
+	if err != nil {
+		var syntaxErr *json.SyntaxError
+		var typeErr *json.UnmarshalTypeError
+
+		if errors.As(err, &syntaxErr) {
+			return schema.EditEmployeeRequest{}, fmt.Errorf("%s: %w", err.Error(), ErrSyntax)
+		} else if errors.As(err, &typeErr) {
+			return schema.EditEmployeeRequest{}, fmt.Errorf("%s: %w", err.Error(), ErrType)
+		} else {
+			return schema.EditEmployeeRequest{}, fmt.Errorf("%s: %w", err.Error(), ErrUnknown)
+		}
+	}
+
+	return req, nil
+}
@@ -0,0 +1,69 @@
+package unmarshal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	schema "github.com/sariya23/tender/internal/hanlders"
+)
+
+func CreateOrganizationRequest(body []byte) (schema.CreateOrganizationRequest, error) {
+	var req schema.CreateOrganizationRequest
+	err := json.Unmarshal(body, &req)
+
+	if err != nil {
+		var syntaxErr *json.SyntaxError
+		var typeErr *json.UnmarshalTypeError
+
+		if errors.As(err, &syntaxErr) {
+			return schema.CreateOrganizationRequest{}, fmt.Errorf("%s: %w", err.Error(), ErrSyntax)
+		} else if errors.As(err, &typeErr) {
+			return schema.CreateOrganizationRequest{}, fmt.Errorf("%s: %w", err.Error(), ErrType)
+		} else {
+			return schema.CreateOrganizationRequest{}, fmt.Errorf("%s: %w", err.Error(), ErrUnknown)
+		}
+	}
+
+	return req, nil
+}
+
+func EditOrganizationRequest(body []byte) (schema.EditOrganizationRequest, error) {
+	var req schema.EditOrganizationRequest
+	err := json.Unmarshal(body, &req)
+
+	if err != nil {
+		var syntaxErr *json.SyntaxError
+		var typeErr *json.UnmarshalTypeError
+
+		if errors.As(err, &syntaxErr) {
+			return schema.EditOrganizationRequest{}, fmt.Errorf("%s: %w", err.Error(), ErrSyntax)
+		} else if errors.As(err, &typeErr) {
+			return schema.EditOrganizationRequest{}, fmt.Errorf("%s: %w", err.Error(), ErrType)
+		} else {
+			return schema.EditOrganizationRequest{}, fmt.Errorf("%s: %w", err.Error(), ErrUnknown)
+		}
+	}
+
+	return req, nil
+}
+
+func GrantResponsibilityRequest(body []byte) (schema.GrantResponsibilityRequest, error) {
+	var req schema.GrantResponsibilityRequest
+	err := json.Unmarshal(body, &req)
+
+	if err != nil {
+		var syntaxErr *json.SyntaxError
+		var typeErr *json.UnmarshalTypeError
+
+		if errors.As(err, &syntaxErr) {
+			return schema.GrantResponsibilityRequest{}, fmt.Errorf("%s: %w", err.Error(), ErrSyntax)
+		} else if errors.As(err, &typeErr) {
+			return schema.GrantResponsibilityRequest{}, fmt.Errorf("%s: %w", err.Error(), ErrType)
+		} else {
+			return schema.GrantResponsibilityRequest{}, fmt.Errorf("%s: %w", err.Error(), ErrUnknown)
+		}
+	}
+
+	return req, nil
+}
@@ -0,0 +1,49 @@
+package unmarshal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	schema "github.com/sariya23/tender/internal/hanlders"
+)
+
+func CreateServiceTypeRequest(body []byte) (schema.CreateServiceTypeRequest, error) {
+	var req schema.CreateServiceTypeRequest
+	err := json.Unmarshal(body, &req)
+
+	if err != nil {
+		var syntaxErr *json.SyntaxError
+		var typeErr *json.UnmarshalTypeError
+
+		if errors.As(err, &syntaxErr) {
+			return schema.CreateServiceTypeRequest{}, fmt.Errorf("%s: %w", err.Error(), ErrSyntax)
+		} else if errors.As(err, &typeErr) {
+			return schema.CreateServiceTypeRequest{}, fmt.Errorf("%s: %w", err.Error(), ErrType)
+		} else {
+			return schema.CreateServiceTypeRequest{}, fmt.Errorf("%s: %w", err.Error(), ErrUnknown)
+		}
+	}
+
+	return req, nil
+}
+
+func EditServiceTypeRequest(body []byte) (schema.EditServiceTypeRequest, error) {
+	var req schema.EditServiceTypeRequest
+	err := json.Unmarshal(body, &req)
+
+	if err != nil {
+		var syntaxErr *json.SyntaxError
+		var typeErr *json.UnmarshalTypeError
+
+		if errors.As(err, &syntaxErr) {
+			return schema.EditServiceTypeRequest{}, fmt.Errorf("%s: %w", err.Error(), ErrSyntax)
+		} else if errors.As(err, &typeErr) {
+			return schema.EditServiceTypeRequest{}, fmt.Errorf("%s: %w", err.Error(), ErrType)
+		} else {
+			return schema.EditServiceTypeRequest{}, fmt.Errorf("%s: %w", err.Error(), ErrUnknown)
+		}
+	}
+
+	return req, nil
+}
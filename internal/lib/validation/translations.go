@@ -0,0 +1,87 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	en_translations "github.com/go-playground/validator/v10/translations/en"
+	ru_translations "github.com/go-playground/validator/v10/translations/ru"
+	"github.com/sariya23/tender/internal/lib/i18n"
+)
+
+// messages сообщения об ошибках полей. Имя поля в сообщение не
+// попадает, потому что поле указывается в pointer.
+var messages = map[string]i18n.Catalog{
+	i18n.English: {
+		"validation.required":   "value is required",
+		"validation.min":        "must be at least {0}",
+		"validation.min_string": "must be at least {0} characters long",
+		"validation.max":        "must be at most {0}",
+		"validation.max_string": "must be at most {0} characters long",
+		"validation.gt":         "must be greater than {0}",
+		"validation.gte":        "must be greater than or equal to {0}",
+		"validation.oneof":      "must be one of {0}",
+	},
+	i18n.Russian: {
+		"validation.required":   "обязательное поле",
+		"validation.min":        "должно быть не меньше {0}",
+		"validation.min_string": "длина должна быть не меньше {0}",
+		"validation.max":        "должно быть не больше {0}",
+		"validation.max_string": "длина должна быть не больше {0}",
+		"validation.gt":         "должно быть больше {0}",
+		"validation.gte":        "должно быть не меньше {0}",
+		"validation.oneof":      "должно быть одним из: {0}",
+	},
+}
+
+// registerTranslations регистрирует в валидаторе сообщения для языка
+// переводчика translator. Для правил, которых нет в messages,
+// используются стандартные переводы validator.
+func registerTranslations(validate *validator.Validate, translator ut.Translator) error {
+	var err error
+	switch translator.Locale() {
+	case i18n.Russian:
+		err = ru_translations.RegisterDefaultTranslations(validate, translator)
+	default:
+		err = en_translations.RegisterDefaultTranslations(validate, translator)
+	}
+	if err != nil {
+		return fmt.Errorf("cannot register default translations: %w", err)
+	}
+
+	catalog, ok := messages[translator.Locale()]
+	if !ok {
+		return fmt.Errorf("no validation messages for locale %s", translator.Locale())
+	}
+	for key, text := range catalog {
+		if err := translator.Add(key, text, false); err != nil {
+			return fmt.Errorf("cannot add message %s: %w", key, err)
+		}
+	}
+
+	for _, tag := range []string{"required", "min", "max", "gt", "gte", "oneof"} {
+		err := validate.RegisterTranslation(tag, translator, func(ut.Translator) error { return nil }, translate)
+		if err != nil {
+			return fmt.Errorf("cannot register %s translation: %w", tag, err)
+		}
+	}
+	return nil
+}
+
+// translate возвращает сообщение об ошибке поля.
+func translate(translator ut.Translator, fieldErr validator.FieldError) string {
+	key := "validation." + fieldErr.Tag()
+	param := fieldErr.Param()
+	switch fieldErr.Tag() {
+	case "min", "max":
+		if fieldErr.Kind() == reflect.String {
+			key += "_string"
+		}
+	case "oneof":
+		param = strings.ReplaceAll(param, " ", ", ")
+	}
+	return i18n.T(translator, key, param)
+}
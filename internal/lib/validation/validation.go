@@ -0,0 +1,71 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	"github.com/sariya23/tender/internal/lib/i18n"
+	"github.com/sariya23/tender/internal/lib/problem"
+)
+
+// New создает валидатор запросов. Валидатор кеширует разбор
+// структур, поэтому его создают один раз и переиспользуют.
+//
+// Поля в ошибках называются по json тегам. Сообщения об ошибках
+// полей регистрируются в переводах translations.
+func New(translations *i18n.Translations) *validator.Validate {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	validate.RegisterTagNameFunc(jsonFieldName)
+	err := translations.Each(func(translator ut.Translator) error {
+		return registerTranslations(validate, translator)
+	})
+	if err != nil {
+		panic(fmt.Sprintf("cannot register validation translations: %v", err))
+	}
+	return validate
+}
+
+// jsonFieldName возвращает имя поля из json тега.
+func jsonFieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "-" {
+		return ""
+	}
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
+
+// FieldErrors переводит ошибки валидатора в ошибки полей. Поле
+// указывается JSON pointer'ом от корня тела запроса, сообщение
+// переводится переводчиком translator.
+func FieldErrors(err error, translator ut.Translator) []problem.FieldError {
+	var validationErrs validator.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		return nil
+	}
+	fields := make([]problem.FieldError, 0, len(validationErrs))
+	for _, fieldErr := range validationErrs {
+		fields = append(fields, problem.FieldError{
+			Pointer: pointer(fieldErr.Namespace()),
+			Rule:    fieldErr.Tag(),
+			Message: fieldErr.Translate(translator),
+		})
+	}
+	return fields
+}
+
+// pointer переводит путь до поля вида Request.tender.name в
+// JSON pointer /tender/name. Имя корневой структуры отбрасывается.
+func pointer(namespace string) string {
+	_, path, found := strings.Cut(namespace, ".")
+	if !found {
+		return ""
+	}
+	return "/" + strings.ReplaceAll(path, ".", "/")
+}
@@ -0,0 +1,104 @@
+package validation_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/sariya23/tender/internal/domain/models"
+	schema "github.com/sariya23/tender/internal/hanlders"
+	"github.com/sariya23/tender/internal/lib/i18n"
+	"github.com/sariya23/tender/internal/lib/problem"
+	"github.com/sariya23/tender/internal/lib/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newValidator создает валидатор и переводы без сообщений хендлеров.
+func newValidator() (*validator.Validate, *i18n.Translations) {
+	translations := i18n.MustNew(map[string]i18n.Catalog{i18n.English: {}, i18n.Russian: {}})
+	return validation.New(translations), translations
+}
+
+// TestFieldErrors_CreateRequest проверяет, что ошибки полей
+// запроса на создание тендера указывают на поле JSON pointer'ом.
+func TestFieldErrors_CreateRequest(t *testing.T) {
+	req := schema.CreateTenderRequest{Tender: models.Tender{
+		TenderName:      strings.Repeat("я", 101),
+		ServiceType:     strings.Repeat("я", 51),
+		Status:          models.TenderCreatedStatus,
+		OrganizationId:  -1,
+		CreatorUsername: "qwe",
+	}}
+	validate, translations := newValidator()
+
+	err := validate.Struct(&req)
+
+	require.Error(t, err)
+	assert.Equal(t, []problem.FieldError{
+		{Pointer: "/tender/name", Rule: "max", Message: "must be at most 100 characters long"},
+		{Pointer: "/tender/description", Rule: "required", Message: "value is required"},
+		{Pointer: "/tender/service_type", Rule: "max", Message: "must be at most 50 characters long"},
+		{Pointer: "/tender/organization_id", Rule: "gte", Message: "must be greater than or equal to 0"},
+	}, validation.FieldErrors(err, translations.Translator("")))
+}
+
+// TestFieldErrors_EditRequest проверяет, что в запросе на
+// редактирование проверяются только переданные поля.
+func TestFieldErrors_EditRequest(t *testing.T) {
+	empty := ""
+	serviceType := "Delivery"
+	req := schema.EditTenderRequest{
+		UpdateTenderData: models.TenderToUpdate{Description: &empty, ServiceType: &serviceType},
+		Username:         "qwe",
+	}
+	validate, translations := newValidator()
+
+	err := validate.Struct(&req)
+
+	require.Error(t, err)
+	assert.Equal(t, []problem.FieldError{
+		{Pointer: "/update_tender_data/description", Rule: "min", Message: "must be at least 1 characters long"},
+	}, validation.FieldErrors(err, translations.Translator("en-US")))
+}
+
+// TestFieldErrors_Valid проверяет, что валидный запрос проходит
+// проверку, а для ошибки не от валидатора ошибок полей нет.
+func TestFieldErrors_Valid(t *testing.T) {
+	req := schema.CreateTenderRequest{Tender: models.Tender{
+		TenderName:      "Тендер 1",
+		Description:     "Первый тендер",
+		ServiceType:     "Construction",
+		Status:          models.TenderCreatedStatus,
+		OrganizationId:  1,
+		CreatorUsername: "qwe",
+	}}
+	validate, translations := newValidator()
+
+	err := validate.Struct(&req)
+
+	require.NoError(t, err)
+	assert.Nil(t, validation.FieldErrors(err, translations.Translator("")))
+}
+
+// TestFieldErrors_Russian проверяет, что сообщения переводятся
+// на язык из Accept-Language.
+func TestFieldErrors_Russian(t *testing.T) {
+	req := schema.CreateTenderRequest{Tender: models.Tender{
+		TenderName:      "Тендер 1",
+		Description:     strings.Repeat("я", 501),
+		ServiceType:     "",
+		Status:          models.TenderCreatedStatus,
+		OrganizationId:  1,
+		CreatorUsername: "qwe",
+	}}
+	validate, translations := newValidator()
+
+	err := validate.Struct(&req)
+
+	require.Error(t, err)
+	assert.Equal(t, []problem.FieldError{
+		{Pointer: "/tender/description", Rule: "max", Message: "длина должна быть не больше 500"},
+		{Pointer: "/tender/service_type", Rule: "required", Message: "обязательное поле"},
+	}, validation.FieldErrors(err, translations.Translator("ru-RU,ru;q=0.9,en;q=0.8")))
+}
@@ -0,0 +1,239 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/lib/jwt"
+	"github.com/sariya23/tender/internal/lib/keygen"
+	"github.com/sariya23/tender/internal/lib/problem"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/sariya23/tender/internal/repository"
+)
+
+var (
+	ErrNotAuthenticated   = errors.New("authentication required")
+	ErrUsernameMismatch   = errors.New("username does not match authenticated employee")
+	ErrInvalidToken       = errors.New("invalid token")
+	ErrInvalidAPIKey      = errors.New("invalid api key")
+	ErrAPIKeyScopeMissing = errors.New("api key has no required scope")
+	ErrAPIKeyNotAllowed   = errors.New("api key cannot be used for this endpoint")
+)
+
+// APIKeyHeader заголовок, в котором интеграции передают API ключ.
+const APIKeyHeader = "X-API-Key"
+
+type employeeContextKey struct{}
+
+type apiKeyContextKey struct{}
+
+// ContextWithEmployee возвращает контекст с аутентифицированным сотрудником.
+func ContextWithEmployee(ctx context.Context, employee models.Employee) context.Context {
+	return context.WithValue(ctx, employeeContextKey{}, employee)
+}
+
+// EmployeeFromContext возвращает сотрудника, которого положил в контекст Authenticator.
+func EmployeeFromContext(ctx context.Context) (models.Employee, bool) {
+	employee, ok := ctx.Value(employeeContextKey{}).(models.Employee)
+	return employee, ok
+}
+
+// ContextWithAPIKey возвращает контекст с API ключом, которым аутентифицирован запрос.
+func ContextWithAPIKey(ctx context.Context, apiKey models.APIKey) context.Context {
+	return context.WithValue(ctx, apiKeyContextKey{}, apiKey)
+}
+
+// APIKeyFromContext возвращает API ключ, если запрос аутентифицирован им, а не токеном.
+func APIKeyFromContext(ctx context.Context) (models.APIKey, bool) {
+	apiKey, ok := ctx.Value(apiKeyContextKey{}).(models.APIKey)
+	return apiKey, ok
+}
+
+// ActingUsername возвращает username сотрудника, от имени которого выполняется запрос.
+//
+// claimedUsername - username, переданный в теле или query запроса. Если он
+// указан, то должен совпадать с аутентифицированным сотрудником.
+func ActingUsername(ginContext *gin.Context, claimedUsername string) (string, error) {
+	employee, ok := EmployeeFromContext(ginContext.Request.Context())
+	if !ok {
+		return "", ErrNotAuthenticated
+	}
+	if claimedUsername != "" && claimedUsername != employee.Username {
+		return "", ErrUsernameMismatch
+	}
+	return employee.Username, nil
+}
+
+// AuthErrorStatus возвращает код ответа для ошибки ActingUsername.
+func AuthErrorStatus(err error) int {
+	if errors.Is(err, ErrUsernameMismatch) {
+		return http.StatusForbidden
+	}
+	return http.StatusUnauthorized
+}
+
+type TokenVerifier interface {
+	Verify(token string) (jwt.Claims, error)
+}
+
+// Authenticator проверяет bearer-токен из заголовка Authorization
+// и кладет сотрудника, username которого указан в sub, в контекст запроса.
+// Ошибки передаются в ginContext.Error и отдаются middleware Problems.
+//
+// Вместо токена можно передать API ключ в заголовке X-API-Key. Тогда
+// в контекст попадает владелец ключа, а права ключа проверяет RequireScope.
+type Authenticator struct {
+	logger       *slog.Logger
+	verifier     TokenVerifier
+	employeeRepo repository.EmployeeRepository
+	apiKeyRepo   repository.APIKeyRepository
+}
+
+func NewAuthenticator(
+	logger *slog.Logger,
+	verifier TokenVerifier,
+	employeeRepo repository.EmployeeRepository,
+	apiKeyRepo repository.APIKeyRepository,
+) *Authenticator {
+	return &Authenticator{
+		logger:       logger,
+		verifier:     verifier,
+		employeeRepo: employeeRepo,
+		apiKeyRepo:   apiKeyRepo,
+	}
+}
+
+// Required пропускает только запросы с валидным токеном.
+func (auth *Authenticator) Required() gin.HandlerFunc {
+	return auth.authenticate(true)
+}
+
+// Optional пропускает запросы без заголовков Authorization и X-API-Key
+// анонимно, но отклоняет запросы с невалидным токеном или ключом.
+func (auth *Authenticator) Optional() gin.HandlerFunc {
+	return auth.authenticate(false)
+}
+
+func (auth *Authenticator) authenticate(required bool) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		const operationPlace = "internal.middleware.auth.authenticate"
+		logger := auth.logger.With("op", operationPlace)
+
+		if key := ginContext.GetHeader(APIKeyHeader); key != "" {
+			auth.authenticateAPIKey(ginContext, logger, key)
+			return
+		}
+
+		header := ginContext.GetHeader("Authorization")
+		if header == "" {
+			if required {
+				logger.Warn("authorization header not specified")
+				abortUnauthorized(ginContext, problem.New(ErrNotAuthenticated, "authorization header required"))
+				return
+			}
+			ginContext.Next()
+			return
+		}
+
+		token, ok := strings.CutPrefix(header, "Bearer ")
+		if !ok || token == "" {
+			logger.Warn("authorization header is not bearer token")
+			abortUnauthorized(ginContext, problem.New(ErrInvalidToken, "authorization header must be Bearer token"))
+			return
+		}
+		claims, err := auth.verifier.Verify(token)
+		if err != nil {
+			logger.Warn("invalid token", slog.String("err", err.Error()))
+			abortUnauthorized(ginContext, problem.New(ErrInvalidToken, err.Error()))
+			return
+		}
+
+		employee, err := auth.employeeRepo.GetEmployeeByUsername(ginContext.Request.Context(), claims.Subject)
+		if err != nil {
+			if errors.Is(err, outerror.ErrEmployeeNotFound) {
+				logger.Warn("token subject not found", slog.String("username", claims.Subject))
+				abortUnauthorized(ginContext, problem.New(ErrInvalidToken, "employee not found"))
+				return
+			}
+			logger.Error("cannot get employee", slog.String("err", err.Error()))
+			_ = ginContext.Error(fmt.Errorf("cannot get employee: %w", err))
+			ginContext.Abort()
+			return
+		}
+
+		ginContext.Request = ginContext.Request.WithContext(ContextWithEmployee(ginContext.Request.Context(), employee))
+		ginContext.Next()
+	}
+}
+
+func (auth *Authenticator) authenticateAPIKey(ginContext *gin.Context, logger *slog.Logger, key string) {
+	apiKey, err := auth.apiKeyRepo.GetAPIKeyByHash(ginContext.Request.Context(), keygen.Hash(key))
+	if err != nil {
+		if errors.Is(err, outerror.ErrAPIKeyNotFound) {
+			logger.Warn("api key not found or revoked")
+			abortUnauthorized(ginContext, ErrInvalidAPIKey)
+			return
+		}
+		logger.Error("cannot get api key", slog.String("err", err.Error()))
+		_ = ginContext.Error(fmt.Errorf("cannot get api key: %w", err))
+		ginContext.Abort()
+		return
+	}
+
+	employee, err := auth.employeeRepo.GetEmployeeById(ginContext.Request.Context(), apiKey.EmployeeId)
+	if err != nil {
+		if errors.Is(err, outerror.ErrEmployeeNotFound) {
+			logger.Warn("api key owner not found", slog.Int("api key id", apiKey.ID))
+			abortUnauthorized(ginContext, ErrInvalidAPIKey)
+			return
+		}
+		logger.Error("cannot get employee", slog.String("err", err.Error()))
+		_ = ginContext.Error(fmt.Errorf("cannot get employee: %w", err))
+		ginContext.Abort()
+		return
+	}
+
+	ctx := ContextWithAPIKey(ContextWithEmployee(ginContext.Request.Context(), employee), apiKey)
+	ginContext.Request = ginContext.Request.WithContext(ctx)
+	ginContext.Next()
+}
+
+// RequireScope проверяет, что у API ключа запроса есть право scope.
+// Запросы с токеном и анонимные запросы пропускаются без проверки,
+// поэтому middleware ставится после Required или Optional.
+func (auth *Authenticator) RequireScope(scope string) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		apiKey, ok := APIKeyFromContext(ginContext.Request.Context())
+		if ok && !apiKey.HasScope(scope) {
+			auth.logger.Warn("api key has no scope", slog.Int("api key id", apiKey.ID), slog.String("scope", scope))
+			_ = ginContext.Error(problem.Newf(ErrAPIKeyScopeMissing, "api key has no scope %s", scope))
+			ginContext.Abort()
+			return
+		}
+		ginContext.Next()
+	}
+}
+
+// RejectAPIKey отклоняет запросы, аутентифицированные API ключом.
+func (auth *Authenticator) RejectAPIKey() gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		if _, ok := APIKeyFromContext(ginContext.Request.Context()); ok {
+			_ = ginContext.Error(ErrAPIKeyNotAllowed)
+			ginContext.Abort()
+			return
+		}
+		ginContext.Next()
+	}
+}
+
+func abortUnauthorized(ginContext *gin.Context, err error) {
+	ginContext.Header("WWW-Authenticate", "Bearer")
+	_ = ginContext.Error(err)
+	ginContext.Abort()
+}
@@ -0,0 +1,58 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/lib/jwt"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockTokenVerifier реализует интерфейс TokenVerifier
+// для целей тестирования. Он позволяет задавать ожидаемые результаты
+// методов:
+//
+// - Verify
+type MockTokenVerifier struct {
+	mock.Mock
+}
+
+func (m *MockTokenVerifier) Verify(token string) (jwt.Claims, error) {
+	args := m.Called(token)
+	return args.Get(0).(jwt.Claims), args.Error(1)
+}
+
+// MockEmployeeRepository реализует интерфейс EmployeeRepository
+// для целей тестирования. Он позволяет задавать ожидаемые результаты
+// методов:
+//
+// - GetEmployeeByUsername
+//
+// - GetEmployeeById
+type MockEmployeeRepository struct {
+	mock.Mock
+}
+
+func (m *MockEmployeeRepository) GetEmployeeByUsername(ctx context.Context, username string) (models.Employee, error) {
+	args := m.Called(ctx, username)
+	return args.Get(0).(models.Employee), args.Error(1)
+}
+
+func (m *MockEmployeeRepository) GetEmployeeById(ctx context.Context, id int) (models.Employee, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(models.Employee), args.Error(1)
+}
+
+// MockAPIKeyRepository реализует интерфейс APIKeyRepository
+// для целей тестирования. Он позволяет задавать ожидаемые результаты
+// методов:
+//
+// - GetAPIKeyByHash
+type MockAPIKeyRepository struct {
+	mock.Mock
+}
+
+func (m *MockAPIKeyRepository) GetAPIKeyByHash(ctx context.Context, keyHash string) (models.APIKey, error) {
+	args := m.Called(ctx, keyHash)
+	return args.Get(0).(models.APIKey), args.Error(1)
+}
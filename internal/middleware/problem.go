@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sariya23/tender/internal/lib/problem"
+)
+
+// authProblems описания ошибок Authenticator и ActingUsername.
+var authProblems = problem.Catalog{
+	{Err: ErrNotAuthenticated, Entry: problem.Entry{Code: "not_authenticated", Status: http.StatusUnauthorized, Title: "Authentication required"}},
+	{Err: ErrInvalidToken, Entry: problem.Entry{Code: "invalid_token", Status: http.StatusUnauthorized, Title: "Invalid token"}},
+	{Err: ErrInvalidAPIKey, Entry: problem.Entry{Code: "invalid_api_key", Status: http.StatusUnauthorized, Title: "Invalid API key"}},
+	{Err: ErrUsernameMismatch, Entry: problem.Entry{Code: "username_mismatch", Status: http.StatusForbidden, Title: "Username does not match authenticated employee"}},
+	{Err: ErrAPIKeyScopeMissing, Entry: problem.Entry{Code: "insufficient_scope", Status: http.StatusForbidden, Title: "API key has no required scope"}},
+	{Err: ErrAPIKeyNotAllowed, Entry: problem.Entry{Code: "api_key_not_allowed", Status: http.StatusForbidden, Title: "API key cannot be used for this endpoint"}},
+}
+
+// Problems отдает ошибку, которую хендлер передал в ginContext.Error,
+// как application/problem+json. Код, статус и заголовок ответа берутся
+// из каталога catalog, ошибки аутентификации описаны в самом middleware.
+//
+// Если хендлер уже записал ответ, то middleware ничего не делает.
+func Problems(logger *slog.Logger, catalog problem.Catalog) gin.HandlerFunc {
+	catalog = append(append(problem.Catalog{}, authProblems...), catalog...)
+	return func(ginContext *gin.Context) {
+		ginContext.Next()
+
+		if len(ginContext.Errors) == 0 || ginContext.Writer.Written() {
+			return
+		}
+		err := ginContext.Errors.Last().Err
+		details := problem.Build(catalog, err, ginContext.Request.URL.Path)
+		if details.Status >= http.StatusInternalServerError {
+			logger.Error("unexpected error", slog.String("path", details.Instance), slog.String("err", err.Error()))
+		}
+
+		ginContext.Header("Content-Type", problem.ContentType)
+		ginContext.JSON(details.Status, details)
+	}
+}
@@ -0,0 +1,374 @@
+package middleware_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/lib/jwt"
+	"github.com/sariya23/tender/internal/lib/keygen"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	"github.com/sariya23/tender/internal/lib/problem"
+	"github.com/sariya23/tender/internal/middleware"
+	"github.com/sariya23/tender/internal/middleware/mocks"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newRouter создает роутер с middleware и обработчиком, который
+// возвращает username аутентифицированного сотрудника. Ошибки
+// отдает middleware Problems, как в приложении.
+func newRouter(handlers ...gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(middleware.Problems(slogdiscard.NewDiscardLogger(), outerror.Catalog))
+	router.GET("/api/me", append(handlers, func(ginContext *gin.Context) {
+		employee, ok := middleware.EmployeeFromContext(ginContext.Request.Context())
+		if !ok {
+			ginContext.JSON(http.StatusOK, gin.H{"username": nil})
+			return
+		}
+		ginContext.JSON(http.StatusOK, gin.H{"username": employee.Username})
+	})...)
+	return router
+}
+
+// requireAuthProblem проверяет, что ответ - ошибка с кодом code и пояснением detail.
+func requireAuthProblem(t *testing.T, w *httptest.ResponseRecorder, status int, code string, detail string) {
+	t.Helper()
+	assert.Equal(t, status, w.Code)
+	assert.Equal(t, problem.ContentType, w.Header().Get("Content-Type"))
+	var details problem.Details
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &details))
+	assert.Equal(t, code, details.Code)
+	assert.Equal(t, detail, details.Detail)
+}
+
+// TestRequired_Success проверяет, что при валидном токене
+// сотрудник из sub кладется в контекст запроса.
+func TestRequired_Success(t *testing.T) {
+	// Arrange
+	mockVerifier := new(mocks.MockTokenVerifier)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepository)
+	auth := middleware.NewAuthenticator(slogdiscard.NewDiscardLogger(), mockVerifier, mockEmployeeRepo, new(mocks.MockAPIKeyRepository))
+	router := newRouter(auth.Required())
+	mockVerifier.On("Verify", "token").Return(jwt.Claims{Subject: "qwe"}, nil)
+	mockEmployeeRepo.On("GetEmployeeByUsername", mock.Anything, "qwe").Return(models.Employee{ID: 1, Username: "qwe"}, nil)
+	req := httptest.NewRequest(http.MethodGet, "/api/me", nil)
+	req.Header.Set("Authorization", "Bearer token")
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"username": "qwe"}`, w.Body.String())
+}
+
+// TestRequired_FailNoHeader проверяет, что без заголовка
+// Authorization возвращается код 401.
+func TestRequired_FailNoHeader(t *testing.T) {
+	// Arrange
+	mockVerifier := new(mocks.MockTokenVerifier)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepository)
+	auth := middleware.NewAuthenticator(slogdiscard.NewDiscardLogger(), mockVerifier, mockEmployeeRepo, new(mocks.MockAPIKeyRepository))
+	router := newRouter(auth.Required())
+	req := httptest.NewRequest(http.MethodGet, "/api/me", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	requireAuthProblem(t, w, http.StatusUnauthorized, "not_authenticated", "authorization header required")
+	assert.Equal(t, "Bearer", w.Header().Get("WWW-Authenticate"))
+	mockVerifier.AssertNotCalled(t, "Verify")
+}
+
+// TestRequired_FailNotBearer проверяет, что если в заголовке
+// Authorization не bearer-токен, то возвращается код 401.
+func TestRequired_FailNotBearer(t *testing.T) {
+	// Arrange
+	mockVerifier := new(mocks.MockTokenVerifier)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepository)
+	auth := middleware.NewAuthenticator(slogdiscard.NewDiscardLogger(), mockVerifier, mockEmployeeRepo, new(mocks.MockAPIKeyRepository))
+	router := newRouter(auth.Required())
+	req := httptest.NewRequest(http.MethodGet, "/api/me", nil)
+	req.Header.Set("Authorization", "Basic cXdlOnF3ZQ==")
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	requireAuthProblem(t, w, http.StatusUnauthorized, "invalid_token", "authorization header must be Bearer token")
+	mockVerifier.AssertNotCalled(t, "Verify")
+}
+
+// TestRequired_FailInvalidToken проверяет, что если токен
+// не прошел проверку, то возвращается код 401.
+func TestRequired_FailInvalidToken(t *testing.T) {
+	// Arrange
+	mockVerifier := new(mocks.MockTokenVerifier)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepository)
+	auth := middleware.NewAuthenticator(slogdiscard.NewDiscardLogger(), mockVerifier, mockEmployeeRepo, new(mocks.MockAPIKeyRepository))
+	router := newRouter(auth.Required())
+	mockVerifier.On("Verify", "token").Return(jwt.Claims{}, jwt.ErrTokenExpired)
+	req := httptest.NewRequest(http.MethodGet, "/api/me", nil)
+	req.Header.Set("Authorization", "Bearer token")
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	requireAuthProblem(t, w, http.StatusUnauthorized, "invalid_token", jwt.ErrTokenExpired.Error())
+	mockEmployeeRepo.AssertNotCalled(t, "GetEmployeeByUsername")
+}
+
+// TestRequired_FailEmployeeNotFound проверяет, что если сотрудника
+// из sub нет, то возвращается код 401.
+func TestRequired_FailEmployeeNotFound(t *testing.T) {
+	// Arrange
+	mockVerifier := new(mocks.MockTokenVerifier)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepository)
+	auth := middleware.NewAuthenticator(slogdiscard.NewDiscardLogger(), mockVerifier, mockEmployeeRepo, new(mocks.MockAPIKeyRepository))
+	router := newRouter(auth.Required())
+	mockVerifier.On("Verify", "token").Return(jwt.Claims{Subject: "qwe"}, nil)
+	mockEmployeeRepo.On("GetEmployeeByUsername", mock.Anything, "qwe").Return(models.Employee{}, outerror.ErrEmployeeNotFound)
+	req := httptest.NewRequest(http.MethodGet, "/api/me", nil)
+	req.Header.Set("Authorization", "Bearer token")
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	requireAuthProblem(t, w, http.StatusUnauthorized, "invalid_token", "employee not found")
+}
+
+// TestRequired_FailUnexpectedError проверяет, что при неожиданной
+// ошибке репозитория возвращается код 500.
+func TestRequired_FailUnexpectedError(t *testing.T) {
+	// Arrange
+	mockVerifier := new(mocks.MockTokenVerifier)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepository)
+	auth := middleware.NewAuthenticator(slogdiscard.NewDiscardLogger(), mockVerifier, mockEmployeeRepo, new(mocks.MockAPIKeyRepository))
+	router := newRouter(auth.Required())
+	mockVerifier.On("Verify", "token").Return(jwt.Claims{Subject: "qwe"}, nil)
+	mockEmployeeRepo.On("GetEmployeeByUsername", mock.Anything, "qwe").Return(models.Employee{}, errors.New("some error"))
+	req := httptest.NewRequest(http.MethodGet, "/api/me", nil)
+	req.Header.Set("Authorization", "Bearer token")
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	requireAuthProblem(t, w, http.StatusInternalServerError, "internal_error", "")
+}
+
+// TestOptional_NoHeader проверяет, что без заголовка Authorization
+// запрос проходит анонимно.
+func TestOptional_NoHeader(t *testing.T) {
+	// Arrange
+	mockVerifier := new(mocks.MockTokenVerifier)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepository)
+	auth := middleware.NewAuthenticator(slogdiscard.NewDiscardLogger(), mockVerifier, mockEmployeeRepo, new(mocks.MockAPIKeyRepository))
+	router := newRouter(auth.Optional())
+	req := httptest.NewRequest(http.MethodGet, "/api/me", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"username": null}`, w.Body.String())
+}
+
+// TestOptional_FailInvalidToken проверяет, что невалидный токен
+// отклоняется, даже если аутентификация необязательна.
+func TestOptional_FailInvalidToken(t *testing.T) {
+	// Arrange
+	mockVerifier := new(mocks.MockTokenVerifier)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepository)
+	auth := middleware.NewAuthenticator(slogdiscard.NewDiscardLogger(), mockVerifier, mockEmployeeRepo, new(mocks.MockAPIKeyRepository))
+	router := newRouter(auth.Optional())
+	mockVerifier.On("Verify", "token").Return(jwt.Claims{}, jwt.ErrInvalidSignature)
+	req := httptest.NewRequest(http.MethodGet, "/api/me", nil)
+	req.Header.Set("Authorization", "Bearer token")
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+// TestRequired_APIKeySuccess проверяет, что по действующему API ключу
+// в контекст кладется владелец ключа.
+func TestRequired_APIKeySuccess(t *testing.T) {
+	// Arrange
+	mockVerifier := new(mocks.MockTokenVerifier)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepository)
+	mockAPIKeyRepo := new(mocks.MockAPIKeyRepository)
+	auth := middleware.NewAuthenticator(slogdiscard.NewDiscardLogger(), mockVerifier, mockEmployeeRepo, mockAPIKeyRepo)
+	router := newRouter(auth.Required())
+	mockAPIKeyRepo.On("GetAPIKeyByHash", mock.Anything, keygen.Hash("tnd_key")).
+		Return(models.APIKey{ID: 1, EmployeeId: 2, Scopes: []string{models.ScopeTendersRead}}, nil)
+	mockEmployeeRepo.On("GetEmployeeById", mock.Anything, 2).Return(models.Employee{ID: 2, Username: "erp"}, nil)
+	req := httptest.NewRequest(http.MethodGet, "/api/me", nil)
+	req.Header.Set(middleware.APIKeyHeader, "tnd_key")
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"username": "erp"}`, w.Body.String())
+	mockVerifier.AssertNotCalled(t, "Verify")
+}
+
+// TestRequired_FailUnknownAPIKey проверяет, что если ключа нет
+// или он отозван, то возвращается код 401.
+func TestRequired_FailUnknownAPIKey(t *testing.T) {
+	// Arrange
+	mockVerifier := new(mocks.MockTokenVerifier)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepository)
+	mockAPIKeyRepo := new(mocks.MockAPIKeyRepository)
+	auth := middleware.NewAuthenticator(slogdiscard.NewDiscardLogger(), mockVerifier, mockEmployeeRepo, mockAPIKeyRepo)
+	router := newRouter(auth.Required())
+	mockAPIKeyRepo.On("GetAPIKeyByHash", mock.Anything, keygen.Hash("tnd_key")).Return(models.APIKey{}, outerror.ErrAPIKeyNotFound)
+	req := httptest.NewRequest(http.MethodGet, "/api/me", nil)
+	req.Header.Set(middleware.APIKeyHeader, "tnd_key")
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	requireAuthProblem(t, w, http.StatusUnauthorized, "invalid_api_key", "invalid api key")
+	mockEmployeeRepo.AssertNotCalled(t, "GetEmployeeById")
+}
+
+// TestRequireScope проверяет права API ключа на эндпоинт.
+func TestRequireScope(t *testing.T) {
+	cases := []struct {
+		name           string
+		scopes         []string
+		useToken       bool
+		expectedStatus int
+	}{
+		{name: "api key with scope", scopes: []string{models.ScopeTendersRead, models.ScopeTendersWrite}, expectedStatus: http.StatusOK},
+		{name: "api key without scope", scopes: []string{models.ScopeTendersRead}, expectedStatus: http.StatusForbidden},
+		{name: "token is not limited by scopes", useToken: true, expectedStatus: http.StatusOK},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockVerifier := new(mocks.MockTokenVerifier)
+			mockEmployeeRepo := new(mocks.MockEmployeeRepository)
+			mockAPIKeyRepo := new(mocks.MockAPIKeyRepository)
+			auth := middleware.NewAuthenticator(slogdiscard.NewDiscardLogger(), mockVerifier, mockEmployeeRepo, mockAPIKeyRepo)
+			router := newRouter(auth.Required(), auth.RequireScope(models.ScopeTendersWrite))
+			mockVerifier.On("Verify", "token").Return(jwt.Claims{Subject: "qwe"}, nil)
+			mockEmployeeRepo.On("GetEmployeeByUsername", mock.Anything, "qwe").Return(models.Employee{ID: 2, Username: "qwe"}, nil)
+			mockEmployeeRepo.On("GetEmployeeById", mock.Anything, 2).Return(models.Employee{ID: 2, Username: "qwe"}, nil)
+			mockAPIKeyRepo.On("GetAPIKeyByHash", mock.Anything, keygen.Hash("tnd_key")).
+				Return(models.APIKey{ID: 1, EmployeeId: 2, Scopes: tc.scopes}, nil)
+			req := httptest.NewRequest(http.MethodGet, "/api/me", nil)
+			if tc.useToken {
+				req.Header.Set("Authorization", "Bearer token")
+			} else {
+				req.Header.Set(middleware.APIKeyHeader, "tnd_key")
+			}
+			w := httptest.NewRecorder()
+
+			// Act
+			router.ServeHTTP(w, req)
+
+			// Assert
+			if tc.expectedStatus == http.StatusForbidden {
+				requireAuthProblem(t, w, http.StatusForbidden, "insufficient_scope", "api key has no scope tenders:write")
+				return
+			}
+			assert.Equal(t, tc.expectedStatus, w.Code)
+		})
+	}
+}
+
+// TestRejectAPIKey проверяет, что API ключ не пускается на эндпоинты,
+// где он запрещен.
+//
+// Возвращается код 403.
+func TestRejectAPIKey(t *testing.T) {
+	// Arrange
+	mockVerifier := new(mocks.MockTokenVerifier)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepository)
+	mockAPIKeyRepo := new(mocks.MockAPIKeyRepository)
+	auth := middleware.NewAuthenticator(slogdiscard.NewDiscardLogger(), mockVerifier, mockEmployeeRepo, mockAPIKeyRepo)
+	router := newRouter(auth.Required(), auth.RejectAPIKey())
+	mockAPIKeyRepo.On("GetAPIKeyByHash", mock.Anything, keygen.Hash("tnd_key")).
+		Return(models.APIKey{ID: 1, EmployeeId: 2, Scopes: []string{models.ScopeTendersWrite}}, nil)
+	mockEmployeeRepo.On("GetEmployeeById", mock.Anything, 2).Return(models.Employee{ID: 2, Username: "erp"}, nil)
+	req := httptest.NewRequest(http.MethodGet, "/api/me", nil)
+	req.Header.Set(middleware.APIKeyHeader, "tnd_key")
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	requireAuthProblem(t, w, http.StatusForbidden, "api_key_not_allowed", "api key cannot be used for this endpoint")
+}
+
+// TestActingUsername проверяет выбор username, от имени которого
+// выполняется запрос.
+func TestActingUsername(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	cases := []struct {
+		name            string
+		authenticated   bool
+		claimedUsername string
+		expected        string
+		expectedErr     error
+		expectedStatus  int
+	}{
+		{name: "authenticated without claim", authenticated: true, expected: "qwe"},
+		{name: "authenticated with same claim", authenticated: true, claimedUsername: "qwe", expected: "qwe"},
+		{name: "claim of other employee", authenticated: true, claimedUsername: "asd", expectedErr: middleware.ErrUsernameMismatch, expectedStatus: http.StatusForbidden},
+		{name: "not authenticated", claimedUsername: "qwe", expectedErr: middleware.ErrNotAuthenticated, expectedStatus: http.StatusUnauthorized},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+			if tc.authenticated {
+				c.Request = c.Request.WithContext(middleware.ContextWithEmployee(c.Request.Context(), models.Employee{Username: "qwe"}))
+			}
+
+			// Act
+			username, err := middleware.ActingUsername(c, tc.claimedUsername)
+
+			// Assert
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				assert.Equal(t, tc.expectedStatus, middleware.AuthErrorStatus(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, username)
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package middleware_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	"github.com/sariya23/tender/internal/lib/problem"
+	"github.com/sariya23/tender/internal/middleware"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newProblemRouter создает роутер с middleware.Problems и обработчиком,
+// который передает ошибку err в ginContext.Error.
+func newProblemRouter(err error) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(middleware.Problems(slogdiscard.NewDiscardLogger(), outerror.Catalog))
+	router.GET("/api/tenders/:tenderId", func(ginContext *gin.Context) {
+		ginContext.Error(err)
+	})
+	return router
+}
+
+// TestProblems_SuccessCatalogError проверяет, что ошибка из каталога
+// отдается как application/problem+json с кодом и статусом из каталога.
+func TestProblems_SuccessCatalogError(t *testing.T) {
+	// Arrange
+	router := newProblemRouter(errors.Join(errors.New("cannot get tender"), outerror.ErrTenderNotFound))
+	expectedBody := `
+	{
+		"type": "urn:tender:problem:tender_not_found",
+		"title": "Tender not found",
+		"status": 404,
+		"detail": "tender not found",
+		"instance": "/api/tenders/2",
+		"code": "tender_not_found"
+	}`
+	req := httptest.NewRequest(http.MethodGet, "/api/tenders/2", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, problem.ContentType, w.Header().Get("Content-Type"))
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestProblems_SuccessDetailAndFields проверяет, что пояснение и
+// ошибки полей из problem.Error попадают в ответ.
+func TestProblems_SuccessDetailAndFields(t *testing.T) {
+	// Arrange
+	router := newProblemRouter(&problem.Error{
+		Err:    outerror.ErrValidationFailed,
+		Detail: "tender is invalid",
+		Fields: []problem.FieldError{{Pointer: "/tender/name", Rule: "required", Message: "value is required"}},
+	})
+	expectedBody := `
+	{
+		"type": "urn:tender:problem:validation_failed",
+		"title": "Request validation failed",
+		"status": 400,
+		"detail": "tender is invalid",
+		"instance": "/api/tenders/2",
+		"code": "validation_failed",
+		"errors": [{"pointer": "/tender/name", "rule": "required", "message": "value is required"}]
+	}`
+	req := httptest.NewRequest(http.MethodGet, "/api/tenders/2", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestProblems_SuccessAuthError проверяет, что ошибки
+// аутентификации описаны в самом middleware.
+func TestProblems_SuccessAuthError(t *testing.T) {
+	// Arrange
+	router := newProblemRouter(middleware.ErrUsernameMismatch)
+	req := httptest.NewRequest(http.MethodGet, "/api/tenders/2", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	require.Contains(t, w.Body.String(), `"code":"username_mismatch"`)
+}
+
+// TestProblems_FailUnknownError проверяет, что на неизвестную
+// ошибку возвращается код 500, а текст ошибки в ответ не попадает.
+func TestProblems_FailUnknownError(t *testing.T) {
+	// Arrange
+	router := newProblemRouter(errors.New("connection refused"))
+	expectedBody := `
+	{
+		"type": "urn:tender:problem:internal_error",
+		"title": "Internal error",
+		"status": 500,
+		"instance": "/api/tenders/2",
+		"code": "internal_error"
+	}`
+	req := httptest.NewRequest(http.MethodGet, "/api/tenders/2", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	require.JSONEq(t, expectedBody, w.Body.String())
+}
+
+// TestProblems_SuccessWrittenResponse проверяет, что если
+// обработчик уже записал ответ, то middleware его не меняет.
+func TestProblems_SuccessWrittenResponse(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(middleware.Problems(slogdiscard.NewDiscardLogger(), outerror.Catalog))
+	router.GET("/api/bids", func(ginContext *gin.Context) {
+		ginContext.Error(outerror.ErrBidNotFound)
+		ginContext.JSON(http.StatusNotFound, gin.H{"message": "bid not found"})
+	})
+	req := httptest.NewRequest(http.MethodGet, "/api/bids", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	require.JSONEq(t, `{"message": "bid not found"}`, w.Body.String())
+}
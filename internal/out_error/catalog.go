@@ -0,0 +1,73 @@
+package outerror
+
+import (
+	"net/http"
+
+	"github.com/sariya23/tender/internal/lib/problem"
+)
+
+// Catalog каталог ошибок API. Каждой ошибке сопоставлены стабильный код,
+// HTTP статус и заголовок ответа application/problem+json.
+//
+// Коды ошибок не меняются, клиенты могут на них опираться.
+// Ошибки "не найдено" всегда отдаются со статусом 404. Раньше эндпоинты
+// тендеров в части таких случаев отвечали 422, изменение описано в README
+// и doc/api_spec.yaml как несовместимое.
+var Catalog = problem.Catalog{
+	{Err: ErrInvalidRequest, Entry: problem.Entry{Code: "invalid_request", Status: http.StatusBadRequest, Title: "Invalid request"}},
+	{Err: ErrValidationFailed, Entry: problem.Entry{Code: "validation_failed", Status: http.StatusBadRequest, Title: "Request validation failed"}},
+	{Err: ErrUnsupportedImportFormat, Entry: problem.Entry{Code: "unsupported_import_format", Status: http.StatusUnsupportedMediaType, Title: "Unsupported import format"}},
+	{Err: ErrImportRowNotAttempted, Entry: problem.Entry{Code: "import_row_not_attempted", Status: http.StatusServiceUnavailable, Title: "Import was canceled before this row"}},
+
+	{Err: ErrEmployeeNotFound, Entry: problem.Entry{Code: "employee_not_found", Status: http.StatusNotFound, Title: "Employee not found"}},
+	{Err: ErrEmployeeAlreadyExists, Entry: problem.Entry{Code: "employee_already_exists", Status: http.StatusConflict, Title: "Employee already exists"}},
+
+	{Err: ErrOrganizationNotFound, Entry: problem.Entry{Code: "organization_not_found", Status: http.StatusNotFound, Title: "Organization not found"}},
+	{Err: ErrUnknownOrganizationType, Entry: problem.Entry{Code: "unknown_organization_type", Status: http.StatusBadRequest, Title: "Unknown organization type"}},
+	{Err: ErrEmployeeNotResponsibleForOrganization, Entry: problem.Entry{Code: "employee_not_responsible_for_organization", Status: http.StatusForbidden, Title: "Employee not responsible for organization"}},
+	{Err: ErrUpdatedEmployeeNotResponsibleForCurrentOrg, Entry: problem.Entry{Code: "updated_employee_not_responsible_for_current_organization", Status: http.StatusForbidden, Title: "Updated employee not responsible for current organization"}},
+	{Err: ErrCurrentEmployeeNotResponsibleForUpdatedOrg, Entry: problem.Entry{Code: "current_employee_not_responsible_for_updated_organization", Status: http.StatusForbidden, Title: "Current employee not responsible for updated organization"}},
+	{Err: ErrUpdatedEmployeeNotResponsibleForUpdatedOrg, Entry: problem.Entry{Code: "updated_employee_not_responsible_for_updated_organization", Status: http.StatusForbidden, Title: "Updated employee not responsible for updated organization"}},
+	{Err: ErrEmployeeAlreadyResponsible, Entry: problem.Entry{Code: "employee_already_responsible", Status: http.StatusConflict, Title: "Employee already responsible for organization"}},
+	{Err: ErrLastOrganizationResponsible, Entry: problem.Entry{Code: "last_organization_responsible", Status: http.StatusConflict, Title: "Cannot remove last responsible"}},
+
+	{Err: ErrTenderNotFound, Entry: problem.Entry{Code: "tender_not_found", Status: http.StatusNotFound, Title: "Tender not found"}},
+	{Err: ErrTenderVersionNotFound, Entry: problem.Entry{Code: "tender_version_not_found", Status: http.StatusNotFound, Title: "Tender version not found"}},
+	{Err: ErrTendersNotFound, Entry: problem.Entry{Code: "tenders_not_found", Status: http.StatusNotFound, Title: "Tenders not found"}},
+	{Err: ErrEmployeeTendersNotFound, Entry: problem.Entry{Code: "employee_tenders_not_found", Status: http.StatusNotFound, Title: "Employee tenders not found"}},
+	{Err: ErrEmployeeNotResponsibleForTender, Entry: problem.Entry{Code: "employee_not_responsible_for_tender", Status: http.StatusForbidden, Title: "Employee not responsible for tender"}},
+	{Err: ErrUnknownTenderStatus, Entry: problem.Entry{Code: "unknown_tender_status", Status: http.StatusBadRequest, Title: "Unknown tender status"}},
+	{Err: ErrNewTenderCannotCreatedWithStatusNotCreated, Entry: problem.Entry{Code: "tender_initial_status_invalid", Status: http.StatusBadRequest, Title: "Tender must be created with status CREATED"}},
+	{Err: ErrCannotSetThisTenderStatus, Entry: problem.Entry{Code: "tender_status_transition_not_allowed", Status: http.StatusBadRequest, Title: "Tender status transition not allowed"}},
+	{Err: ErrTenderStatusReasonRequired, Entry: problem.Entry{Code: "tender_status_reason_required", Status: http.StatusBadRequest, Title: "Reason required for tender status transition"}},
+	{Err: ErrTenderStatusFilterRequiresUsername, Entry: problem.Entry{Code: "tender_status_filter_requires_authentication", Status: http.StatusBadRequest, Title: "Authentication required for tender status filter"}},
+	{Err: ErrTenderDeadlineBeforePublishAt, Entry: problem.Entry{Code: "tender_deadline_before_publish_at", Status: http.StatusBadRequest, Title: "Tender deadline before publish_at"}},
+	{Err: ErrTenderReopenDeadlinePassed, Entry: problem.Entry{Code: "tender_reopen_deadline_passed", Status: http.StatusBadRequest, Title: "Deadline of reopened tender already passed"}},
+	{Err: ErrTenderVersionConflict, Entry: problem.Entry{Code: "tender_version_conflict", Status: http.StatusConflict, Title: "Tender was changed by someone else"}},
+	{Err: ErrTenderNotPublished, Entry: problem.Entry{Code: "tender_not_published", Status: http.StatusUnprocessableEntity, Title: "Tender not published"}},
+	{Err: ErrTenderCloseRequiresQuorum, Entry: problem.Entry{Code: "tender_close_requires_quorum", Status: http.StatusForbidden, Title: "Tender can be closed only by quorum"}},
+	{Err: ErrUnknownCloseVoteDecision, Entry: problem.Entry{Code: "unknown_close_vote_decision", Status: http.StatusBadRequest, Title: "Unknown close vote decision"}},
+	{Err: ErrEmployeeAlreadyVoted, Entry: problem.Entry{Code: "employee_already_voted", Status: http.StatusConflict, Title: "Employee already voted"}},
+	{Err: ErrNothingToUpdate, Entry: problem.Entry{Code: "nothing_to_update", Status: http.StatusBadRequest, Title: "Nothing to update"}},
+	{Err: ErrEmptySearchQuery, Entry: problem.Entry{Code: "empty_search_query", Status: http.StatusBadRequest, Title: "Search query is empty"}},
+
+	{Err: ErrBidNotFound, Entry: problem.Entry{Code: "bid_not_found", Status: http.StatusNotFound, Title: "Bid not found"}},
+	{Err: ErrBidVersionNotFound, Entry: problem.Entry{Code: "bid_version_not_found", Status: http.StatusNotFound, Title: "Bid version not found"}},
+	{Err: ErrTenderBidsNotFound, Entry: problem.Entry{Code: "tender_bids_not_found", Status: http.StatusNotFound, Title: "Tender bids not found"}},
+	{Err: ErrEmployeeBidsNotFound, Entry: problem.Entry{Code: "employee_bids_not_found", Status: http.StatusNotFound, Title: "Employee bids not found"}},
+	{Err: ErrBidOrganizationIsTenderOrganization, Entry: problem.Entry{Code: "bid_organization_is_tender_organization", Status: http.StatusUnprocessableEntity, Title: "Bid organization is tender organization"}},
+	{Err: ErrUnknownBidStatus, Entry: problem.Entry{Code: "unknown_bid_status", Status: http.StatusBadRequest, Title: "Unknown bid status"}},
+	{Err: ErrNewBidCannotCreatedWithStatusNotCreated, Entry: problem.Entry{Code: "bid_initial_status_invalid", Status: http.StatusBadRequest, Title: "Bid must be created with status CREATED"}},
+	{Err: ErrCannotSetThisBidStatus, Entry: problem.Entry{Code: "bid_status_transition_not_allowed", Status: http.StatusBadRequest, Title: "Bid status transition not allowed"}},
+	{Err: ErrBidVersionConflict, Entry: problem.Entry{Code: "bid_version_conflict", Status: http.StatusConflict, Title: "Bid was changed by someone else"}},
+	{Err: ErrEmployeeNotResponsibleForBid, Entry: problem.Entry{Code: "employee_not_responsible_for_bid", Status: http.StatusForbidden, Title: "Employee not responsible for bid"}},
+
+	{Err: ErrServiceTypeNotFound, Entry: problem.Entry{Code: "service_type_not_found", Status: http.StatusNotFound, Title: "Service type not found"}},
+	{Err: ErrUnknownServiceType, Entry: problem.Entry{Code: "unknown_service_type", Status: http.StatusBadRequest, Title: "Unknown service type"}},
+	{Err: ErrServiceTypeAlreadyExists, Entry: problem.Entry{Code: "service_type_already_exists", Status: http.StatusConflict, Title: "Service type already exists"}},
+	{Err: ErrServiceTypeInUse, Entry: problem.Entry{Code: "service_type_in_use", Status: http.StatusConflict, Title: "Service type is used by tenders"}},
+	{Err: ErrEmployeeNotSystemAdmin, Entry: problem.Entry{Code: "employee_not_system_admin", Status: http.StatusForbidden, Title: "Employee is not system administrator"}},
+
+	{Err: ErrAPIKeyNotFound, Entry: problem.Entry{Code: "api_key_not_found", Status: http.StatusNotFound, Title: "API key not found"}},
+	{Err: ErrUnknownAPIKeyScope, Entry: problem.Entry{Code: "unknown_api_key_scope", Status: http.StatusBadRequest, Title: "Unknown API key scope"}},
+}
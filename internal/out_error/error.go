@@ -4,7 +4,7 @@ import "errors"
 
 var (
 	ErrEmployeeNotFound                           = errors.New("employee not found")
-	ErrTendersWithThisServiceTypeNotFound         = errors.New("not found tenders with this service type")
+	ErrTendersNotFound                            = errors.New("tenders not found")
 	ErrEmployeeTendersNotFound                    = errors.New("not found tenders for this employee")
 	ErrOrganizationNotFound                       = errors.New("organization not found")
 	ErrEmployeeNotResponsibleForOrganization      = errors.New("employee not responsible for organization")
@@ -16,6 +16,41 @@ var (
 	ErrUnknownTenderStatus                        = errors.New("unknown tender status")
 	ErrNothingToUpdate                            = errors.New("nothing to update")
 	ErrNewTenderCannotCreatedWithStatusNotCreated = errors.New("tender cannot be created with status not created")
-	ErrCannotSetThisTenderStatus                  = errors.New("cannot set tender status in this cases: PUBLISED -> CREATED, CLOSED -> CREATED")
+	ErrCannotSetThisTenderStatus                  = errors.New("tender status transition is not allowed")
 	ErrEmployeeNotResponsibleForTender            = errors.New("employee not respobsible for this tender")
+	ErrBidNotFound                                = errors.New("bid not found")
+	ErrBidVersionNotFound                         = errors.New("bid version not found")
+	ErrTenderBidsNotFound                         = errors.New("not found bids for this tender")
+	ErrEmployeeBidsNotFound                       = errors.New("not found bids for this employee")
+	ErrTenderNotPublished                         = errors.New("tender is not published")
+	ErrBidOrganizationIsTenderOrganization        = errors.New("bid cannot be made by organization of tender")
+	ErrUnknownBidStatus                           = errors.New("unknown bid status")
+	ErrNewBidCannotCreatedWithStatusNotCreated    = errors.New("bid cannot be created with status not created")
+	ErrCannotSetThisBidStatus                     = errors.New("cannot set bid status in this cases: PUBLISHED -> CREATED, CANCELED -> any other")
+	ErrEmployeeNotResponsibleForBid               = errors.New("employee not responsible for this bid")
+	ErrTenderCloseRequiresQuorum                  = errors.New("published tender can be closed only by quorum of responsible employees")
+	ErrUnknownCloseVoteDecision                   = errors.New("unknown close vote decision")
+	ErrEmployeeAlreadyVoted                       = errors.New("employee already voted for closing this tender version")
+	ErrTenderVersionConflict                      = errors.New("tender was changed by someone else")
+	ErrBidVersionConflict                         = errors.New("bid was changed by someone else")
+	ErrTenderStatusReasonRequired                 = errors.New("reason is required for this tender status transition")
+	ErrTenderDeadlineBeforePublishAt              = errors.New("tender deadline must be after publish_at")
+	ErrTenderReopenDeadlinePassed                 = errors.New("deadline of reopened tender must be in the future")
+	ErrTenderStatusFilterRequiresUsername         = errors.New("username is required to filter tenders by status other than PUBLISHED")
+	ErrEmptySearchQuery                           = errors.New("search query is empty")
+	ErrUnknownOrganizationType                    = errors.New("unknown organization type")
+	ErrEmployeeAlreadyExists                      = errors.New("employee with this username already exists")
+	ErrEmployeeAlreadyResponsible                 = errors.New("employee already responsible for organization")
+	ErrLastOrganizationResponsible                = errors.New("cannot remove last responsible of organization with live tenders")
+	ErrAPIKeyNotFound                             = errors.New("api key not found")
+	ErrUnknownAPIKeyScope                         = errors.New("unknown api key scope")
+	ErrServiceTypeNotFound                        = errors.New("service type not found")
+	ErrUnknownServiceType                         = errors.New("unknown service type")
+	ErrServiceTypeAlreadyExists                   = errors.New("service type with this name already exists")
+	ErrServiceTypeInUse                           = errors.New("service type is used by tenders")
+	ErrEmployeeNotSystemAdmin                     = errors.New("employee is not system administrator")
+	ErrInvalidRequest                             = errors.New("invalid request")
+	ErrValidationFailed                           = errors.New("request validation failed")
+	ErrUnsupportedImportFormat                    = errors.New("unsupported import format, use text/csv or application/x-ndjson")
+	ErrImportRowNotAttempted                      = errors.New("import was canceled before this row")
 )
@@ -2,21 +2,41 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/sariya23/tender/internal/domain/models"
 )
 
 type TenderRepository interface {
 	CreateTender(ctx context.Context, tender models.Tender) (models.Tender, error)
-	GetAllTenders(ctx context.Context) ([]models.Tender, error)
-	GetTendersByServiceType(ctx context.Context, serviceType string) ([]models.Tender, error)
-	GetEmployeeTenders(ctx context.Context, empl models.Employee) ([]models.Tender, error)
-	EditTender(ctx context.Context, oldTender models.Tender, tenderId int, updateTender models.TenderToUpdate) (models.Tender, error)
-	RollbackTender(ctx context.Context, tenderId int, toVersionRollback int) error
+	CreateTenders(ctx context.Context, tenders []models.Tender) ([]models.Tender, error)
+	GetTenders(ctx context.Context, filter models.TenderFilter, page models.Page) (models.TenderPage, error)
+	GetEmployeeTenders(ctx context.Context, empl models.Employee, page models.Page) (models.TenderPage, error)
+	EditTender(ctx context.Context, oldTender models.Tender, tenderId int, updateTender models.TenderToUpdate, modifiedBy string) (models.Tender, error)
+	RollbackTender(ctx context.Context, tenderId int, toVersionRollback int, activeVersion int) error
+	RollbackTenderAsNewVersion(ctx context.Context, tenderId int, toVersionRollback int, activeVersion int, modifiedBy string) error
 	GetTenderById(ctx context.Context, tenderId int) (models.Tender, error)
 	FindTenderVersion(ctx context.Context, tenderId int, version int) error
+	GetTenderVersions(ctx context.Context, tenderId int) ([]models.TenderVersion, error)
+	GetTenderVersion(ctx context.Context, tenderId int, version int) (models.TenderVersion, error)
 	GetTenderStatus(ctx context.Context, tenderStatus string) (string, error)
-	GetLastInsertedTenderId(ctx context.Context) (int, error)
+	GetDueTenders(ctx context.Context, now time.Time) ([]models.Tender, error)
+	VoteTenderClose(ctx context.Context, tenderId int, vote models.TenderCloseVote, quorum int) (models.TenderCloseVoting, error)
+}
+
+// TenderSearcher ищет опубликованные тендеры по словам из названия и описания.
+type TenderSearcher interface {
+	SearchTenders(ctx context.Context, query string, page models.Page) (models.TenderSearchPage, error)
+}
+
+type BidRepository interface {
+	CreateBid(ctx context.Context, bid models.Bid) (models.Bid, error)
+	GetTenderBids(ctx context.Context, tenderId int) ([]models.Bid, error)
+	GetEmployeeBids(ctx context.Context, empl models.Employee) ([]models.Bid, error)
+	EditBid(ctx context.Context, oldBid models.Bid, bidId int, updateBid models.BidToUpdate) (models.Bid, error)
+	RollbackBid(ctx context.Context, bidId int, toVersionRollback int) error
+	GetBidById(ctx context.Context, bidId int) (models.Bid, error)
+	GetBidVersion(ctx context.Context, bidId int, version int) (models.Bid, error)
 }
 
 type EmployeeRepository interface {
@@ -24,10 +44,66 @@ type EmployeeRepository interface {
 	GetEmployeeById(ctx context.Context, id int) (models.Employee, error)
 }
 
+// EmployeeManager создает и изменяет сотрудников.
+type EmployeeManager interface {
+	EmployeeRepository
+	CreateEmployee(ctx context.Context, employee models.Employee) (models.Employee, error)
+	GetEmployees(ctx context.Context, page models.Page) (models.EmployeePage, error)
+	EditEmployee(ctx context.Context, id int, updateEmployee models.EmployeeToUpdate) (models.Employee, error)
+}
+
+// RoleRepository возвращает роли сотрудников.
+type RoleRepository interface {
+	GetEmployeeRoles(ctx context.Context, emplId int) ([]string, error)
+	GetOrganizationRole(ctx context.Context, emplId int, orgId int) (string, error)
+}
+
+// APIKeyRepository ищет действующие API ключи.
+type APIKeyRepository interface {
+	GetAPIKeyByHash(ctx context.Context, keyHash string) (models.APIKey, error)
+}
+
+// APIKeyManager выпускает и отзывает API ключи сотрудников.
+type APIKeyManager interface {
+	APIKeyRepository
+	CreateAPIKey(ctx context.Context, apiKey models.APIKey, keyHash string) (models.APIKey, error)
+	GetAPIKeys(ctx context.Context, employeeId int) ([]models.APIKey, error)
+	RevokeAPIKey(ctx context.Context, employeeId int, apiKeyId int) (models.APIKey, error)
+}
+
+// ServiceTypeRepository ищет типы услуг в справочнике.
+type ServiceTypeRepository interface {
+	GetServiceTypeByName(ctx context.Context, name string) (models.ServiceType, error)
+}
+
+// ServiceTypeManager ведет справочник типов услуг.
+type ServiceTypeManager interface {
+	ServiceTypeRepository
+	GetServiceTypes(ctx context.Context) ([]models.ServiceType, error)
+	CreateServiceType(ctx context.Context, name string) (models.ServiceType, error)
+	EditServiceType(ctx context.Context, id int, name string) (models.ServiceType, error)
+	DeleteServiceType(ctx context.Context, id int) error
+}
+
 type OrganizationRepository interface {
 	GetOrganizationById(ctx context.Context, orgId int) (models.Organization, error)
 }
 
+// OrganizationManager создает, изменяет и удаляет организации
+// и управляет ответственными за них сотрудниками.
+type OrganizationManager interface {
+	OrganizationRepository
+	EmployeeResponsibler
+	CreateOrganization(ctx context.Context, organization models.Organization) (models.Organization, error)
+	GetOrganizations(ctx context.Context, page models.Page) (models.OrganizationPage, error)
+	EditOrganization(ctx context.Context, orgId int, updateOrganization models.OrganizationToUpdate) (models.Organization, error)
+	DeleteOrganization(ctx context.Context, orgId int) error
+	GetOrganizationType(ctx context.Context, orgType string) (string, error)
+	AddOrganizationResponsible(ctx context.Context, orgId int, emplId int, role string) error
+	RemoveOrganizationResponsible(ctx context.Context, orgId int, emplId int) error
+}
+
 type EmployeeResponsibler interface {
 	CheckResponsibility(ctx context.Context, emplId int, orgId int) error
+	GetOrganizationResponsibles(ctx context.Context, orgId int) ([]models.Employee, error)
 }
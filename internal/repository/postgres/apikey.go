@@ -0,0 +1,121 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/sariya23/tender/internal/domain/models"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+// apiKeyColumns колонки API ключа в порядке scanAPIKey.
+const apiKeyColumns = `api_key_id, employee_id, name, key_prefix, scopes, created_at, revoked_at`
+
+// CreateAPIKey сохраняет API ключ с хешем keyHash. Если сотрудника
+// нет, то возвращается ErrEmployeeNotFound.
+func (storage *Storage) CreateAPIKey(ctx context.Context, apiKey models.APIKey, keyHash string) (models.APIKey, error) {
+	const operationPlace = "repository.postgres.apikey.CreateAPIKey"
+	insertAPIKey := `insert into api_key (employee_id, name, key_prefix, key_hash, scopes)
+						values (@employee_id, @name, @key_prefix, @key_hash, @scopes)
+						returning ` + apiKeyColumns
+
+	row := storage.connection.QueryRow(
+		ctx,
+		insertAPIKey,
+		pgx.NamedArgs{
+			"employee_id": apiKey.EmployeeId,
+			"name":        apiKey.Name,
+			"key_prefix":  apiKey.Prefix,
+			"key_hash":    keyHash,
+			"scopes":      apiKey.Scopes,
+		},
+	)
+	createdAPIKey, err := scanAPIKey(row)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return models.APIKey{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeNotFound)
+		}
+		return models.APIKey{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	return createdAPIKey, nil
+}
+
+// GetAPIKeys возвращает все API ключи сотрудника, включая отозванные.
+func (storage *Storage) GetAPIKeys(ctx context.Context, employeeId int) ([]models.APIKey, error) {
+	const operationPlace = "repository.postgres.apikey.GetAPIKeys"
+	query := `select ` + apiKeyColumns + ` from api_key where employee_id = $1 order by api_key_id`
+
+	rows, err := storage.connection.Query(ctx, query, employeeId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer rows.Close()
+
+	apiKeys := []models.APIKey{}
+	for rows.Next() {
+		apiKey, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		apiKeys = append(apiKeys, apiKey)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	return apiKeys, nil
+}
+
+// RevokeAPIKey отзывает действующий API ключ сотрудника. Если у сотрудника
+// нет такого действующего ключа, то возвращается ErrAPIKeyNotFound.
+func (storage *Storage) RevokeAPIKey(ctx context.Context, employeeId int, apiKeyId int) (models.APIKey, error) {
+	const operationPlace = "repository.postgres.apikey.RevokeAPIKey"
+	query := `update api_key set revoked_at = CURRENT_TIMESTAMP
+				where api_key_id = @api_key_id and employee_id = @employee_id and revoked_at is null
+				returning ` + apiKeyColumns
+
+	row := storage.connection.QueryRow(ctx, query, pgx.NamedArgs{"api_key_id": apiKeyId, "employee_id": employeeId})
+	revokedAPIKey, err := scanAPIKey(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.APIKey{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrAPIKeyNotFound)
+		}
+		return models.APIKey{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	return revokedAPIKey, nil
+}
+
+// GetAPIKeyByHash возвращает действующий API ключ по хешу.
+func (storage *Storage) GetAPIKeyByHash(ctx context.Context, keyHash string) (models.APIKey, error) {
+	const operationPlace = "repository.postgres.apikey.GetAPIKeyByHash"
+	query := `select ` + apiKeyColumns + ` from api_key where key_hash = $1 and revoked_at is null`
+
+	row := storage.connection.QueryRow(ctx, query, keyHash)
+	apiKey, err := scanAPIKey(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.APIKey{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrAPIKeyNotFound)
+		}
+		return models.APIKey{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	return apiKey, nil
+}
+
+func scanAPIKey(row pgx.Row) (models.APIKey, error) {
+	var apiKey models.APIKey
+	err := row.Scan(
+		&apiKey.ID,
+		&apiKey.EmployeeId,
+		&apiKey.Name,
+		&apiKey.Prefix,
+		&apiKey.Scopes,
+		&apiKey.CreatedAt,
+		&apiKey.RevokedAt,
+	)
+	return apiKey, err
+}
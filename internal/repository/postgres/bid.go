@@ -0,0 +1,329 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/sariya23/tender/internal/domain/models"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+func (storage *Storage) CreateBid(ctx context.Context, bid models.Bid) (createdBid models.Bid, err error) {
+	const operationPlace = "repository.postgres.bid.CreateBid"
+
+	args := pgx.NamedArgs{
+		"tender_id": bid.TenderId,
+		"name":      bid.BidName,
+		"desc":      bid.Description,
+		"status":    bid.Status,
+		"org_id":    bid.OrganizationId,
+		"username":  bid.CreatorUsername,
+		"version":   1,
+	}
+	createQuery := `insert into bid (bid_id, tender_id, name, description, status, organization_id, creator_username, version)
+						values (nextval('bid_id_seq'), @tender_id, @name, @desc, @status, @org_id, @username, @version)
+						returning bid_id, tender_id, name, description, status, organization_id, creator_username, version
+	`
+	createdBid = models.Bid{}
+
+	tx, err := storage.connection.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return models.Bid{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			tx.Commit(ctx)
+		}
+	}()
+	row := tx.QueryRow(ctx, createQuery, args)
+	err = row.Scan(
+		&createdBid.ID,
+		&createdBid.TenderId,
+		&createdBid.BidName,
+		&createdBid.Description,
+		&createdBid.Status,
+		&createdBid.OrganizationId,
+		&createdBid.CreatorUsername,
+		&createdBid.Version,
+	)
+	if err != nil {
+		return models.Bid{}, fmt.Errorf("%s: %w. Place = createQuery", operationPlace, err)
+	}
+	return createdBid, nil
+}
+
+func (storage *Storage) GetTenderBids(ctx context.Context, tenderId int) ([]models.Bid, error) {
+	const operationPlace = "repository.postgres.bid.GetTenderBids"
+	query := `select bid_id, tender_id, name, description, status, organization_id, creator_username, version
+				from bid
+				where tender_id = $1 and is_active_version = $2
+				order by bid_id`
+
+	bids, err := storage.queryBids(ctx, query, tenderId, true)
+	if err != nil {
+		return []models.Bid{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if len(bids) == 0 {
+		return []models.Bid{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTenderBidsNotFound)
+	}
+	return bids, nil
+}
+
+func (storage *Storage) GetEmployeeBids(ctx context.Context, empl models.Employee) ([]models.Bid, error) {
+	const operationPlace = "repository.postgres.bid.GetEmployeeBids"
+	query := `select bid_id, tender_id, name, description, status, organization_id, creator_username, version
+				from bid
+				where creator_username = $1 and is_active_version = $2
+				order by bid_id`
+
+	bids, err := storage.queryBids(ctx, query, empl.Username, true)
+	if err != nil {
+		return []models.Bid{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if len(bids) == 0 {
+		return []models.Bid{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeBidsNotFound)
+	}
+	return bids, nil
+}
+
+func (storage *Storage) EditBid(
+	ctx context.Context,
+	oldBid models.Bid,
+	bidId int,
+	updateBid models.BidToUpdate,
+) (updatedBid models.Bid, err error) {
+	const operationPlace = "repository.postgres.bid.EditBid"
+
+	insertQuery := `
+	insert into bid (bid_id, tender_id, name, description, status, organization_id, creator_username, version, is_active_version)
+	values (@bid_id, @tender_id, @name, @desc, @status, @org_id, @username, @version, @is_active_version)
+	returning bid_id, tender_id, name, description, status, organization_id, creator_username, version`
+
+	tx, err := storage.connection.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return models.Bid{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			tx.Commit(ctx)
+		}
+	}()
+
+	err = lockActiveBidVersion(ctx, tx, bidId, oldBid.Version)
+	if err != nil {
+		return models.Bid{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	lastBidVersion, err := getLastBidVersion(ctx, tx, bidId)
+	if err != nil {
+		return models.Bid{}, fmt.Errorf("%s.getLastBidVersion: %w", operationPlace, err)
+	}
+	args := pgx.NamedArgs{
+		"is_active_version": true,
+		"version":           lastBidVersion + 1,
+		"bid_id":            bidId,
+		"tender_id":         oldBid.TenderId,
+		"org_id":            oldBid.OrganizationId,
+		"username":          oldBid.CreatorUsername,
+	}
+
+	if newName := updateBid.BidName; newName == nil {
+		args["name"] = oldBid.BidName
+	} else {
+		args["name"] = *newName
+	}
+
+	if newDesc := updateBid.Description; newDesc == nil {
+		args["desc"] = oldBid.Description
+	} else {
+		args["desc"] = *newDesc
+	}
+
+	if newStatus := updateBid.Status; newStatus == nil {
+		args["status"] = oldBid.Status
+	} else {
+		args["status"] = *newStatus
+	}
+
+	deactivateQuery := "update bid set is_active_version = $1 where bid_id = $2"
+	_, err = tx.Exec(ctx, deactivateQuery, false, bidId)
+	if err != nil {
+		return models.Bid{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	row := tx.QueryRow(ctx, insertQuery, args)
+	err = row.Scan(
+		&updatedBid.ID,
+		&updatedBid.TenderId,
+		&updatedBid.BidName,
+		&updatedBid.Description,
+		&updatedBid.Status,
+		&updatedBid.OrganizationId,
+		&updatedBid.CreatorUsername,
+		&updatedBid.Version,
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return models.Bid{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrBidVersionConflict)
+		}
+		return models.Bid{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	return updatedBid, nil
+}
+
+func (storage *Storage) RollbackBid(ctx context.Context, bidId int, toVersionRollback int) (err error) {
+	const operationPlace = "repository.postgres.bid.RollbackBid"
+	deactivateVersionQuery := `update bid set is_active_version = $1 where bid_id = $2`
+	rollbackQuery := `update bid set is_active_version = $1 where bid_id = $2 and version = $3`
+
+	tx, err := storage.connection.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			tx.Commit(ctx)
+		}
+	}()
+
+	_, err = tx.Exec(ctx, deactivateVersionQuery, false, bidId)
+	if err != nil {
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	_, err = tx.Exec(ctx, rollbackQuery, true, bidId, toVersionRollback)
+	if err != nil {
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return nil
+}
+
+func (storage *Storage) GetBidById(ctx context.Context, bidId int) (models.Bid, error) {
+	const operationPlace = "repository.postgres.bid.GetBidById"
+	query := `select bid_id, tender_id, name, description, status, organization_id, creator_username, version
+				from bid
+				where bid_id = $1 and is_active_version = $2`
+
+	var bid models.Bid
+
+	row := storage.connection.QueryRow(ctx, query, bidId, true)
+	err := row.Scan(
+		&bid.ID,
+		&bid.TenderId,
+		&bid.BidName,
+		&bid.Description,
+		&bid.Status,
+		&bid.OrganizationId,
+		&bid.CreatorUsername,
+		&bid.Version,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Bid{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrBidNotFound)
+		}
+		return models.Bid{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	return bid, nil
+}
+
+func (storage *Storage) GetBidVersion(ctx context.Context, bidId int, version int) (models.Bid, error) {
+	const operationPlace = "repository.postgres.bid.GetBidVersion"
+	query := `select bid_id, tender_id, name, description, status, organization_id, creator_username, version
+				from bid
+				where bid_id = $1 and version = $2`
+
+	var bid models.Bid
+
+	row := storage.connection.QueryRow(ctx, query, bidId, version)
+	err := row.Scan(
+		&bid.ID,
+		&bid.TenderId,
+		&bid.BidName,
+		&bid.Description,
+		&bid.Status,
+		&bid.OrganizationId,
+		&bid.CreatorUsername,
+		&bid.Version,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Bid{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrBidVersionNotFound)
+		}
+		return models.Bid{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	return bid, nil
+}
+
+func (storage *Storage) queryBids(ctx context.Context, query string, args ...any) ([]models.Bid, error) {
+	bids := []models.Bid{}
+
+	rows, err := storage.connection.Query(ctx, query, args...)
+	if err != nil {
+		return []models.Bid{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		bid := models.Bid{}
+		err := rows.Scan(
+			&bid.ID,
+			&bid.TenderId,
+			&bid.BidName,
+			&bid.Description,
+			&bid.Status,
+			&bid.OrganizationId,
+			&bid.CreatorUsername,
+			&bid.Version,
+		)
+		if err != nil {
+			return []models.Bid{}, err
+		}
+		bids = append(bids, bid)
+	}
+	if err := rows.Err(); err != nil {
+		return []models.Bid{}, err
+	}
+
+	return bids, nil
+}
+
+func getLastBidVersion(ctx context.Context, tx pgx.Tx, bidId int) (int, error) {
+	const operationPlace = "repository.postgres.bid.getLastBidVersion"
+	query := "select version from bid where bid_id = $1 order by version desc limit 1"
+	row := tx.QueryRow(ctx, query, bidId)
+	var version int
+	err := row.Scan(&version)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return version, nil
+}
+
+// lockActiveBidVersion блокирует активную версию предложения до конца транзакции.
+// Если активная версия не expectedVersion, то возвращается ErrBidVersionConflict.
+func lockActiveBidVersion(ctx context.Context, tx pgx.Tx, bidId int, expectedVersion int) error {
+	query := `select version from bid where bid_id = $1 and is_active_version = $2 for update`
+	var activeVersion int
+	err := tx.QueryRow(ctx, query, bidId, true).Scan(&activeVersion)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return outerror.ErrBidNotFound
+		}
+		return err
+	}
+	if activeVersion != expectedVersion {
+		return outerror.ErrBidVersionConflict
+	}
+	return nil
+}
@@ -10,14 +10,15 @@ import (
 	outerror "github.com/sariya23/tender/internal/out_error"
 )
 
+// employeeColumns колонки сотрудника в порядке scanEmployee.
+const employeeColumns = `employee_id, username, coalesce(first_name, ''), coalesce(last_name, ''), created_at, updated_at`
+
 func (storage *Storage) GetEmployeeByUsername(ctx context.Context, username string) (models.Employee, error) {
 	const operationPlace = "repository.postgres.employee.GetEmployeeByUsername"
-	query := "select employee_id, username, first_name, last_name from employee where username = $1"
-
-	var employee models.Employee
+	query := "select " + employeeColumns + " from employee where username = $1"
 
 	row := storage.connection.QueryRow(ctx, query, username)
-	err := row.Scan(&employee.ID, &employee.Username, &employee.FirstName, &employee.LastName)
+	employee, err := scanEmployee(row)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return models.Employee{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeNotFound)
@@ -29,11 +30,14 @@ func (storage *Storage) GetEmployeeByUsername(ctx context.Context, username stri
 	return employee, nil
 }
 
-func (storage *Storage) CreateEmployee(ctx context.Context, employee models.Employee) error {
+// CreateEmployee создает сотрудника. Если сотрудник с таким username
+// уже есть, то возвращается ErrEmployeeAlreadyExists.
+func (storage *Storage) CreateEmployee(ctx context.Context, employee models.Employee) (models.Employee, error) {
 	const operationPlace = "repository.postgres.employee.CreateEmployee"
-	inserEmployee := "insert into employee (username, first_name, last_name) values (@username, @first_name, @last_name)"
+	inserEmployee := `insert into employee (username, first_name, last_name) values (@username, @first_name, @last_name)
+						returning ` + employeeColumns
 
-	_, err := storage.connection.Exec(
+	row := storage.connection.QueryRow(
 		ctx,
 		inserEmployee,
 		pgx.NamedArgs{
@@ -42,14 +46,169 @@ func (storage *Storage) CreateEmployee(ctx context.Context, employee models.Empl
 			"last_name":  employee.LastName,
 		},
 	)
-
+	createdEmployee, err := scanEmployee(row)
 	if err != nil {
-		return fmt.Errorf("%s: %w", operationPlace, err)
+		if isUniqueViolation(err) {
+			return models.Employee{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeAlreadyExists)
+		}
+		return models.Employee{}, fmt.Errorf("%s: %w", operationPlace, err)
 	}
 
-	return nil
+	return createdEmployee, nil
 }
 
 func (storage *Storage) GetEmployeeById(ctx context.Context, id int) (models.Employee, error) {
-	panic("impl me")
+	const operationPlace = "repository.postgres.employee.GetEmployeeById"
+	query := "select " + employeeColumns + " from employee where employee_id = $1"
+
+	row := storage.connection.QueryRow(ctx, query, id)
+	employee, err := scanEmployee(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Employee{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeNotFound)
+		} else {
+			return models.Employee{}, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+	}
+
+	return employee, nil
+}
+
+// GetEmployees возвращает страницу сотрудников, отсортированных по id.
+func (storage *Storage) GetEmployees(ctx context.Context, page models.Page) (models.EmployeePage, error) {
+	const operationPlace = "repository.postgres.employee.GetEmployees"
+	if page.Limit <= 0 {
+		page.Limit = models.DefaultPageLimit
+	}
+
+	var total int
+	err := storage.connection.QueryRow(ctx, `select count(*) from employee`).Scan(&total)
+	if err != nil {
+		return models.EmployeePage{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	args := pgx.NamedArgs{
+		"limit":    page.Limit + 1,
+		"offset":   page.Offset,
+		"after_id": page.AfterId,
+	}
+	if page.AfterId > 0 {
+		args["offset"] = 0
+	}
+	query := `select ` + employeeColumns + ` from employee
+				where employee_id > @after_id
+				order by employee_id
+				limit @limit offset @offset`
+
+	rows, err := storage.connection.Query(ctx, query, args)
+	if err != nil {
+		return models.EmployeePage{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer rows.Close()
+
+	employees := []models.Employee{}
+	for rows.Next() {
+		employee, err := scanEmployee(rows)
+		if err != nil {
+			return models.EmployeePage{}, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		employees = append(employees, employee)
+	}
+	if err := rows.Err(); err != nil {
+		return models.EmployeePage{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	employeePage := models.EmployeePage{Employees: employees, Total: total}
+	if len(employees) > page.Limit {
+		employeePage.Employees = employees[:page.Limit]
+		nextCursor := employeePage.Employees[page.Limit-1].ID
+		employeePage.NextCursor = &nextCursor
+	}
+	return employeePage, nil
+}
+
+// EditEmployee обновляет поля сотрудника, которые заданы в updateEmployee.
+//
+// Тендеры и предложения ссылаются на сотрудника по username, поэтому
+// при смене username они обновляются в той же транзакции.
+func (storage *Storage) EditEmployee(ctx context.Context, id int, updateEmployee models.EmployeeToUpdate) (updatedEmployee models.Employee, err error) {
+	const operationPlace = "repository.postgres.employee.EditEmployee"
+	selectQuery := `select username from employee where employee_id = $1 for update`
+	updateQuery := `update employee set
+						username = coalesce(@username, username),
+						first_name = coalesce(@first_name, first_name),
+						last_name = coalesce(@last_name, last_name),
+						updated_at = current_timestamp
+					where employee_id = @id
+					returning ` + employeeColumns
+
+	tx, err := storage.connection.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return models.Employee{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			tx.Commit(ctx)
+		}
+	}()
+
+	var oldUsername string
+	err = tx.QueryRow(ctx, selectQuery, id).Scan(&oldUsername)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Employee{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeNotFound)
+		}
+		return models.Employee{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	row := tx.QueryRow(
+		ctx,
+		updateQuery,
+		pgx.NamedArgs{
+			"username":   updateEmployee.Username,
+			"first_name": updateEmployee.FirstName,
+			"last_name":  updateEmployee.LastName,
+			"id":         id,
+		},
+	)
+	updatedEmployee, err = scanEmployee(row)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return models.Employee{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeAlreadyExists)
+		}
+		return models.Employee{}, fmt.Errorf("%s: %w. Place = updateQuery", operationPlace, err)
+	}
+
+	if updatedEmployee.Username != oldUsername {
+		renameArgs := pgx.NamedArgs{"old": oldUsername, "new": updatedEmployee.Username}
+		_, err = tx.Exec(ctx, `update tender set creator_username = @new where creator_username = @old`, renameArgs)
+		if err != nil {
+			return models.Employee{}, fmt.Errorf("%s: %w. Place = rename tender creator", operationPlace, err)
+		}
+		_, err = tx.Exec(ctx, `update tender set modified_by = @new where modified_by = @old`, renameArgs)
+		if err != nil {
+			return models.Employee{}, fmt.Errorf("%s: %w. Place = rename tender modified_by", operationPlace, err)
+		}
+		_, err = tx.Exec(ctx, `update bid set creator_username = @new where creator_username = @old`, renameArgs)
+		if err != nil {
+			return models.Employee{}, fmt.Errorf("%s: %w. Place = rename bid creator", operationPlace, err)
+		}
+	}
+
+	return updatedEmployee, nil
+}
+
+func scanEmployee(row pgx.Row) (models.Employee, error) {
+	var employee models.Employee
+	err := row.Scan(
+		&employee.ID,
+		&employee.Username,
+		&employee.FirstName,
+		&employee.LastName,
+		&employee.CreatedAt,
+		&employee.UpdatedAt,
+	)
+	return employee, err
 }
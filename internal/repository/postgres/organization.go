@@ -10,16 +10,19 @@ import (
 	outerror "github.com/sariya23/tender/internal/out_error"
 )
 
+// organizationColumns колонки организации в порядке scanOrganization.
+// Запросы должны выбирать их из organizationFrom.
+const organizationColumns = `o.organization_id, o.name, coalesce(o.description, ''), t.type, o.created_at, o.updated_at`
+
+const organizationFrom = `organization o join nsi_organization_type t on t.nsi_organization_type_id = o.organization_type_id`
+
 func (storage *Storage) GetOrganizationById(ctx context.Context, orgId int) (models.Organization, error) {
 	const operationPlace = "repository.postgres.organization.GetOrganizationById"
-	queryGetOrg := "select organization_id, name, description, organization_type_id from organization where organization_id = $1"
-	queryGetOrgType := "select type from nsi_organization_type where nsi_organization_type_id = $1"
+	query := `select ` + organizationColumns + ` from ` + organizationFrom + `
+				where o.organization_id = $1 and o.deleted_at is null`
 
-	var organization models.Organization
-	var typeId int
-
-	row := storage.connection.QueryRow(ctx, queryGetOrg, orgId)
-	err := row.Scan(&organization.ID, &organization.Name, &organization.Description, &typeId)
+	row := storage.connection.QueryRow(ctx, query, orgId)
+	organization, err := scanOrganization(row)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return models.Organization{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrOrganizationNotFound)
@@ -28,13 +31,78 @@ func (storage *Storage) GetOrganizationById(ctx context.Context, orgId int) (mod
 		}
 	}
 
-	typeRow := storage.connection.QueryRow(ctx, queryGetOrgType, typeId)
-	err = typeRow.Scan(&organization.Type)
+	return organization, nil
+}
+
+// GetOrganizations возвращает страницу неудаленных организаций, отсортированных по id.
+func (storage *Storage) GetOrganizations(ctx context.Context, page models.Page) (models.OrganizationPage, error) {
+	const operationPlace = "repository.postgres.organization.GetOrganizations"
+	if page.Limit <= 0 {
+		page.Limit = models.DefaultPageLimit
+	}
+
+	countQuery := `select count(*) from organization where deleted_at is null`
+	var total int
+	err := storage.connection.QueryRow(ctx, countQuery).Scan(&total)
 	if err != nil {
-		return models.Organization{}, fmt.Errorf("%s: %w", operationPlace, err)
+		return models.OrganizationPage{}, fmt.Errorf("%s: %w", operationPlace, err)
 	}
 
-	return organization, nil
+	args := pgx.NamedArgs{
+		"limit":    page.Limit + 1,
+		"offset":   page.Offset,
+		"after_id": page.AfterId,
+	}
+	if page.AfterId > 0 {
+		args["offset"] = 0
+	}
+	query := `select ` + organizationColumns + ` from ` + organizationFrom + `
+				where o.deleted_at is null and o.organization_id > @after_id
+				order by o.organization_id
+				limit @limit offset @offset`
+
+	rows, err := storage.connection.Query(ctx, query, args)
+	if err != nil {
+		return models.OrganizationPage{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer rows.Close()
+
+	organizations := []models.Organization{}
+	for rows.Next() {
+		organization, err := scanOrganization(rows)
+		if err != nil {
+			return models.OrganizationPage{}, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		organizations = append(organizations, organization)
+	}
+	if err := rows.Err(); err != nil {
+		return models.OrganizationPage{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	organizationPage := models.OrganizationPage{Organizations: organizations, Total: total}
+	if len(organizations) > page.Limit {
+		organizationPage.Organizations = organizations[:page.Limit]
+		nextCursor := organizationPage.Organizations[page.Limit-1].ID
+		organizationPage.NextCursor = &nextCursor
+	}
+	return organizationPage, nil
+}
+
+// GetOrganizationType возвращает тип организации из справочника nsi_organization_type.
+// Если такого типа нет, то возвращается ErrUnknownOrganizationType.
+func (storage *Storage) GetOrganizationType(ctx context.Context, orgType string) (string, error) {
+	const operationPlace = "repository.postgres.organization.GetOrganizationType"
+	query := "select type from nsi_organization_type where type = $1"
+
+	var foundType string
+	err := storage.connection.QueryRow(ctx, query, orgType).Scan(&foundType)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", fmt.Errorf("%s: %w", operationPlace, outerror.ErrUnknownOrganizationType)
+		}
+		return "", fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return foundType, nil
 }
 
 func (storage *Storage) CheckResponsibility(ctx context.Context, emplId int, orgId int) error {
@@ -55,19 +123,24 @@ func (storage *Storage) CheckResponsibility(ctx context.Context, emplId int, org
 	return nil
 }
 
-func (storage *Storage) CreateOrganization(ctx context.Context, organization models.Organization) error {
+func (storage *Storage) CreateOrganization(ctx context.Context, organization models.Organization) (models.Organization, error) {
 	const operationPlace = "repository.postgres.organization.CreateOrganization"
 	queryGetOrgType := "select nsi_organization_type_id from nsi_organization_type where type = $1"
-	insertOrg := "insert into organization(name, description, organization_type_id) values (@name, @desc, @orgTypeId)"
+	insertOrg := `insert into organization(name, description, organization_type_id) values (@name, @desc, @orgTypeId)
+					returning organization_id`
 	var typeId int
 
 	row := storage.connection.QueryRow(ctx, queryGetOrgType, organization.Type)
 	err := row.Scan(&typeId)
 	if err != nil {
-		return fmt.Errorf("%s: %w", operationPlace, err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Organization{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrUnknownOrganizationType)
+		}
+		return models.Organization{}, fmt.Errorf("%s: %w", operationPlace, err)
 	}
 
-	_, err = storage.connection.Exec(
+	var orgId int
+	err = storage.connection.QueryRow(
 		ctx,
 		insertOrg,
 		pgx.NamedArgs{
@@ -75,10 +148,190 @@ func (storage *Storage) CreateOrganization(ctx context.Context, organization mod
 			"desc":      organization.Description,
 			"orgTypeId": typeId,
 		},
+	).Scan(&orgId)
+	if err != nil {
+		return models.Organization{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	return storage.GetOrganizationById(ctx, orgId)
+}
+
+// EditOrganization обновляет поля организации, которые заданы в updateOrganization.
+func (storage *Storage) EditOrganization(ctx context.Context, orgId int, updateOrganization models.OrganizationToUpdate) (models.Organization, error) {
+	const operationPlace = "repository.postgres.organization.EditOrganization"
+	query := `update organization set
+				name = coalesce(@name, name),
+				description = coalesce(@desc, description),
+				organization_type_id = coalesce(
+					(select nsi_organization_type_id from nsi_organization_type where type = @type),
+					organization_type_id
+				),
+				updated_at = current_timestamp
+				where organization_id = @org_id and deleted_at is null`
+
+	tag, err := storage.connection.Exec(
+		ctx,
+		query,
+		pgx.NamedArgs{
+			"name":   updateOrganization.Name,
+			"desc":   updateOrganization.Description,
+			"type":   updateOrganization.Type,
+			"org_id": orgId,
+		},
 	)
+	if err != nil {
+		return models.Organization{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return models.Organization{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrOrganizationNotFound)
+	}
+
+	return storage.GetOrganizationById(ctx, orgId)
+}
+
+// DeleteOrganization помечает организацию удаленной. Удаленная организация
+// не возвращается другими методами, но ее тендеры и предложения остаются.
+func (storage *Storage) DeleteOrganization(ctx context.Context, orgId int) error {
+	const operationPlace = "repository.postgres.organization.DeleteOrganization"
+	query := `update organization set deleted_at = current_timestamp, updated_at = current_timestamp
+				where organization_id = $1 and deleted_at is null`
+
+	tag, err := storage.connection.Exec(ctx, query, orgId)
 	if err != nil {
 		return fmt.Errorf("%s: %w", operationPlace, err)
 	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrOrganizationNotFound)
+	}
+	return nil
+}
+
+func (storage *Storage) GetOrganizationResponsibles(ctx context.Context, orgId int) ([]models.Employee, error) {
+	const operationPlace = "repository.postgres.organization.GetOrganizationResponsibles"
+	query := `select ` + employeeColumns + ` from employee
+				where employee_id in (select employee_id from organization_responsible where organization_id = $1)
+				order by employee_id`
+
+	employees := []models.Employee{}
+	rows, err := storage.connection.Query(ctx, query, orgId)
+	if err != nil {
+		return []models.Employee{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		employee, err := scanEmployee(rows)
+		if err != nil {
+			return []models.Employee{}, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		employees = append(employees, employee)
+	}
+	if err := rows.Err(); err != nil {
+		return []models.Employee{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	return employees, nil
+}
 
+// AddOrganizationResponsible делает сотрудника ответственным за организацию
+// с ролью role. Если сотрудника нет, то возвращается ErrEmployeeNotFound, если он уже
+// ответственный - ErrEmployeeAlreadyResponsible.
+func (storage *Storage) AddOrganizationResponsible(ctx context.Context, orgId int, emplId int, role string) error {
+	const operationPlace = "repository.postgres.organization.AddOrganizationResponsible"
+	query := `insert into organization_responsible (organization_id, employee_id, role) values ($1, $2, $3)`
+
+	_, err := storage.connection.Exec(ctx, query, orgId, emplId, role)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeAlreadyResponsible)
+		} else if isForeignKeyViolation(err) {
+			return fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeNotFound)
+		}
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
 	return nil
 }
+
+// RemoveOrganizationResponsible снимает с сотрудника ответственность за организацию.
+//
+// Строка организации блокируется на время транзакции, поэтому параллельные
+// снятия и создание тендеров (CreateTender блокирует ту же строку) не оставят
+// организацию с незакрытыми тендерами без ответственных.
+// В этом случае возвращается ErrLastOrganizationResponsible.
+func (storage *Storage) RemoveOrganizationResponsible(ctx context.Context, orgId int, emplId int) (err error) {
+	const operationPlace = "repository.postgres.organization.RemoveOrganizationResponsible"
+	lockOrg := `select organization_id from organization where organization_id = $1 and deleted_at is null for update`
+	deleteResponsible := `delete from organization_responsible where organization_id = $1 and employee_id = $2`
+	countResponsibles := `select count(*) from organization_responsible where organization_id = $1`
+	countLiveTenders := `select count(*) from tender
+							where organization_id = @org_id and is_active_version = @active and status = any(@statuses)`
+
+	tx, err := storage.connection.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			tx.Commit(ctx)
+		}
+	}()
+
+	var lockedOrgId int
+	err = tx.QueryRow(ctx, lockOrg, orgId).Scan(&lockedOrgId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("%s: %w", operationPlace, outerror.ErrOrganizationNotFound)
+		}
+		return fmt.Errorf("%s: %w. Place = lockOrg", operationPlace, err)
+	}
+
+	tag, err := tx.Exec(ctx, deleteResponsible, orgId, emplId)
+	if err != nil {
+		return fmt.Errorf("%s: %w. Place = deleteResponsible", operationPlace, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeNotResponsibleForOrganization)
+	}
+
+	var responsiblesLeft int
+	err = tx.QueryRow(ctx, countResponsibles, orgId).Scan(&responsiblesLeft)
+	if err != nil {
+		return fmt.Errorf("%s: %w. Place = countResponsibles", operationPlace, err)
+	}
+	if responsiblesLeft > 0 {
+		return nil
+	}
+
+	var liveTenders int
+	err = tx.QueryRow(
+		ctx,
+		countLiveTenders,
+		pgx.NamedArgs{
+			"org_id":   orgId,
+			"active":   true,
+			"statuses": []string{models.TenderCreatedStatus, models.TenderPublishedStatus},
+		},
+	).Scan(&liveTenders)
+	if err != nil {
+		return fmt.Errorf("%s: %w. Place = countLiveTenders", operationPlace, err)
+	}
+	if liveTenders > 0 {
+		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrLastOrganizationResponsible)
+	}
+	return nil
+}
+
+func scanOrganization(row pgx.Row) (models.Organization, error) {
+	var organization models.Organization
+	err := row.Scan(
+		&organization.ID,
+		&organization.Name,
+		&organization.Description,
+		&organization.Type,
+		&organization.CreatedAt,
+		&organization.UpdatedAt,
+	)
+	return organization, err
+}
@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+// GetEmployeeRoles возвращает глобальные роли сотрудника.
+func (storage *Storage) GetEmployeeRoles(ctx context.Context, emplId int) ([]string, error) {
+	const operationPlace = "repository.postgres.role.GetEmployeeRoles"
+	query := `select role from employee_role where employee_id = $1 order by role`
+
+	rows, err := storage.connection.Query(ctx, query, emplId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer rows.Close()
+
+	roles := []string{}
+	for rows.Next() {
+		var role string
+		if err := rows.Scan(&role); err != nil {
+			return nil, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		roles = append(roles, role)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	return roles, nil
+}
+
+// GetOrganizationRole возвращает роль сотрудника в организации. Если сотрудник
+// не ответственный за организацию, то возвращается ErrEmployeeNotResponsibleForOrganization.
+func (storage *Storage) GetOrganizationRole(ctx context.Context, emplId int, orgId int) (string, error) {
+	const operationPlace = "repository.postgres.role.GetOrganizationRole"
+	query := `select role from organization_responsible where organization_id = $1 and employee_id = $2`
+
+	var role string
+	err := storage.connection.QueryRow(ctx, query, orgId, emplId).Scan(&role)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeNotResponsibleForOrganization)
+		}
+		return "", fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	return role, nil
+}
@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/sariya23/tender/internal/domain/models"
+)
+
+// searchConfig конфигурация полнотекстового поиска. Должна совпадать
+// с конфигурацией колонки tender.search_vector.
+const searchConfig = "russian"
+
+// escapedSearchText название и описание тендера с экранированными
+// символами HTML. Сниппет отдается как HTML, в котором разметка - только
+// теги <mark>, поэтому текст, который написал пользователь, экранируется
+// до того, как ts_headline добавит теги.
+const escapedSearchText = `replace(replace(replace(replace(replace(
+					name || ' ' || description,
+					'&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), '''', '&#39;')`
+
+// SearchTenders ищет опубликованные тендеры по запросу query в формате
+// websearch_to_tsquery: слова, "фразы в кавычках", or и -исключения.
+// Результаты отсортированы по релевантности.
+func (storage *Storage) SearchTenders(ctx context.Context, query string, page models.Page) (models.TenderSearchPage, error) {
+	const operationPlace = "repository.postgres.search.SearchTenders"
+	if page.Limit <= 0 {
+		page.Limit = models.DefaultPageLimit
+	}
+
+	args := pgx.NamedArgs{
+		"config": searchConfig,
+		"query":  query,
+		"active": true,
+		"status": models.TenderPublishedStatus,
+		"limit":  page.Limit,
+		"offset": page.Offset,
+	}
+	where := `is_active_version = @active and status = @status
+				and search_vector @@ websearch_to_tsquery(@config::regconfig, @query)`
+
+	countQuery := `select count(*) from tender where ` + where
+	var total int
+	err := storage.connection.QueryRow(ctx, countQuery, args).Scan(&total)
+	if err != nil {
+		return models.TenderSearchPage{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	searchQuery := `select ` + tenderColumns + `,
+				ts_rank(search_vector, websearch_to_tsquery(@config::regconfig, @query)) as rank,
+				ts_headline(
+					@config::regconfig,
+					` + escapedSearchText + `,
+					websearch_to_tsquery(@config::regconfig, @query),
+					'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=20, MinWords=5'
+				) as snippet
+				from tender
+				where ` + where + `
+				order by rank desc, tender_id
+				limit @limit offset @offset`
+
+	rows, err := storage.connection.Query(ctx, searchQuery, args)
+	if err != nil {
+		return models.TenderSearchPage{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer rows.Close()
+
+	results := []models.TenderSearchResult{}
+	for rows.Next() {
+		var result models.TenderSearchResult
+		err := rows.Scan(
+			&result.Tender.ID,
+			&result.Tender.Version,
+			&result.Tender.CreatedAt,
+			&result.Tender.UpdatedAt,
+			&result.Tender.TenderName,
+			&result.Tender.Description,
+			&result.Tender.ServiceType,
+			&result.Tender.Status,
+			&result.Tender.OrganizationId,
+			&result.Tender.CreatorUsername,
+			&result.Tender.PublishAt,
+			&result.Tender.Deadline,
+			&result.Rank,
+			&result.Snippet,
+		)
+		if err != nil {
+			return models.TenderSearchPage{}, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		results = append(results, result)
+	}
+	if err := rows.Err(); err != nil {
+		return models.TenderSearchPage{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return models.TenderSearchPage{Results: results, Total: total}, nil
+}
@@ -0,0 +1,127 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/sariya23/tender/internal/domain/models"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+// GetServiceTypes возвращает все типы услуг из справочника nsi_service_type,
+// отсортированные по названию, с количеством опубликованных тендеров.
+func (storage *Storage) GetServiceTypes(ctx context.Context) ([]models.ServiceType, error) {
+	const operationPlace = "repository.postgres.servicetype.GetServiceTypes"
+	query := `select st.nsi_service_type_id, st.type, count(t.tender_id)
+				from nsi_service_type st
+				left join tender t on t.service_type = st.type and t.is_active_version = true and t.status = @status
+				group by st.nsi_service_type_id, st.type
+				order by st.type`
+
+	rows, err := storage.connection.Query(ctx, query, pgx.NamedArgs{"status": models.TenderPublishedStatus})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer rows.Close()
+
+	serviceTypes := []models.ServiceType{}
+	for rows.Next() {
+		var serviceType models.ServiceType
+		err := rows.Scan(&serviceType.ID, &serviceType.Name, &serviceType.PublishedTenders)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		serviceTypes = append(serviceTypes, serviceType)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	return serviceTypes, nil
+}
+
+// GetServiceTypeByName ищет тип услуг по названию без учета регистра.
+// Если такого типа нет, то возвращается ErrUnknownServiceType.
+func (storage *Storage) GetServiceTypeByName(ctx context.Context, name string) (models.ServiceType, error) {
+	const operationPlace = "repository.postgres.servicetype.GetServiceTypeByName"
+	query := `select nsi_service_type_id, type from nsi_service_type where lower(type) = lower($1)`
+
+	row := storage.connection.QueryRow(ctx, query, name)
+	serviceType, err := scanServiceType(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.ServiceType{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrUnknownServiceType)
+		}
+		return models.ServiceType{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	return serviceType, nil
+}
+
+// CreateServiceType добавляет тип услуг в справочник. Если тип с таким
+// названием (без учета регистра) уже есть, то возвращается ErrServiceTypeAlreadyExists.
+func (storage *Storage) CreateServiceType(ctx context.Context, name string) (models.ServiceType, error) {
+	const operationPlace = "repository.postgres.servicetype.CreateServiceType"
+	query := `insert into nsi_service_type (type) values ($1) returning nsi_service_type_id, type`
+
+	row := storage.connection.QueryRow(ctx, query, name)
+	serviceType, err := scanServiceType(row)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return models.ServiceType{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrServiceTypeAlreadyExists)
+		}
+		return models.ServiceType{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	return serviceType, nil
+}
+
+// EditServiceType переименовывает тип услуг. Тендеры с этим типом
+// переходят на новое название. Если типа нет, то возвращается
+// ErrServiceTypeNotFound, если название занято - ErrServiceTypeAlreadyExists.
+func (storage *Storage) EditServiceType(ctx context.Context, id int, name string) (models.ServiceType, error) {
+	const operationPlace = "repository.postgres.servicetype.EditServiceType"
+	query := `update nsi_service_type set type = $1 where nsi_service_type_id = $2 returning nsi_service_type_id, type`
+
+	row := storage.connection.QueryRow(ctx, query, name, id)
+	serviceType, err := scanServiceType(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.ServiceType{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrServiceTypeNotFound)
+		} else if isUniqueViolation(err) {
+			return models.ServiceType{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrServiceTypeAlreadyExists)
+		}
+		return models.ServiceType{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	return serviceType, nil
+}
+
+// DeleteServiceType удаляет тип услуг из справочника. Если типа нет, то
+// возвращается ErrServiceTypeNotFound, если он есть у тендеров (в любой
+// версии) - ErrServiceTypeInUse.
+func (storage *Storage) DeleteServiceType(ctx context.Context, id int) error {
+	const operationPlace = "repository.postgres.servicetype.DeleteServiceType"
+	query := `delete from nsi_service_type where nsi_service_type_id = $1`
+
+	tag, err := storage.connection.Exec(ctx, query, id)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return fmt.Errorf("%s: %w", operationPlace, outerror.ErrServiceTypeInUse)
+		}
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrServiceTypeNotFound)
+	}
+
+	return nil
+}
+
+func scanServiceType(row pgx.Row) (models.ServiceType, error) {
+	var serviceType models.ServiceType
+	err := row.Scan(&serviceType.ID, &serviceType.Name)
+	return serviceType, err
+}
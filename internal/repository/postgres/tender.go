@@ -4,34 +4,27 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/sariya23/tender/internal/domain/models"
 	outerror "github.com/sariya23/tender/internal/out_error"
 )
 
+// createTenderQuery добавляет первую версию тендера.
+// tender_id выдает последовательность tender_id_seq,
+// поэтому параллельные создания не получат одинаковый id.
+const createTenderQuery = `insert into tender (name, description, service_type, status, organization_id, creator_username, version, modified_by, publish_at, deadline)
+						values (@name, @desc, @service_type, @status, @org_id, @username, @version, @username, @publish_at, @deadline)
+						returning ` + tenderColumns
+
+// CreateTender создает первую версию тендера. Организация тендера
+// блокируется до конца транзакции, см. lockTenderOrganization: если
+// организации уже нет или создатель больше не ответственный за нее, то
+// возвращается ErrOrganizationNotFound или ErrEmployeeNotResponsibleForOrganization.
 func (storage *Storage) CreateTender(ctx context.Context, tender models.Tender) (createdTender models.Tender, err error) {
 	const operationPlace = "repository.postgres.tender.CreateTender"
 
-	lastTenderId, err := storage.GetLastInsertedTenderId(ctx)
-	if err != nil {
-		return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, err)
-	}
-	args := pgx.NamedArgs{
-		"tender_id":    lastTenderId + 1,
-		"name":         tender.TenderName,
-		"desc":         tender.Description,
-		"service_type": tender.ServiceType,
-		"status":       tender.Status,
-		"org_id":       tender.OrganizationId,
-		"username":     tender.CreatorUsername,
-		"version":      1,
-	}
-	createQuery := `insert into tender values (@tender_id, @name, @desc, @service_type, @status, @org_id, @username, @version) 
-						returning name, description, service_type, organization_id, creator_username, status
-	`
-	createdTender = models.Tender{}
-
 	tx, err := storage.connection.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
 		return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, err)
@@ -43,150 +36,265 @@ func (storage *Storage) CreateTender(ctx context.Context, tender models.Tender)
 			tx.Commit(ctx)
 		}
 	}()
+	err = lockTenderOrganization(ctx, tx, tender)
+	if err != nil {
+		return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
 	row := tx.QueryRow(
 		ctx,
-		createQuery,
-		args,
-	)
-	err = row.Scan(
-		&createdTender.TenderName,
-		&createdTender.Description,
-		&createdTender.ServiceType,
-		&createdTender.OrganizationId,
-		&createdTender.CreatorUsername,
-		&createdTender.Status,
+		createTenderQuery,
+		createTenderArgs(tender),
 	)
+	createdTender, err = scanTender(row)
 	if err != nil {
 		return models.Tender{}, fmt.Errorf("%s: %w. Place = createQuery", operationPlace, err)
 	}
 	return createdTender, nil
 }
 
-func (storage *Storage) GetAllTenders(ctx context.Context) ([]models.Tender, error) {
-	const operationPlace = "repository.postgres.tender.GetAllTenders"
+// CreateTenders создает тендеры tenders в одной транзакции и возвращает
+// их в том же порядке. Если не удалось создать хотя бы один тендер,
+// то не создается ни один. Организации тендеров блокируются так же,
+// как в CreateTender.
+func (storage *Storage) CreateTenders(ctx context.Context, tenders []models.Tender) (createdTenders []models.Tender, err error) {
+	const operationPlace = "repository.postgres.tender.CreateTenders"
 
-	query := `select name, description, service_type, status, organization_id, creator_username from tender
-				where is_active_version = $1 and status = $2
-	`
-	tenders := []models.Tender{}
-
-	rows, err := storage.connection.Query(ctx, query, true, models.TenderPublishedStatus)
+	tx, err := storage.connection.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
-		return []models.Tender{}, fmt.Errorf("%s: %w", operationPlace, err)
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
 	}
-	defer rows.Close()
-
-	for rows.Next() {
-		tender := models.Tender{}
-		err := rows.Scan(&tender.TenderName, &tender.Description, &tender.ServiceType, &tender.Status, &tender.OrganizationId, &tender.CreatorUsername)
+	defer func() {
 		if err != nil {
-			return []models.Tender{}, fmt.Errorf("%s: %w", operationPlace, err)
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+			if err != nil {
+				createdTenders = nil
+				err = fmt.Errorf("%s: %w", operationPlace, err)
+			}
 		}
+	}()
 
-		tenders = append(tenders, tender)
-
-		if err := rows.Err(); err != nil {
-			return []models.Tender{}, fmt.Errorf("%s: %w", operationPlace, err)
+	batch := &pgx.Batch{}
+	locked := make(map[string]bool)
+	for _, tender := range tenders {
+		key := fmt.Sprintf("%d/%s", tender.OrganizationId, tender.CreatorUsername)
+		if !locked[key] {
+			err = lockTenderOrganization(ctx, tx, tender)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", operationPlace, err)
+			}
+			locked[key] = true
 		}
+		batch.Queue(createTenderQuery, createTenderArgs(tender))
 	}
+	results := tx.SendBatch(ctx, batch)
+	createdTenders = make([]models.Tender, 0, len(tenders))
+	for range tenders {
+		createdTender, err := scanTender(results.QueryRow())
+		if err != nil {
+			results.Close()
+			return nil, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		createdTenders = append(createdTenders, createdTender)
+	}
+	err = results.Close()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return createdTenders, nil
+}
 
-	if len(tenders) == 0 {
-		return []models.Tender{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTendersWithThisServiceTypeNotFound)
+// lockTenderOrganization блокирует строку организации тендера на чтение до
+// конца транзакции tx и проверяет, что создатель тендера все еще ответственный
+// за нее. RemoveOrganizationResponsible блокирует ту же строку на запись,
+// поэтому тендер не появится у организации, с которой сняли последнего ответственного.
+func lockTenderOrganization(ctx context.Context, tx pgx.Tx, tender models.Tender) error {
+	lockOrg := `select organization_id from organization where organization_id = $1 and deleted_at is null for share`
+	checkResponsible := `select exists(
+							select 1 from organization_responsible
+							where organization_id = $1
+								and employee_id = (select employee_id from employee where username = $2)
+						)`
+
+	var lockedOrgId int
+	err := tx.QueryRow(ctx, lockOrg, tender.OrganizationId).Scan(&lockedOrgId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return outerror.ErrOrganizationNotFound
+		}
+		return fmt.Errorf("%w. Place = lockOrg", err)
 	}
 
-	return tenders, nil
+	var responsible bool
+	err = tx.QueryRow(ctx, checkResponsible, tender.OrganizationId, tender.CreatorUsername).Scan(&responsible)
+	if err != nil {
+		return fmt.Errorf("%w. Place = checkResponsible", err)
+	}
+	if !responsible {
+		return outerror.ErrEmployeeNotResponsibleForOrganization
+	}
+	return nil
 }
 
-func (storage *Storage) GetTendersByServiceType(ctx context.Context, serviceType string) ([]models.Tender, error) {
-	const operationPlace = "repository.postgres.tender.GetAllTenders"
-
-	query := `select name, description, service_type, status, organization_id, creator_username
-				from tender
-				where service_type=$1 and is_active_version=$2 and status = $3`
-	tenders := []models.Tender{}
+// createTenderArgs аргументы createTenderQuery для тендера tender.
+func createTenderArgs(tender models.Tender) pgx.NamedArgs {
+	return pgx.NamedArgs{
+		"name":         tender.TenderName,
+		"desc":         tender.Description,
+		"service_type": tender.ServiceType,
+		"status":       tender.Status,
+		"org_id":       tender.OrganizationId,
+		"username":     tender.CreatorUsername,
+		"version":      1,
+		"publish_at":   tender.PublishAt,
+		"deadline":     tender.Deadline,
+	}
+}
 
-	rows, err := storage.connection.Query(ctx, query, serviceType, true, models.TenderPublishedStatus)
+// GetTenders возвращает страницу тендеров, подходящих под фильтр filter.
+func (storage *Storage) GetTenders(ctx context.Context, filter models.TenderFilter, page models.Page) (models.TenderPage, error) {
+	const operationPlace = "repository.postgres.tender.GetTenders"
 
+	where, args := tenderFilterWhere(filter)
+	tenders, err := storage.getTendersPage(ctx, where, args, filter.Sort, page)
 	if err != nil {
-		return []models.Tender{}, fmt.Errorf("%s: %w", operationPlace, err)
+		return models.TenderPage{Tenders: []models.Tender{}}, fmt.Errorf("%s: %w", operationPlace, err)
 	}
-	defer rows.Close()
+	if tenders.Total == 0 {
+		return models.TenderPage{Tenders: []models.Tender{}}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTendersNotFound)
+	}
+	return tenders, nil
+}
 
-	for rows.Next() {
-		tender := models.Tender{}
-		err := rows.Scan(&tender.TenderName, &tender.Description, &tender.ServiceType, &tender.Status, &tender.OrganizationId, &tender.CreatorUsername)
-		if err != nil {
-			return []models.Tender{}, fmt.Errorf("%s: %w", operationPlace, err)
-		}
+func (storage *Storage) GetEmployeeTenders(ctx context.Context, empl models.Employee, page models.Page) (models.TenderPage, error) {
+	const operationPlace = "repository.postgres.tender.GetEmployeeTenders"
 
-		tenders = append(tenders, tender)
+	where := `creator_username = @username and is_active_version = @active`
+	args := pgx.NamedArgs{
+		"username": empl.Username,
+		"active":   true,
+	}
+	tenders, err := storage.getTendersPage(ctx, where, args, models.TenderSort{}, page)
+	if err != nil {
+		return models.TenderPage{Tenders: []models.Tender{}}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if tenders.Total == 0 {
+		return models.TenderPage{Tenders: []models.Tender{}}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeTendersNotFound)
+	}
+	return tenders, nil
+}
 
-		if err := rows.Err(); err != nil {
-			return []models.Tender{}, fmt.Errorf("%s: %w", operationPlace, err)
-		}
+// getTendersPage возвращает страницу тендеров, подходящих под условие where,
+// в порядке sort. Страницу можно получить по offset, а если тендеры
+// отсортированы по id, то и по after_id. Чтобы понять, есть ли следующая
+// страница, запрашивается на один тендер больше, чем limit.
+func (storage *Storage) getTendersPage(ctx context.Context, where string, args pgx.NamedArgs, sort models.TenderSort, page models.Page) (models.TenderPage, error) {
+	const operationPlace = "repository.postgres.tender.getTendersPage"
+	if page.Limit <= 0 {
+		page.Limit = models.DefaultPageLimit
 	}
 
-	if len(tenders) == 0 {
-		return []models.Tender{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTendersWithThisServiceTypeNotFound)
+	countQuery := `select count(*) from tender where ` + where
+	var total int
+	err := storage.connection.QueryRow(ctx, countQuery, args).Scan(&total)
+	if err != nil {
+		return models.TenderPage{}, fmt.Errorf("%s: %w", operationPlace, err)
 	}
 
-	return tenders, nil
-}
-func (storage *Storage) GetEmployeeTenders(ctx context.Context, empl models.Employee) (t []models.Tender, err error) {
-	const operationPlace = "repository.postgres.tender.GetEmployeeTenders"
-	query := `select name, description, service_type, status, organization_id, creator_username 
-				from tender
-				where creator_username = $1 and is_active_version=$2`
-	tenders := []models.Tender{}
+	pageArgs := pgx.NamedArgs{}
+	for k, v := range args {
+		pageArgs[k] = v
+	}
+	pageArgs["limit"] = page.Limit + 1
+	pageWhere := where
+	if page.AfterId > 0 && sort.IsById() {
+		pageWhere += ` and tender_id > @after_id`
+		pageArgs["after_id"] = page.AfterId
+		pageArgs["offset"] = 0
+	} else {
+		pageArgs["offset"] = page.Offset
+	}
+	query := `select ` + tenderColumns + ` from tender
+				where ` + pageWhere + `
+				order by ` + tenderOrderBy(sort) + `
+				limit @limit offset @offset`
 
-	rows, err := storage.connection.Query(ctx, query, empl.Username, true)
+	rows, err := storage.connection.Query(ctx, query, pageArgs)
 	if err != nil {
-		return []models.Tender{}, fmt.Errorf("%s: %w", operationPlace, err)
+		return models.TenderPage{}, fmt.Errorf("%s: %w", operationPlace, err)
 	}
 	defer rows.Close()
 
+	tenders := []models.Tender{}
 	for rows.Next() {
-		tender := models.Tender{}
-		err := rows.Scan(
-			&tender.TenderName,
-			&tender.Description,
-			&tender.ServiceType,
-			&tender.Status,
-			&tender.OrganizationId,
-			&tender.CreatorUsername,
-		)
+		tender, err := scanTender(rows)
 		if err != nil {
-			return []models.Tender{}, fmt.Errorf("%s: %w", operationPlace, err)
+			return models.TenderPage{}, fmt.Errorf("%s: %w", operationPlace, err)
 		}
-
 		tenders = append(tenders, tender)
+	}
+	if err := rows.Err(); err != nil {
+		return models.TenderPage{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
 
-		if err := rows.Err(); err != nil {
-			return []models.Tender{}, fmt.Errorf("%s: %w", operationPlace, err)
+	tenderPage := models.TenderPage{Tenders: tenders, Total: total}
+	if len(tenders) > page.Limit {
+		tenderPage.Tenders = tenders[:page.Limit]
+		if sort.IsById() {
+			nextCursor := tenderPage.Tenders[page.Limit-1].ID
+			tenderPage.NextCursor = &nextCursor
 		}
 	}
-	if len(tenders) == 0 {
-		return []models.Tender{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeTendersNotFound)
-	}
-	return tenders, nil
+	return tenderPage, nil
 }
+
 func (storage *Storage) EditTender(
 	ctx context.Context,
 	oldTender models.Tender,
 	tenderId int,
 	updateTender models.TenderToUpdate,
-) (models.Tender, error) {
+	modifiedBy string,
+) (updatedTender models.Tender, err error) {
 	const operationPlace = "repository.postgres.tender.EditTender"
 
 	insertQuery := `
-	insert into tender values (@tender_id, @name, @desc, @srv_type, @status, @org_id, @username, @version, @is_active_version)
-	returning name, description, service_type, status, organization_id, creator_username`
+	insert into tender (tender_id, name, description, service_type, status, organization_id, creator_username, version, is_active_version, modified_by, publish_at, deadline, created_at)
+	values (
+		@tender_id, @name, @desc, @srv_type, @status, @org_id, @username, @version, @is_active_version, @modified_by, @publish_at, @deadline,
+		(select min(created_at) from tender where tender_id = @tender_id)
+	)
+	returning ` + tenderColumns
 
-	lastTenderVersion, err := storage.getLastTenderVersion(ctx, tenderId)
+	tx, err := storage.connection.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			tx.Commit(ctx)
+		}
+	}()
+
+	// oldTender прочитан до начала транзакции, поэтому проверяем,
+	// что за это время тендер никто не изменил.
+	err = lockActiveTenderVersion(ctx, tx, tenderId, oldTender.Version)
+	if err != nil {
+		return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	lastTenderVersion, err := getLastTenderVersion(ctx, tx, tenderId)
 	if err != nil {
 		return models.Tender{}, fmt.Errorf("%s.getLastTenderVersion: %w", operationPlace, err)
 	}
-	args := pgx.NamedArgs{"is_active_version": true, "version": lastTenderVersion + 1, "tender_id": tenderId}
+	args := pgx.NamedArgs{
+		"is_active_version": true,
+		"version":           lastTenderVersion + 1,
+		"tender_id":         tenderId,
+		"modified_by":       modifiedBy,
+	}
 
 	if newName := updateTender.TenderName; newName == nil {
 		args["name"] = oldTender.TenderName
@@ -224,10 +332,44 @@ func (storage *Storage) EditTender(
 		args["username"] = *newUsername
 	}
 
-	tx, err := storage.connection.BeginTx(ctx, pgx.TxOptions{})
+	if newPublishAt := updateTender.PublishAt; newPublishAt == nil {
+		args["publish_at"] = oldTender.PublishAt
+	} else {
+		args["publish_at"] = *newPublishAt
+	}
+
+	if newDeadline := updateTender.Deadline; newDeadline == nil {
+		args["deadline"] = oldTender.Deadline
+	} else {
+		args["deadline"] = *newDeadline
+	}
+
+	deactivateQuery := "update tender set is_active_version = $1 where tender_id = $2"
+	_, err = tx.Exec(ctx, deactivateQuery, false, tenderId)
+	if err != nil {
+		return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	row := tx.QueryRow(ctx, insertQuery, args)
+	updatedTender, err = scanTender(row)
 	if err != nil {
+		if isUniqueViolation(err) {
+			return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTenderVersionConflict)
+		}
 		return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, err)
 	}
+
+	return updatedTender, nil
+}
+func (storage *Storage) RollbackTender(ctx context.Context, tenderId int, toVersionRollback int, activeVersion int) (err error) {
+	const operationPlace = "repository.postgres.tender.RollbackTender"
+	deactivateVersionQuery := `update tender set is_active_version = $1 where tender_id = $2`
+	rollbackQuery := `update tender set is_active_version = $1, updated_at = CURRENT_TIMESTAMP where tender_id = $2 and version = $3`
+
+	tx, err := storage.connection.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
 	defer func() {
 		if err != nil {
 			tx.Rollback(ctx)
@@ -235,32 +377,45 @@ func (storage *Storage) EditTender(
 			tx.Commit(ctx)
 		}
 	}()
-	deactivateQuery := "update tender set is_active_version = $1 where tender_id = $2"
-	_, err = tx.Exec(ctx, deactivateQuery, false, tenderId)
+
+	err = lockActiveTenderVersion(ctx, tx, tenderId, activeVersion)
 	if err != nil {
-		return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, err)
+		return fmt.Errorf("%s: %w", operationPlace, err)
 	}
 
-	var tender models.Tender
-	row := tx.QueryRow(ctx, insertQuery, args)
-	err = row.Scan(
-		&tender.TenderName,
-		&tender.Description,
-		&tender.ServiceType,
-		&tender.Status,
-		&tender.OrganizationId,
-		&tender.CreatorUsername,
-	)
+	_, err = tx.Exec(ctx, deactivateVersionQuery, false, tenderId)
 	if err != nil {
-		return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, err)
+		return fmt.Errorf("%s: %w", operationPlace, err)
 	}
 
-	return tender, nil
+	_, err = tx.Exec(ctx, rollbackQuery, true, tenderId, toVersionRollback)
+	if err != nil {
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return nil
 }
-func (storage *Storage) RollbackTender(ctx context.Context, tenderId int, toVersionRollback int) (err error) {
-	const operationPlace = "repository.postgres.tender.RollbackTender"
+
+// RollbackTenderAsNewVersion откатывает тендер, копируя версию toVersionRollback
+// в новую версию last+1. История версий остается линейной, а в rolled_back_from
+// новой версии записывается номер версии, на которую откатили.
+func (storage *Storage) RollbackTenderAsNewVersion(
+	ctx context.Context,
+	tenderId int,
+	toVersionRollback int,
+	activeVersion int,
+	modifiedBy string,
+) (err error) {
+	const operationPlace = "repository.postgres.tender.RollbackTenderAsNewVersion"
 	deactivateVersionQuery := `update tender set is_active_version = $1 where tender_id = $2`
-	rollbackQuery := `update tender set is_active_version = $1 where tender_id = $2 and version = $3`
+	rollbackQuery := `insert into tender (
+							tender_id, name, description, service_type, status, organization_id, creator_username, publish_at, deadline,
+							version, is_active_version, modified_by, rolled_back_from, created_at
+						)
+						select tender_id, name, description, service_type, status, organization_id, creator_username, publish_at, deadline,
+							@new_version, true, @modified_by, version,
+							(select min(created_at) from tender where tender_id = @tender_id)
+						from tender
+						where tender_id = @tender_id and version = @version`
 
 	tx, err := storage.connection.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
@@ -274,34 +429,51 @@ func (storage *Storage) RollbackTender(ctx context.Context, tenderId int, toVers
 		}
 	}()
 
+	err = lockActiveTenderVersion(ctx, tx, tenderId, activeVersion)
+	if err != nil {
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	lastVersion, err := getLastTenderVersion(ctx, tx, tenderId)
+	if err != nil {
+		return fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
 	_, err = tx.Exec(ctx, deactivateVersionQuery, false, tenderId)
 	if err != nil {
 		return fmt.Errorf("%s: %w", operationPlace, err)
 	}
 
-	_, err = tx.Exec(ctx, rollbackQuery, true, tenderId, toVersionRollback)
+	tag, err := tx.Exec(
+		ctx,
+		rollbackQuery,
+		pgx.NamedArgs{
+			"new_version": lastVersion + 1,
+			"modified_by": modifiedBy,
+			"tender_id":   tenderId,
+			"version":     toVersionRollback,
+		},
+	)
 	if err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("%s: %w", operationPlace, outerror.ErrTenderVersionConflict)
+		}
 		return fmt.Errorf("%s: %w", operationPlace, err)
 	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", operationPlace, outerror.ErrTenderVersionNotFound)
+	}
 	return nil
 }
+
 func (storage *Storage) GetTenderById(ctx context.Context, tenderId int) (models.Tender, error) {
 	const operationPlace = "repository.postgres.tender.GetTenderById"
-	query := `select name, description, service_type, status, organization_id, creator_username 
+	query := `select ` + tenderColumns + `
 				from tender
 				where tender_id = $1 and is_active_version=$2`
 
-	var tender models.Tender
-
 	row := storage.connection.QueryRow(ctx, query, tenderId, true)
-	err := row.Scan(
-		&tender.TenderName,
-		&tender.Description,
-		&tender.ServiceType,
-		&tender.Status,
-		&tender.OrganizationId,
-		&tender.CreatorUsername,
-	)
+	tender, err := scanTender(row)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTenderNotFound)
@@ -329,29 +501,100 @@ func (storage *Storage) FindTenderVersion(ctx context.Context, tenderId int, ver
 	return nil
 }
 
-func (storage *Storage) GetTenderStatus(ctx context.Context, tenderStatus string) (string, error) {
-	panic("impl me")
+func (storage *Storage) GetTenderVersions(ctx context.Context, tenderId int) ([]models.TenderVersion, error) {
+	const operationPlace = "repository.postgres.tender.GetTenderVersions"
+	query := `select ` + tenderColumns + `, is_active_version, modified_by, rolled_back_from
+				from tender
+				where tender_id = $1
+				order by version`
+	versions := []models.TenderVersion{}
+
+	rows, err := storage.connection.Query(ctx, query, tenderId)
+	if err != nil {
+		return []models.TenderVersion{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		version, err := scanTenderVersion(rows)
+		if err != nil {
+			return []models.TenderVersion{}, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		versions = append(versions, version)
+	}
+	if err := rows.Err(); err != nil {
+		return []models.TenderVersion{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	if len(versions) == 0 {
+		return []models.TenderVersion{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTenderNotFound)
+	}
+	return versions, nil
 }
 
-func (storage *Storage) GetLastInsertedTenderId(ctx context.Context) (int, error) {
-	const operationPlace = "repository.postgres.tender.getLastInsertedTenderId"
-	query := `select tender_id from tender order by tender_id desc limit 1`
-	row := storage.connection.QueryRow(ctx, query)
-	var tenderId int
-	err := row.Scan(&tenderId)
+func (storage *Storage) GetTenderVersion(ctx context.Context, tenderId int, version int) (models.TenderVersion, error) {
+	const operationPlace = "repository.postgres.tender.GetTenderVersion"
+	query := `select ` + tenderColumns + `, is_active_version, modified_by, rolled_back_from
+				from tender
+				where tender_id = $1 and version = $2`
+
+	row := storage.connection.QueryRow(ctx, query, tenderId, version)
+	tenderVersion, err := scanTenderVersion(row)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return 0, nil
+			return models.TenderVersion{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTenderVersionNotFound)
 		}
-		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+		return models.TenderVersion{}, fmt.Errorf("%s: %w", operationPlace, err)
 	}
-	return tenderId, nil
+	return tenderVersion, nil
 }
 
-func (storage *Storage) getLastTenderVersion(ctx context.Context, tenderId int) (int, error) {
+// GetDueTenders возвращает активные версии тендеров, которые пора
+// опубликовать (CREATED и publish_at <= now) или закрыть (PUBLISHED и deadline <= now).
+func (storage *Storage) GetDueTenders(ctx context.Context, now time.Time) ([]models.Tender, error) {
+	const operationPlace = "repository.postgres.tender.GetDueTenders"
+	query := `select ` + tenderColumns + `
+				from tender
+				where is_active_version = true
+					and ((status = @created and publish_at <= @now) or (status = @published and deadline <= @now))
+				order by tender_id`
+	tenders := []models.Tender{}
+
+	rows, err := storage.connection.Query(
+		ctx,
+		query,
+		pgx.NamedArgs{
+			"created":   models.TenderCreatedStatus,
+			"published": models.TenderPublishedStatus,
+			"now":       now,
+		},
+	)
+	if err != nil {
+		return []models.Tender{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		tender, err := scanTender(rows)
+		if err != nil {
+			return []models.Tender{}, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		tenders = append(tenders, tender)
+	}
+	if err := rows.Err(); err != nil {
+		return []models.Tender{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return tenders, nil
+}
+
+func (storage *Storage) GetTenderStatus(ctx context.Context, tenderStatus string) (string, error) {
+	panic("impl me")
+}
+
+func getLastTenderVersion(ctx context.Context, tx pgx.Tx, tenderId int) (int, error) {
 	const operationPlace = "repository.postgres.tender.getLastTenderVersion"
 	query := "select version from tender where tender_id = $1 order by version desc limit 1"
-	row := storage.connection.QueryRow(ctx, query, tenderId)
+	row := tx.QueryRow(ctx, query, tenderId)
 	var version int
 	err := row.Scan(&version)
 	if err != nil {
@@ -359,3 +602,65 @@ func (storage *Storage) getLastTenderVersion(ctx context.Context, tenderId int)
 	}
 	return version, nil
 }
+
+// lockActiveTenderVersion блокирует активную версию тендера до конца транзакции.
+// Если expectedVersion не 0 и активная версия другая, то возвращается ErrTenderVersionConflict.
+func lockActiveTenderVersion(ctx context.Context, tx pgx.Tx, tenderId int, expectedVersion int) error {
+	query := `select version from tender where tender_id = $1 and is_active_version = $2 for update`
+	var activeVersion int
+	err := tx.QueryRow(ctx, query, tenderId, true).Scan(&activeVersion)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return outerror.ErrTenderNotFound
+		}
+		return err
+	}
+	if expectedVersion != 0 && activeVersion != expectedVersion {
+		return outerror.ErrTenderVersionConflict
+	}
+	return nil
+}
+
+// tenderColumns колонки тендера в порядке, который ожидает scanTender.
+const tenderColumns = `tender_id, version, created_at, updated_at, name, description, service_type, status, organization_id, creator_username, publish_at, deadline`
+
+func scanTender(row pgx.Row) (models.Tender, error) {
+	var tender models.Tender
+	err := row.Scan(
+		&tender.ID,
+		&tender.Version,
+		&tender.CreatedAt,
+		&tender.UpdatedAt,
+		&tender.TenderName,
+		&tender.Description,
+		&tender.ServiceType,
+		&tender.Status,
+		&tender.OrganizationId,
+		&tender.CreatorUsername,
+		&tender.PublishAt,
+		&tender.Deadline,
+	)
+	return tender, err
+}
+
+func scanTenderVersion(row pgx.Row) (models.TenderVersion, error) {
+	var version models.TenderVersion
+	err := row.Scan(
+		&version.ID,
+		&version.Version,
+		&version.CreatedAt,
+		&version.UpdatedAt,
+		&version.TenderName,
+		&version.Description,
+		&version.ServiceType,
+		&version.Status,
+		&version.OrganizationId,
+		&version.CreatorUsername,
+		&version.PublishAt,
+		&version.Deadline,
+		&version.IsActiveVersion,
+		&version.ModifiedBy,
+		&version.RolledBackFrom,
+	)
+	return version, err
+}
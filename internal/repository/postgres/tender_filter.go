@@ -0,0 +1,113 @@
+package postgres
+
+import (
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/sariya23/tender/internal/domain/models"
+)
+
+// tenderSortColumns колонки, по которым разрешено сортировать тендеры.
+// Значение сортировки из запроса никогда не попадает в SQL напрямую.
+var tenderSortColumns = map[string]string{
+	"":                             "tender_id",
+	models.TenderSortById:          "tender_id",
+	models.TenderSortByName:        "name",
+	models.TenderSortByCreatedAt:   "created_at",
+	models.TenderSortByServiceType: "service_type",
+}
+
+// likeEscaper экранирует спецсимволы like, чтобы подстрока
+// из запроса искалась как есть.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// whereBuilder собирает условие where из частей. Значения
+// передаются только через именованные параметры.
+type whereBuilder struct {
+	conditions []string
+	args       pgx.NamedArgs
+}
+
+func newWhereBuilder() *whereBuilder {
+	return &whereBuilder{args: pgx.NamedArgs{}}
+}
+
+// add добавляет условие и значения параметров, которые в нем используются.
+func (builder *whereBuilder) add(condition string, args pgx.NamedArgs) {
+	builder.conditions = append(builder.conditions, condition)
+	for k, v := range args {
+		builder.args[k] = v
+	}
+}
+
+func (builder *whereBuilder) build() (string, pgx.NamedArgs) {
+	return strings.Join(builder.conditions, " and "), builder.args
+}
+
+// tenderFilterWhere переводит фильтр тендеров в условие where.
+// Если статусы не указаны, то возвращаются только опубликованные тендеры.
+func tenderFilterWhere(filter models.TenderFilter) (string, pgx.NamedArgs) {
+	builder := newWhereBuilder()
+	builder.add(`is_active_version = @active`, pgx.NamedArgs{"active": true})
+
+	if len(filter.ServiceTypes) > 0 {
+		// Типы услуг в справочнике уникальны без учета регистра,
+		// поэтому и фильтр по ним регистр не учитывает.
+		serviceTypes := make([]string, 0, len(filter.ServiceTypes))
+		for _, serviceType := range filter.ServiceTypes {
+			serviceTypes = append(serviceTypes, strings.ToLower(serviceType))
+		}
+		builder.add(`lower(service_type) = any(@service_types)`, pgx.NamedArgs{"service_types": serviceTypes})
+	}
+	if filter.OrganizationId > 0 {
+		builder.add(`organization_id = @org_id`, pgx.NamedArgs{"org_id": filter.OrganizationId})
+	}
+	if filter.CreatorUsername != "" {
+		builder.add(`creator_username = @creator_username`, pgx.NamedArgs{"creator_username": filter.CreatorUsername})
+	}
+
+	statuses := filter.Statuses
+	if len(statuses) == 0 {
+		statuses = []string{models.TenderPublishedStatus}
+	}
+	builder.add(`status = any(@statuses)`, pgx.NamedArgs{"statuses": statuses})
+	switch {
+	case filter.ViewAll:
+		// Видны тендеры всех сотрудников, например, аудитору.
+	case filter.Viewer != "":
+		builder.add(
+			`(status = @published or creator_username = @viewer)`,
+			pgx.NamedArgs{"published": models.TenderPublishedStatus, "viewer": filter.Viewer},
+		)
+	default:
+		builder.add(`status = @published`, pgx.NamedArgs{"published": models.TenderPublishedStatus})
+	}
+
+	if filter.NameContains != "" {
+		builder.add(`name ilike @name_pattern`, pgx.NamedArgs{"name_pattern": "%" + likeEscaper.Replace(filter.NameContains) + "%"})
+	}
+	if filter.CreatedFrom != nil {
+		builder.add(`created_at >= @created_from`, pgx.NamedArgs{"created_from": *filter.CreatedFrom})
+	}
+	if filter.CreatedTo != nil {
+		builder.add(`created_at <= @created_to`, pgx.NamedArgs{"created_to": *filter.CreatedTo})
+	}
+	return builder.build()
+}
+
+// tenderOrderBy возвращает выражение order by для сортировки тендеров.
+// При равных значениях тендеры упорядочены по id.
+func tenderOrderBy(sort models.TenderSort) string {
+	column, ok := tenderSortColumns[sort.Field]
+	if !ok {
+		column = tenderSortColumns[""]
+	}
+	direction := "asc"
+	if sort.Desc {
+		direction = "desc"
+	}
+	if column == "tender_id" {
+		return column + " " + direction
+	}
+	return column + " " + direction + ", tender_id"
+}
@@ -0,0 +1,123 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/sariya23/tender/internal/domain/models"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+// VoteTenderClose учитывает голос за закрытие активной версии тендера.
+//
+// Все действия выполняются в одной транзакции с блокировкой активной версии тендера:
+// вето сбрасывает все голоса текущего раунда, а голос, которым набирается кворум,
+// создает новую версию тендера со статусом CLOSED.
+func (storage *Storage) VoteTenderClose(
+	ctx context.Context,
+	tenderId int,
+	vote models.TenderCloseVote,
+	quorum int,
+) (voting models.TenderCloseVoting, err error) {
+	const operationPlace = "repository.postgres.vote.VoteTenderClose"
+	lockTenderQuery := `select version, status from tender
+							where tender_id = $1 and is_active_version = $2
+							for update`
+	insertVoteQuery := `insert into tender_close_vote (tender_id, tender_version, employee_id, decision)
+							values ($1, $2, $3, $4)
+							on conflict on constraint unique_tender_close_vote do nothing`
+	resetVotesQuery := `delete from tender_close_vote where tender_id = $1 and tender_version = $2`
+	countApprovalsQuery := `select count(*) from tender_close_vote
+								where tender_id = $1 and tender_version = $2 and decision = $3`
+	deactivateQuery := `update tender set is_active_version = $1 where tender_id = $2`
+	closeQuery := `insert into tender (tender_id, name, description, service_type, status, organization_id, creator_username, publish_at, deadline, version, is_active_version, created_at, modified_by)
+						select tender_id, name, description, service_type, @status, organization_id, creator_username, publish_at, deadline, @new_version, true, created_at,
+							(select username from employee where employee_id = @employee_id)
+						from tender
+						where tender_id = @tender_id and version = @version
+						returning ` + tenderColumns
+
+	voting = models.TenderCloseVoting{Quorum: quorum}
+
+	tx, err := storage.connection.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return models.TenderCloseVoting{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			tx.Commit(ctx)
+		}
+	}()
+
+	var version int
+	var status string
+	err = tx.QueryRow(ctx, lockTenderQuery, tenderId, true).Scan(&version, &status)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.TenderCloseVoting{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTenderNotFound)
+		}
+		return models.TenderCloseVoting{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if status != models.TenderPublishedStatus {
+		return models.TenderCloseVoting{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTenderNotPublished)
+	}
+
+	tag, err := tx.Exec(ctx, insertVoteQuery, tenderId, version, vote.EmployeeId, vote.Decision)
+	if err != nil {
+		return models.TenderCloseVoting{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return models.TenderCloseVoting{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeAlreadyVoted)
+	}
+
+	if vote.Decision == models.CloseVoteReject {
+		_, err = tx.Exec(ctx, resetVotesQuery, tenderId, version)
+		if err != nil {
+			return models.TenderCloseVoting{}, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		voting.Status = models.CloseVotingRejected
+		return voting, nil
+	}
+
+	err = tx.QueryRow(ctx, countApprovalsQuery, tenderId, version, models.CloseVoteApprove).Scan(&voting.Approvals)
+	if err != nil {
+		return models.TenderCloseVoting{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if voting.Approvals < quorum {
+		voting.Status = models.CloseVotingPending
+		return voting, nil
+	}
+
+	var lastVersion int
+	err = tx.QueryRow(ctx, "select max(version) from tender where tender_id = $1", tenderId).Scan(&lastVersion)
+	if err != nil {
+		return models.TenderCloseVoting{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	_, err = tx.Exec(ctx, deactivateQuery, false, tenderId)
+	if err != nil {
+		return models.TenderCloseVoting{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	closedTender, err := scanTender(tx.QueryRow(
+		ctx,
+		closeQuery,
+		pgx.NamedArgs{
+			"status":      models.TenderClosedStatus,
+			"new_version": lastVersion + 1,
+			"tender_id":   tenderId,
+			"version":     version,
+			"employee_id": vote.EmployeeId,
+		},
+	))
+	if err != nil {
+		return models.TenderCloseVoting{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	voting.Status = models.CloseVotingClosed
+	voting.Tender = &closedTender
+	return voting, nil
+}
@@ -0,0 +1,24 @@
+package route
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+)
+
+type APIKeyServicer interface {
+	GetAPIKeys(ctx context.Context) gin.HandlerFunc
+	IssueAPIKey(ctx context.Context) gin.HandlerFunc
+	RevokeAPIKey(ctx context.Context) gin.HandlerFunc
+}
+
+// AddAPIKeyRoutes добавляет эндпоинты управления API ключами.
+// Ключами управляет сотрудник с токеном, сами ключи сюда не пускаются.
+func AddAPIKeyRoutes(ctx context.Context, ak APIKeyServicer, auth Authenticator, r *gin.RouterGroup) {
+	apiKey := r.Group("/api-keys", auth.Required(), auth.RejectAPIKey())
+	{
+		apiKey.GET("/", ak.GetAPIKeys(ctx))
+		apiKey.POST("/new", ak.IssueAPIKey(ctx))
+		apiKey.DELETE("/:apiKeyId", ak.RevokeAPIKey(ctx))
+	}
+}
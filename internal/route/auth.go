@@ -0,0 +1,13 @@
+package route
+
+import "github.com/gin-gonic/gin"
+
+// Authenticator возвращает middleware аутентификации.
+// Required требует токен или API ключ, Optional пропускает анонимные запросы.
+// RequireScope проверяет права API ключа, RejectAPIKey запрещает API ключи.
+type Authenticator interface {
+	Required() gin.HandlerFunc
+	Optional() gin.HandlerFunc
+	RequireScope(scope string) gin.HandlerFunc
+	RejectAPIKey() gin.HandlerFunc
+}
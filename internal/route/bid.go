@@ -0,0 +1,26 @@
+package route
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BidServicer interface {
+	CreateBid(ctx context.Context) gin.HandlerFunc
+	GetTenderBids(ctx context.Context) gin.HandlerFunc
+	GetEmployeeBidsByUsername(ctx context.Context) gin.HandlerFunc
+	EditBid(ctx context.Context) gin.HandlerFunc
+	RollbackBid(ctx context.Context) gin.HandlerFunc
+}
+
+func AddBidRoutes(ctx context.Context, bd BidServicer, auth Authenticator, r *gin.RouterGroup) {
+	bid := r.Group("/bids", auth.Required(), auth.RejectAPIKey())
+	{
+		bid.POST("/new", bd.CreateBid(ctx))
+		bid.GET("/my", bd.GetEmployeeBidsByUsername(ctx))
+		bid.GET("/tender/:tenderId/list", bd.GetTenderBids(ctx))
+		bid.PATCH("/:bidId/edit", bd.EditBid(ctx))
+		bid.PUT("/:bidId/rollback/:version", bd.RollbackBid(ctx))
+	}
+}
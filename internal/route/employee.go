@@ -0,0 +1,29 @@
+package route
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+)
+
+type EmployeeServicer interface {
+	CreateEmployee(ctx context.Context) gin.HandlerFunc
+	GetEmployee(ctx context.Context) gin.HandlerFunc
+	GetEmployeeByUsername(ctx context.Context) gin.HandlerFunc
+	GetEmployees(ctx context.Context) gin.HandlerFunc
+	EditEmployee(ctx context.Context) gin.HandlerFunc
+}
+
+// AddEmployeeRoutes добавляет эндпоинты сотрудников. Смотреть
+// сотрудников может любой, создавать и менять - только сотрудник
+// с токеном, API ключи сюда не пускаются.
+func AddEmployeeRoutes(ctx context.Context, emp EmployeeServicer, auth Authenticator, r *gin.RouterGroup) {
+	employee := r.Group("/employees")
+	{
+		employee.GET("/", emp.GetEmployees(ctx))
+		employee.POST("/new", auth.Required(), auth.RejectAPIKey(), emp.CreateEmployee(ctx))
+		employee.GET("/by-username/:username", emp.GetEmployeeByUsername(ctx))
+		employee.GET("/:employeeId", emp.GetEmployee(ctx))
+		employee.PATCH("/:employeeId/edit", auth.Required(), auth.RejectAPIKey(), emp.EditEmployee(ctx))
+	}
+}
@@ -0,0 +1,35 @@
+package route
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+)
+
+type OrganizationServicer interface {
+	CreateOrganization(ctx context.Context) gin.HandlerFunc
+	GetOrganization(ctx context.Context) gin.HandlerFunc
+	GetOrganizations(ctx context.Context) gin.HandlerFunc
+	EditOrganization(ctx context.Context) gin.HandlerFunc
+	DeleteOrganization(ctx context.Context) gin.HandlerFunc
+	GetResponsibles(ctx context.Context) gin.HandlerFunc
+	GrantResponsibility(ctx context.Context) gin.HandlerFunc
+	RevokeResponsibility(ctx context.Context) gin.HandlerFunc
+}
+
+// AddOrganizationRoutes добавляет эндпоинты организаций. Смотреть
+// организации может любой, менять их и ответственных - только
+// сотрудник с токеном, API ключи сюда не пускаются.
+func AddOrganizationRoutes(ctx context.Context, org OrganizationServicer, auth Authenticator, r *gin.RouterGroup) {
+	organization := r.Group("/organizations")
+	{
+		organization.GET("/", org.GetOrganizations(ctx))
+		organization.POST("/new", auth.Required(), auth.RejectAPIKey(), org.CreateOrganization(ctx))
+		organization.GET("/:organizationId", org.GetOrganization(ctx))
+		organization.PATCH("/:organizationId/edit", auth.Required(), auth.RejectAPIKey(), org.EditOrganization(ctx))
+		organization.DELETE("/:organizationId", auth.Required(), auth.RejectAPIKey(), org.DeleteOrganization(ctx))
+		organization.GET("/:organizationId/responsibles", org.GetResponsibles(ctx))
+		organization.POST("/:organizationId/responsibles", auth.Required(), auth.RejectAPIKey(), org.GrantResponsibility(ctx))
+		organization.DELETE("/:organizationId/responsibles/:employeeId", auth.Required(), auth.RejectAPIKey(), org.RevokeResponsibility(ctx))
+	}
+}
@@ -0,0 +1,27 @@
+package route
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ServiceTypeServicer interface {
+	GetServiceTypes(ctx context.Context) gin.HandlerFunc
+	CreateServiceType(ctx context.Context) gin.HandlerFunc
+	EditServiceType(ctx context.Context) gin.HandlerFunc
+	DeleteServiceType(ctx context.Context) gin.HandlerFunc
+}
+
+// AddServiceTypeRoutes добавляет эндпоинты справочника типов услуг.
+// Список доступен всем, справочник ведет сотрудник с токеном
+// (право системного администратора проверяет сервис).
+func AddServiceTypeRoutes(ctx context.Context, st ServiceTypeServicer, auth Authenticator, r *gin.RouterGroup) {
+	serviceType := r.Group("/service-types")
+	{
+		serviceType.GET("/", st.GetServiceTypes(ctx))
+		serviceType.POST("/new", auth.Required(), auth.RejectAPIKey(), st.CreateServiceType(ctx))
+		serviceType.PATCH("/:serviceTypeId/edit", auth.Required(), auth.RejectAPIKey(), st.EditServiceType(ctx))
+		serviceType.DELETE("/:serviceTypeId", auth.Required(), auth.RejectAPIKey(), st.DeleteServiceType(ctx))
+	}
+}
@@ -4,23 +4,45 @@ import (
 	"context"
 
 	"github.com/gin-gonic/gin"
+	"github.com/sariya23/tender/internal/domain/models"
 )
 
 type TenderServicer interface {
 	GetTenders(ctx context.Context) gin.HandlerFunc
 	GetEmployeeTendersByUsername(ctx context.Context) gin.HandlerFunc
+	GetOrganizationTenders(ctx context.Context) gin.HandlerFunc
+	SearchTenders(ctx context.Context) gin.HandlerFunc
 	CreateTender(ctx context.Context) gin.HandlerFunc
+	ImportTenders(ctx context.Context) gin.HandlerFunc
 	EditTender(ctx context.Context) gin.HandlerFunc
 	RollbackTender(ctx context.Context) gin.HandlerFunc
+	VoteCloseTender(ctx context.Context) gin.HandlerFunc
+	GetTenderVersions(ctx context.Context) gin.HandlerFunc
+	GetTenderVersion(ctx context.Context) gin.HandlerFunc
+	DiffTenderVersions(ctx context.Context) gin.HandlerFunc
+	GetTenderStatus(ctx context.Context) gin.HandlerFunc
+	SetTenderStatus(ctx context.Context) gin.HandlerFunc
 }
 
-func AddTenderRoutes(ctx context.Context, tn TenderServicer, r *gin.RouterGroup) {
+func AddTenderRoutes(ctx context.Context, tn TenderServicer, auth Authenticator, r *gin.RouterGroup) {
 	tender := r.Group("/tenders")
+	read := auth.RequireScope(models.ScopeTendersRead)
+	write := auth.RequireScope(models.ScopeTendersWrite)
+	rollback := auth.RequireScope(models.ScopeTendersRollback)
 	{
-		tender.GET("/", tn.GetTenders(ctx))
-		tender.GET("/my", tn.GetEmployeeTendersByUsername(ctx))
-		tender.POST("/new", tn.CreateTender(ctx))
-		tender.PATCH("/:tenderId/edit", tn.EditTender(ctx))
-		tender.PUT("/:tenderId/rollback/:version", tn.RollbackTender(ctx))
+		tender.GET("/", auth.Optional(), read, tn.GetTenders(ctx))
+		tender.GET("/my", auth.Required(), read, tn.GetEmployeeTendersByUsername(ctx))
+		tender.GET("/search", auth.Optional(), read, tn.SearchTenders(ctx))
+		tender.POST("/new", auth.Required(), write, tn.CreateTender(ctx))
+		tender.POST("/import", auth.Required(), write, tn.ImportTenders(ctx))
+		tender.PATCH("/:tenderId/edit", auth.Required(), write, tn.EditTender(ctx))
+		tender.PUT("/:tenderId/rollback/:version", auth.Required(), rollback, tn.RollbackTender(ctx))
+		tender.PUT("/:tenderId/close/vote", auth.Required(), write, tn.VoteCloseTender(ctx))
+		tender.GET("/:tenderId/versions", auth.Required(), read, tn.GetTenderVersions(ctx))
+		tender.GET("/:tenderId/versions/:version", auth.Required(), read, tn.GetTenderVersion(ctx))
+		tender.GET("/:tenderId/diff", auth.Required(), read, tn.DiffTenderVersions(ctx))
+		tender.GET("/:tenderId/status", auth.Required(), read, tn.GetTenderStatus(ctx))
+		tender.PUT("/:tenderId/status", auth.Required(), write, tn.SetTenderStatus(ctx))
 	}
+	r.GET("/organizations/:organizationId/tenders", auth.Required(), read, tn.GetOrganizationTenders(ctx))
 }
@@ -0,0 +1,82 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/lib/keygen"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+// IssueAPIKey выпускает API ключ сотрудника employeeId с названием name
+// и правами scopes. Ключ возвращается только здесь, хранится лишь его хеш.
+func (apiKeySrv *APIKeyService) IssueAPIKey(ctx context.Context, employeeId int, name string, scopes []string) (models.APIKey, string, error) {
+	const operationPlace = "internal.service.apikey.apikey.IssueAPIKey"
+	logger := apiKeySrv.logger.With("op", operationPlace)
+
+	uniqueScopes := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if !models.IsKnownScope(scope) {
+			logger.Warn("unknown scope", slog.String("scope", scope))
+			return models.APIKey{}, "", fmt.Errorf("%s: %w", operationPlace, outerror.ErrUnknownAPIKeyScope)
+		}
+		if !slices.Contains(uniqueScopes, scope) {
+			uniqueScopes = append(uniqueScopes, scope)
+		}
+	}
+
+	key, prefix, err := keygen.NewAPIKey()
+	if err != nil {
+		logger.Error("cannot generate api key", slog.String("err", err.Error()))
+		return models.APIKey{}, "", fmt.Errorf("cannot generate api key: %w", err)
+	}
+
+	apiKey := models.APIKey{EmployeeId: employeeId, Name: name, Prefix: prefix, Scopes: uniqueScopes}
+	createdAPIKey, err := apiKeySrv.apiKeyRepo.CreateAPIKey(ctx, apiKey, keygen.Hash(key))
+	if err != nil {
+		if errors.Is(err, outerror.ErrEmployeeNotFound) {
+			logger.Warn("employee not found", slog.Int("employee id", employeeId))
+			return models.APIKey{}, "", fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeNotFound)
+		}
+		logger.Error("cannot create api key", slog.String("err", err.Error()))
+		return models.APIKey{}, "", fmt.Errorf("cannot create api key: %w", err)
+	}
+	logger.Info("api key issued", slog.Int("api key id", createdAPIKey.ID), slog.Int("employee id", employeeId))
+	return createdAPIKey, key, nil
+}
+
+// GetAPIKeys возвращает API ключи сотрудника, включая отозванные.
+func (apiKeySrv *APIKeyService) GetAPIKeys(ctx context.Context, employeeId int) ([]models.APIKey, error) {
+	const operationPlace = "internal.service.apikey.apikey.GetAPIKeys"
+	logger := apiKeySrv.logger.With("op", operationPlace)
+
+	apiKeys, err := apiKeySrv.apiKeyRepo.GetAPIKeys(ctx, employeeId)
+	if err != nil {
+		logger.Error("cannot get api keys", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("cannot get api keys: %w", err)
+	}
+	logger.Info("success get api keys", slog.Int("employee id", employeeId))
+	return apiKeys, nil
+}
+
+// RevokeAPIKey отзывает действующий API ключ сотрудника.
+func (apiKeySrv *APIKeyService) RevokeAPIKey(ctx context.Context, employeeId int, apiKeyId int) (models.APIKey, error) {
+	const operationPlace = "internal.service.apikey.apikey.RevokeAPIKey"
+	logger := apiKeySrv.logger.With("op", operationPlace)
+
+	revokedAPIKey, err := apiKeySrv.apiKeyRepo.RevokeAPIKey(ctx, employeeId, apiKeyId)
+	if err != nil {
+		if errors.Is(err, outerror.ErrAPIKeyNotFound) {
+			logger.Warn("api key not found", slog.Int("api key id", apiKeyId), slog.Int("employee id", employeeId))
+			return models.APIKey{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrAPIKeyNotFound)
+		}
+		logger.Error("cannot revoke api key", slog.String("err", err.Error()))
+		return models.APIKey{}, fmt.Errorf("cannot revoke api key: %w", err)
+	}
+	logger.Info("api key revoked", slog.Int("api key id", apiKeyId))
+	return revokedAPIKey, nil
+}
@@ -0,0 +1,43 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockAPIKeyManager реализует интерфейс APIKeyManager
+// для целей тестирования. Он позволяет задавать ожидаемые результаты
+// методов:
+//
+// - GetAPIKeyByHash
+//
+// - CreateAPIKey
+//
+// - GetAPIKeys
+//
+// - RevokeAPIKey
+type MockAPIKeyManager struct {
+	mock.Mock
+}
+
+func (m *MockAPIKeyManager) GetAPIKeyByHash(ctx context.Context, keyHash string) (models.APIKey, error) {
+	args := m.Called(ctx, keyHash)
+	return args.Get(0).(models.APIKey), args.Error(1)
+}
+
+func (m *MockAPIKeyManager) CreateAPIKey(ctx context.Context, apiKey models.APIKey, keyHash string) (models.APIKey, error) {
+	args := m.Called(ctx, apiKey, keyHash)
+	return args.Get(0).(models.APIKey), args.Error(1)
+}
+
+func (m *MockAPIKeyManager) GetAPIKeys(ctx context.Context, employeeId int) ([]models.APIKey, error) {
+	args := m.Called(ctx, employeeId)
+	return args.Get(0).([]models.APIKey), args.Error(1)
+}
+
+func (m *MockAPIKeyManager) RevokeAPIKey(ctx context.Context, employeeId int, apiKeyId int) (models.APIKey, error) {
+	args := m.Called(ctx, employeeId, apiKeyId)
+	return args.Get(0).(models.APIKey), args.Error(1)
+}
@@ -0,0 +1,20 @@
+package apikey
+
+import (
+	"log/slog"
+
+	"github.com/sariya23/tender/internal/repository"
+)
+
+// APIKeyService позволяет выпускать и отзывать API ключи сотрудников.
+type APIKeyService struct {
+	logger     *slog.Logger
+	apiKeyRepo repository.APIKeyManager
+}
+
+func New(logger *slog.Logger, apiKeyRepo repository.APIKeyManager) *APIKeyService {
+	return &APIKeyService{
+		logger:     logger,
+		apiKeyRepo: apiKeyRepo,
+	}
+}
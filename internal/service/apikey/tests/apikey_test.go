@@ -0,0 +1,117 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/lib/keygen"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/sariya23/tender/internal/service/apikey"
+	"github.com/sariya23/tender/internal/service/apikey/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestIssueAPIKey_Success проверяет, что в хранилище попадает
+// хеш выпущенного ключа, а повторяющиеся права схлопываются.
+func TestIssueAPIKey_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockAPIKeyRepo := new(mocks.MockAPIKeyManager)
+	logger := slogdiscard.NewDiscardLogger()
+	apiKeyService := apikey.New(logger, mockAPIKeyRepo)
+	var savedAPIKey models.APIKey
+	var savedHash string
+	mockAPIKeyRepo.On("CreateAPIKey", ctx, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			savedAPIKey = args.Get(1).(models.APIKey)
+			savedHash = args.Get(2).(string)
+		}).
+		Return(models.APIKey{ID: 1, EmployeeId: 2, Name: "erp"}, nil)
+
+	// Act
+	createdAPIKey, key, err := apiKeyService.IssueAPIKey(ctx, 2, "erp", []string{models.ScopeTendersRead, models.ScopeTendersWrite, models.ScopeTendersRead})
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, models.APIKey{ID: 1, EmployeeId: 2, Name: "erp"}, createdAPIKey)
+	require.Equal(t, keygen.Hash(key), savedHash)
+	require.Equal(t, []string{models.ScopeTendersRead, models.ScopeTendersWrite}, savedAPIKey.Scopes)
+	require.Equal(t, key[:keygen.PrefixLength], savedAPIKey.Prefix)
+	require.Equal(t, 2, savedAPIKey.EmployeeId)
+}
+
+// TestIssueAPIKey_FailUnknownScope проверяет, что ключ с неизвестным
+// правом не выпускается.
+func TestIssueAPIKey_FailUnknownScope(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockAPIKeyRepo := new(mocks.MockAPIKeyManager)
+	logger := slogdiscard.NewDiscardLogger()
+	apiKeyService := apikey.New(logger, mockAPIKeyRepo)
+
+	// Act
+	createdAPIKey, key, err := apiKeyService.IssueAPIKey(ctx, 2, "erp", []string{"bids:write"})
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrUnknownAPIKeyScope)
+	require.Equal(t, models.APIKey{}, createdAPIKey)
+	require.Empty(t, key)
+	mockAPIKeyRepo.AssertNotCalled(t, "CreateAPIKey")
+}
+
+// TestIssueAPIKey_FailEmployeeNotFound проверяет, что если сотрудника нет,
+// то возвращается ErrEmployeeNotFound.
+func TestIssueAPIKey_FailEmployeeNotFound(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockAPIKeyRepo := new(mocks.MockAPIKeyManager)
+	logger := slogdiscard.NewDiscardLogger()
+	apiKeyService := apikey.New(logger, mockAPIKeyRepo)
+	mockAPIKeyRepo.On("CreateAPIKey", ctx, mock.Anything, mock.Anything).Return(models.APIKey{}, outerror.ErrEmployeeNotFound)
+
+	// Act
+	_, key, err := apiKeyService.IssueAPIKey(ctx, 2, "erp", []string{models.ScopeTendersRead})
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrEmployeeNotFound)
+	require.Empty(t, key)
+}
+
+// TestRevokeAPIKey_Success проверяет, что отозванный ключ возвращается.
+func TestRevokeAPIKey_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockAPIKeyRepo := new(mocks.MockAPIKeyManager)
+	logger := slogdiscard.NewDiscardLogger()
+	apiKeyService := apikey.New(logger, mockAPIKeyRepo)
+	expectedAPIKey := models.APIKey{ID: 1, EmployeeId: 2, Name: "erp"}
+	mockAPIKeyRepo.On("RevokeAPIKey", ctx, 2, 1).Return(expectedAPIKey, nil)
+
+	// Act
+	revokedAPIKey, err := apiKeyService.RevokeAPIKey(ctx, 2, 1)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, expectedAPIKey, revokedAPIKey)
+}
+
+// TestRevokeAPIKey_FailNotFound проверяет, что если у сотрудника нет
+// такого действующего ключа, то возвращается ErrAPIKeyNotFound.
+func TestRevokeAPIKey_FailNotFound(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockAPIKeyRepo := new(mocks.MockAPIKeyManager)
+	logger := slogdiscard.NewDiscardLogger()
+	apiKeyService := apikey.New(logger, mockAPIKeyRepo)
+	mockAPIKeyRepo.On("RevokeAPIKey", ctx, 2, 1).Return(models.APIKey{}, outerror.ErrAPIKeyNotFound)
+
+	// Act
+	revokedAPIKey, err := apiKeyService.RevokeAPIKey(ctx, 2, 1)
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrAPIKeyNotFound)
+	require.Equal(t, models.APIKey{}, revokedAPIKey)
+}
@@ -0,0 +1,89 @@
+package bid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+// CreateBid создает предложение с данными, переданными в bid.
+//
+// Предложение можно сделать только по опубликованному тендеру и
+// только от лица организации, которая не является организацией тендера.
+func (bidSrv *BidService) CreateBid(ctx context.Context, bid models.Bid) (models.Bid, error) {
+	const operationPlace = "internal.service.bid.create.CreateBid"
+	logger := bidSrv.logger.With("op", operationPlace)
+
+	if !bid.IsNewBidHasStatusCreated() {
+		return models.Bid{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrNewBidCannotCreatedWithStatusNotCreated)
+	}
+
+	tender, err := bidSrv.tenderRepo.GetTenderById(ctx, bid.TenderId)
+	if err != nil {
+		if errors.Is(err, outerror.ErrTenderNotFound) {
+			logger.Warn("tender not found", slog.Int("tender id", bid.TenderId))
+			return models.Bid{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTenderNotFound)
+		}
+		logger.Error("cannot get tender by id", slog.Int("tender id", bid.TenderId), slog.String("err", err.Error()))
+		return models.Bid{}, fmt.Errorf("cannot get tender by id: %w", err)
+	}
+	if tender.Status != models.TenderPublishedStatus {
+		logger.Warn("tender not published", slog.Int("tender id", bid.TenderId), slog.String("status", tender.Status))
+		return models.Bid{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTenderNotPublished)
+	}
+	if tender.OrganizationId == bid.OrganizationId {
+		logger.Warn("bid organization is tender organization", slog.Int("org id", bid.OrganizationId))
+		return models.Bid{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrBidOrganizationIsTenderOrganization)
+	}
+	logger.Info("success check tender")
+
+	empl, err := bidSrv.employeeRepo.GetEmployeeByUsername(ctx, bid.CreatorUsername)
+	if err != nil {
+		if errors.Is(err, outerror.ErrEmployeeNotFound) {
+			logger.Warn("employee not found", slog.String("username", bid.CreatorUsername))
+			return models.Bid{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeNotFound)
+		}
+		logger.Error("cannot get employee with username", slog.String("username", bid.CreatorUsername), slog.String("err", err.Error()))
+		return models.Bid{}, fmt.Errorf("cannot get employee: %w", err)
+	}
+	logger.Info("success check employee by username")
+
+	_, err = bidSrv.orgRepo.GetOrganizationById(ctx, bid.OrganizationId)
+	if err != nil {
+		if errors.Is(err, outerror.ErrOrganizationNotFound) {
+			logger.Warn("organization not found", slog.Int("org id", bid.OrganizationId))
+			return models.Bid{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrOrganizationNotFound)
+		}
+		logger.Error("cannot get organization with id", slog.Int("org id", bid.OrganizationId), slog.String("err", err.Error()))
+		return models.Bid{}, fmt.Errorf("cannot get organization with id: %w", err)
+	}
+	logger.Info("success check organization by id")
+
+	err = bidSrv.employeeResponsibler.CheckResponsibility(ctx, empl.ID, bid.OrganizationId)
+	if err != nil {
+		if errors.Is(err, outerror.ErrEmployeeNotResponsibleForOrganization) {
+			logger.Warn("employee not responsible for organization", slog.Int("empl id", empl.ID), slog.Int("org id", bid.OrganizationId))
+			return models.Bid{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeNotResponsibleForOrganization)
+		}
+		logger.Error(
+			"cannot check that employee responsible for organization",
+			slog.Int("empl id", empl.ID),
+			slog.Int("org id", bid.OrganizationId),
+			slog.String("err", err.Error()),
+		)
+		return models.Bid{}, fmt.Errorf("cannot check that employee responsible for organization: %w", err)
+	}
+	logger.Info("success check employee responsible")
+
+	createdBid, err := bidSrv.bidRepo.CreateBid(ctx, bid)
+	if err != nil {
+		logger.Error("cannot create bid", slog.String("err", err.Error()))
+		return models.Bid{}, fmt.Errorf("cannot create bid: %w", err)
+	}
+	logger.Info("success create bid")
+	return createdBid, nil
+}
@@ -0,0 +1,73 @@
+package bid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+// GetTenderBids возвращает список предложений по тендеру.
+// Предложения доступны только создателю тендера.
+func (bidSrv *BidService) GetTenderBids(ctx context.Context, tenderId int, username string) ([]models.Bid, error) {
+	const operationPlace = "internal.service.bid.get.GetTenderBids"
+	logger := bidSrv.logger.With("op", operationPlace)
+
+	tender, err := bidSrv.tenderRepo.GetTenderById(ctx, tenderId)
+	if err != nil {
+		if errors.Is(err, outerror.ErrTenderNotFound) {
+			logger.Warn("tender not found", slog.Int("tender id", tenderId))
+			return []models.Bid{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTenderNotFound)
+		}
+		logger.Error("cannot get tender by id", slog.Int("tender id", tenderId), slog.String("err", err.Error()))
+		return []models.Bid{}, fmt.Errorf("cannot get tender by id: %w", err)
+	}
+	if tender.CreatorUsername != username {
+		logger.Warn(fmt.Sprintf("employee with username \"%s\" not creator of tender with id \"%d\"", username, tenderId))
+		return []models.Bid{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeNotResponsibleForTender)
+	}
+
+	bids, err := bidSrv.bidRepo.GetTenderBids(ctx, tenderId)
+	if err != nil {
+		if errors.Is(err, outerror.ErrTenderBidsNotFound) {
+			logger.Warn("no bids for tender", slog.Int("tender id", tenderId))
+			return []models.Bid{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTenderBidsNotFound)
+		}
+		logger.Error("cannot get tender bids", slog.String("err", err.Error()))
+		return []models.Bid{}, fmt.Errorf("cannot get tender bids: %w", err)
+	}
+	logger.Info("success get tender bids")
+	return bids, nil
+}
+
+// GetEmployeeBidsByUsername возвращает список предложений, которые создал переданный юзер.
+func (bidSrv *BidService) GetEmployeeBidsByUsername(ctx context.Context, username string) ([]models.Bid, error) {
+	const operationPlace = "internal.service.bid.get.GetEmployeeBidsByUsername"
+	logger := bidSrv.logger.With("op", operationPlace)
+
+	empl, err := bidSrv.employeeRepo.GetEmployeeByUsername(ctx, username)
+	if err != nil {
+		if errors.Is(err, outerror.ErrEmployeeNotFound) {
+			logger.Warn("employee not found", slog.String("username", username))
+			return []models.Bid{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeNotFound)
+		}
+		logger.Error("cannot get employee", slog.String("username", username), slog.String("err", err.Error()))
+		return []models.Bid{}, fmt.Errorf("cannot get employee: %w", err)
+	}
+	logger.Info("success check employee by username")
+
+	bids, err := bidSrv.bidRepo.GetEmployeeBids(ctx, empl)
+	if err != nil {
+		if errors.Is(err, outerror.ErrEmployeeBidsNotFound) {
+			logger.Warn("no bids for employee", slog.String("username", username))
+			return []models.Bid{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeBidsNotFound)
+		}
+		logger.Error("cannot get bids", slog.String("err", err.Error()))
+		return []models.Bid{}, fmt.Errorf("cannot get bids: %w", err)
+	}
+	logger.Info("success get employee bids")
+	return bids, nil
+}
@@ -0,0 +1,64 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockBidRepo реализует интерфейс BidRepository
+// для целей тестирования. Он позволяет задавать ожидаемые результаты
+// методов:
+//
+// - CreateBid
+//
+// - GetTenderBids
+//
+// - GetEmployeeBids
+//
+// - EditBid
+//
+// - RollbackBid
+//
+// - GetBidById
+//
+// - GetBidVersion
+type MockBidRepo struct {
+	mock.Mock
+}
+
+func (m *MockBidRepo) CreateBid(ctx context.Context, bid models.Bid) (models.Bid, error) {
+	args := m.Called(ctx, bid)
+	return args.Get(0).(models.Bid), args.Error(1)
+}
+
+func (m *MockBidRepo) GetTenderBids(ctx context.Context, tenderId int) ([]models.Bid, error) {
+	args := m.Called(ctx, tenderId)
+	return args.Get(0).([]models.Bid), args.Error(1)
+}
+
+func (m *MockBidRepo) GetEmployeeBids(ctx context.Context, empl models.Employee) ([]models.Bid, error) {
+	args := m.Called(ctx, empl)
+	return args.Get(0).([]models.Bid), args.Error(1)
+}
+
+func (m *MockBidRepo) EditBid(ctx context.Context, oldBid models.Bid, bidId int, updateBid models.BidToUpdate) (models.Bid, error) {
+	args := m.Called(ctx, oldBid, bidId, updateBid)
+	return args.Get(0).(models.Bid), args.Error(1)
+}
+
+func (m *MockBidRepo) RollbackBid(ctx context.Context, bidId int, toVersionRollback int) error {
+	args := m.Called(ctx, bidId, toVersionRollback)
+	return args.Error(0)
+}
+
+func (m *MockBidRepo) GetBidById(ctx context.Context, bidId int) (models.Bid, error) {
+	args := m.Called(ctx, bidId)
+	return args.Get(0).(models.Bid), args.Error(1)
+}
+
+func (m *MockBidRepo) GetBidVersion(ctx context.Context, bidId int, version int) (models.Bid, error) {
+	args := m.Called(ctx, bidId, version)
+	return args.Get(0).(models.Bid), args.Error(1)
+}
@@ -0,0 +1,72 @@
+package bid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+// RollbackBid делает активной указанную версию предложения.
+// Откатить предложение может только его создатель, пока тендер опубликован.
+// Статус восстанавливаемой версии проверяется по тем же правилам, что и при редактировании.
+func (bidSrv *BidService) RollbackBid(ctx context.Context, bidId int, version int, username string) (models.Bid, error) {
+	const operationPlace = "internal.service.bid.rollback.RollbackBid"
+	logger := bidSrv.logger.With("op", operationPlace)
+
+	bid, err := bidSrv.bidRepo.GetBidById(ctx, bidId)
+	if err != nil {
+		if errors.Is(err, outerror.ErrBidNotFound) {
+			logger.Warn(fmt.Sprintf("bid with id=\"%d\" not found", bidId))
+			return models.Bid{}, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		logger.Error(fmt.Sprintf("cannot get bid with id=\"%d\"", bidId))
+		return models.Bid{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	bidVersion, err := bidSrv.bidRepo.GetBidVersion(ctx, bidId, version)
+	if err != nil {
+		if errors.Is(err, outerror.ErrBidVersionNotFound) {
+			logger.Warn(fmt.Sprintf("bid version=\"%d\" not found", version))
+			return models.Bid{}, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		logger.Error("cannot get bid version")
+		return models.Bid{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	if bid.CreatorUsername != username {
+		logger.Warn(fmt.Sprintf("employee with username=<%s> not creator of bid with id=<%d>", username, bidId))
+		return models.Bid{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeNotResponsibleForBid)
+	}
+
+	statusUpdate := models.BidToUpdate{Status: &bidVersion.Status}
+	if !statusUpdate.CanSetThisBidStatus(bid.Status) {
+		logger.Warn(fmt.Sprintf("cannot set status \"%s\" to bid with status \"%s\"", bidVersion.Status, bid.Status))
+		return models.Bid{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrCannotSetThisBidStatus)
+	}
+
+	tender, err := bidSrv.tenderRepo.GetTenderById(ctx, bid.TenderId)
+	if err != nil {
+		logger.Error("cannot get bid tender", slog.Int("tender id", bid.TenderId), slog.String("err", err.Error()))
+		return models.Bid{}, fmt.Errorf("cannot get bid tender: %w", err)
+	}
+	if tender.Status != models.TenderPublishedStatus {
+		logger.Warn("bid tender not published", slog.Int("tender id", bid.TenderId), slog.String("status", tender.Status))
+		return models.Bid{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTenderNotPublished)
+	}
+
+	err = bidSrv.bidRepo.RollbackBid(ctx, bidId, version)
+	if err != nil {
+		logger.Error("cannot rollback bid", slog.String("err", err.Error()))
+		return models.Bid{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	bid, err = bidSrv.bidRepo.GetBidById(ctx, bidId)
+	if err != nil {
+		logger.Error("unexpected error", slog.String("err", err.Error()))
+		return models.Bid{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	return bid, nil
+}
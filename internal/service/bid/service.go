@@ -0,0 +1,35 @@
+package bid
+
+import (
+	"log/slog"
+
+	"github.com/sariya23/tender/internal/repository"
+)
+
+// BidService позволяет взаимодействовать с предложениями по тендерам.
+type BidService struct {
+	logger               *slog.Logger
+	bidRepo              repository.BidRepository
+	tenderRepo           repository.TenderRepository
+	employeeRepo         repository.EmployeeRepository
+	orgRepo              repository.OrganizationRepository
+	employeeResponsibler repository.EmployeeResponsibler
+}
+
+func New(
+	logger *slog.Logger,
+	bidRepo repository.BidRepository,
+	tenderRepo repository.TenderRepository,
+	employeeRepo repository.EmployeeRepository,
+	orgRepo repository.OrganizationRepository,
+	employeeOrgResponsibler repository.EmployeeResponsibler,
+) *BidService {
+	return &BidService{
+		logger:               logger,
+		bidRepo:              bidRepo,
+		tenderRepo:           tenderRepo,
+		employeeRepo:         employeeRepo,
+		orgRepo:              orgRepo,
+		employeeResponsibler: employeeOrgResponsibler,
+	}
+}
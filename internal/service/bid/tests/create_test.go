@@ -0,0 +1,202 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/sariya23/tender/internal/service/bid"
+	"github.com/sariya23/tender/internal/service/bid/mocks"
+	tendermocks "github.com/sariya23/tender/internal/service/tender/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCreateBid_Success проверяет, что предложение создается,
+// если тендер опубликован, сотрудник и организация существуют
+// и сотрудник ответственный за организацию.
+func TestCreateBid_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockBidRepo := new(mocks.MockBidRepo)
+	mockTenderRepo := new(tendermocks.MockTenderRepo)
+	mockEmployeeRepo := new(tendermocks.MockEmployeeRepo)
+	mockOrgRepo := new(tendermocks.MockOrgRepo)
+	mockResponsibler := new(tendermocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	bidToCreate := models.Bid{
+		TenderId:        1,
+		BidName:         "Bid 1",
+		Description:     "qwe",
+		Status:          models.BidCreatedStatus,
+		OrganizationId:  2,
+		CreatorUsername: "qwe",
+	}
+	expectedBid := bidToCreate
+	expectedBid.ID = 1
+	expectedBid.Version = 1
+	bidService := bid.New(logger, mockBidRepo, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{Status: models.TenderPublishedStatus, OrganizationId: 1}, nil)
+	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, "qwe").Return(models.Employee{ID: 3}, nil)
+	mockOrgRepo.On("GetOrganizationById", ctx, 2).Return(models.Organization{ID: 2}, nil)
+	mockResponsibler.On("CheckResponsibility", ctx, 3, 2).Return(nil)
+	mockBidRepo.On("CreateBid", ctx, bidToCreate).Return(expectedBid, nil)
+
+	// Act
+	createdBid, err := bidService.CreateBid(ctx, bidToCreate)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, expectedBid, createdBid)
+}
+
+// TestCreateBid_FailTenderNotPublished проверяет, что
+// нельзя сделать предложение по неопубликованному тендеру.
+func TestCreateBid_FailTenderNotPublished(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockBidRepo := new(mocks.MockBidRepo)
+	mockTenderRepo := new(tendermocks.MockTenderRepo)
+	mockEmployeeRepo := new(tendermocks.MockEmployeeRepo)
+	mockOrgRepo := new(tendermocks.MockOrgRepo)
+	mockResponsibler := new(tendermocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	bidToCreate := models.Bid{TenderId: 1, Status: models.BidCreatedStatus, OrganizationId: 2, CreatorUsername: "qwe"}
+	bidService := bid.New(logger, mockBidRepo, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	for _, status := range []string{models.TenderCreatedStatus, models.TenderClosedStatus} {
+		mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{Status: status, OrganizationId: 1}, nil).Once()
+
+		// Act
+		createdBid, err := bidService.CreateBid(ctx, bidToCreate)
+
+		// Assert
+		require.ErrorIs(t, err, outerror.ErrTenderNotPublished)
+		require.Equal(t, models.Bid{}, createdBid)
+	}
+	mockBidRepo.AssertNotCalled(t, "CreateBid")
+}
+
+// TestCreateBid_FailTenderNotFound проверяет, что
+// нельзя сделать предложение по несуществующему тендеру.
+func TestCreateBid_FailTenderNotFound(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockBidRepo := new(mocks.MockBidRepo)
+	mockTenderRepo := new(tendermocks.MockTenderRepo)
+	mockEmployeeRepo := new(tendermocks.MockEmployeeRepo)
+	mockOrgRepo := new(tendermocks.MockOrgRepo)
+	mockResponsibler := new(tendermocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	bidToCreate := models.Bid{TenderId: 1, Status: models.BidCreatedStatus, OrganizationId: 2, CreatorUsername: "qwe"}
+	bidService := bid.New(logger, mockBidRepo, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{}, outerror.ErrTenderNotFound)
+
+	// Act
+	createdBid, err := bidService.CreateBid(ctx, bidToCreate)
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrTenderNotFound)
+	require.Equal(t, models.Bid{}, createdBid)
+}
+
+// TestCreateBid_FailSameOrganization проверяет, что организация
+// не может сделать предложение по своему же тендеру.
+func TestCreateBid_FailSameOrganization(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockBidRepo := new(mocks.MockBidRepo)
+	mockTenderRepo := new(tendermocks.MockTenderRepo)
+	mockEmployeeRepo := new(tendermocks.MockEmployeeRepo)
+	mockOrgRepo := new(tendermocks.MockOrgRepo)
+	mockResponsibler := new(tendermocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	bidToCreate := models.Bid{TenderId: 1, Status: models.BidCreatedStatus, OrganizationId: 1, CreatorUsername: "qwe"}
+	bidService := bid.New(logger, mockBidRepo, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{Status: models.TenderPublishedStatus, OrganizationId: 1}, nil)
+
+	// Act
+	createdBid, err := bidService.CreateBid(ctx, bidToCreate)
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrBidOrganizationIsTenderOrganization)
+	require.Equal(t, models.Bid{}, createdBid)
+}
+
+// TestCreateBid_FailStatusNotCreated проверяет, что
+// предложение нельзя создать со статусом, отличным от CREATED.
+func TestCreateBid_FailStatusNotCreated(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockBidRepo := new(mocks.MockBidRepo)
+	mockTenderRepo := new(tendermocks.MockTenderRepo)
+	mockEmployeeRepo := new(tendermocks.MockEmployeeRepo)
+	mockOrgRepo := new(tendermocks.MockOrgRepo)
+	mockResponsibler := new(tendermocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	bidToCreate := models.Bid{TenderId: 1, Status: models.BidPublishedStatus, OrganizationId: 2, CreatorUsername: "qwe"}
+	bidService := bid.New(logger, mockBidRepo, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+
+	// Act
+	createdBid, err := bidService.CreateBid(ctx, bidToCreate)
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrNewBidCannotCreatedWithStatusNotCreated)
+	require.Equal(t, models.Bid{}, createdBid)
+}
+
+// TestCreateBid_FailEmployeeNotResponsible проверяет, что
+// сотрудник не может сделать предложение от лица организации,
+// за которую он не отвечает.
+func TestCreateBid_FailEmployeeNotResponsible(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockBidRepo := new(mocks.MockBidRepo)
+	mockTenderRepo := new(tendermocks.MockTenderRepo)
+	mockEmployeeRepo := new(tendermocks.MockEmployeeRepo)
+	mockOrgRepo := new(tendermocks.MockOrgRepo)
+	mockResponsibler := new(tendermocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	bidToCreate := models.Bid{TenderId: 1, Status: models.BidCreatedStatus, OrganizationId: 2, CreatorUsername: "qwe"}
+	bidService := bid.New(logger, mockBidRepo, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{Status: models.TenderPublishedStatus, OrganizationId: 1}, nil)
+	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, "qwe").Return(models.Employee{ID: 3}, nil)
+	mockOrgRepo.On("GetOrganizationById", ctx, 2).Return(models.Organization{ID: 2}, nil)
+	mockResponsibler.On("CheckResponsibility", ctx, 3, 2).Return(outerror.ErrEmployeeNotResponsibleForOrganization)
+
+	// Act
+	createdBid, err := bidService.CreateBid(ctx, bidToCreate)
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrEmployeeNotResponsibleForOrganization)
+	require.Equal(t, models.Bid{}, createdBid)
+}
+
+// TestCreateBid_FailCannotCreateBid проверяет, что
+// непредвиденная ошибка при создании предложения возвращается наверх.
+func TestCreateBid_FailCannotCreateBid(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockBidRepo := new(mocks.MockBidRepo)
+	mockTenderRepo := new(tendermocks.MockTenderRepo)
+	mockEmployeeRepo := new(tendermocks.MockEmployeeRepo)
+	mockOrgRepo := new(tendermocks.MockOrgRepo)
+	mockResponsibler := new(tendermocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	someErr := errors.New("some err")
+	bidToCreate := models.Bid{TenderId: 1, Status: models.BidCreatedStatus, OrganizationId: 2, CreatorUsername: "qwe"}
+	bidService := bid.New(logger, mockBidRepo, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{Status: models.TenderPublishedStatus, OrganizationId: 1}, nil)
+	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, "qwe").Return(models.Employee{ID: 3}, nil)
+	mockOrgRepo.On("GetOrganizationById", ctx, 2).Return(models.Organization{ID: 2}, nil)
+	mockResponsibler.On("CheckResponsibility", ctx, 3, 2).Return(nil)
+	mockBidRepo.On("CreateBid", ctx, bidToCreate).Return(models.Bid{}, someErr)
+
+	// Act
+	createdBid, err := bidService.CreateBid(ctx, bidToCreate)
+
+	// Assert
+	require.ErrorIs(t, err, someErr)
+	require.Equal(t, models.Bid{}, createdBid)
+}
@@ -0,0 +1,112 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/sariya23/tender/internal/service/bid"
+	"github.com/sariya23/tender/internal/service/bid/mocks"
+	tendermocks "github.com/sariya23/tender/internal/service/tender/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetTenderBids_Success проверяет, что создатель
+// тендера получает все предложения по нему.
+func TestGetTenderBids_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockBidRepo := new(mocks.MockBidRepo)
+	mockTenderRepo := new(tendermocks.MockTenderRepo)
+	mockEmployeeRepo := new(tendermocks.MockEmployeeRepo)
+	mockOrgRepo := new(tendermocks.MockOrgRepo)
+	mockResponsibler := new(tendermocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	expectedBids := []models.Bid{
+		{ID: 1, TenderId: 1, BidName: "Bid 1", Status: models.BidPublishedStatus, OrganizationId: 2, CreatorUsername: "zxc", Version: 1},
+		{ID: 2, TenderId: 1, BidName: "Bid 2", Status: models.BidCreatedStatus, OrganizationId: 3, CreatorUsername: "asd", Version: 2},
+	}
+	bidService := bid.New(logger, mockBidRepo, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{CreatorUsername: "qwe"}, nil)
+	mockBidRepo.On("GetTenderBids", ctx, 1).Return(expectedBids, nil)
+
+	// Act
+	bids, err := bidService.GetTenderBids(ctx, 1, "qwe")
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, expectedBids, bids)
+}
+
+// TestGetTenderBids_FailNotTenderCreator проверяет, что
+// предложения по тендеру недоступны никому, кроме создателя тендера.
+func TestGetTenderBids_FailNotTenderCreator(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockBidRepo := new(mocks.MockBidRepo)
+	mockTenderRepo := new(tendermocks.MockTenderRepo)
+	mockEmployeeRepo := new(tendermocks.MockEmployeeRepo)
+	mockOrgRepo := new(tendermocks.MockOrgRepo)
+	mockResponsibler := new(tendermocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	bidService := bid.New(logger, mockBidRepo, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{CreatorUsername: "qwe"}, nil)
+
+	// Act
+	bids, err := bidService.GetTenderBids(ctx, 1, "zxc")
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrEmployeeNotResponsibleForTender)
+	require.Empty(t, bids)
+}
+
+// TestGetEmployeeBids_Success проверяет, что
+// возвращаются предложения сотрудника.
+func TestGetEmployeeBids_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockBidRepo := new(mocks.MockBidRepo)
+	mockTenderRepo := new(tendermocks.MockTenderRepo)
+	mockEmployeeRepo := new(tendermocks.MockEmployeeRepo)
+	mockOrgRepo := new(tendermocks.MockOrgRepo)
+	mockResponsibler := new(tendermocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	empl := models.Employee{ID: 1, Username: "qwe"}
+	expectedBids := []models.Bid{
+		{ID: 1, TenderId: 1, BidName: "Bid 1", Status: models.BidPublishedStatus, OrganizationId: 2, CreatorUsername: "qwe", Version: 1},
+	}
+	bidService := bid.New(logger, mockBidRepo, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, "qwe").Return(empl, nil)
+	mockBidRepo.On("GetEmployeeBids", ctx, empl).Return(expectedBids, nil)
+
+	// Act
+	bids, err := bidService.GetEmployeeBidsByUsername(ctx, "qwe")
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, expectedBids, bids)
+}
+
+// TestGetEmployeeBids_FailEmployeeNotFound проверяет, что
+// если сотрудника нет, то возвращается ошибка.
+func TestGetEmployeeBids_FailEmployeeNotFound(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockBidRepo := new(mocks.MockBidRepo)
+	mockTenderRepo := new(tendermocks.MockTenderRepo)
+	mockEmployeeRepo := new(tendermocks.MockEmployeeRepo)
+	mockOrgRepo := new(tendermocks.MockOrgRepo)
+	mockResponsibler := new(tendermocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	bidService := bid.New(logger, mockBidRepo, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, "qwe").Return(models.Employee{}, outerror.ErrEmployeeNotFound)
+
+	// Act
+	bids, err := bidService.GetEmployeeBidsByUsername(ctx, "qwe")
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrEmployeeNotFound)
+	require.Empty(t, bids)
+}
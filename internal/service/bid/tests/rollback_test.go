@@ -0,0 +1,136 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/sariya23/tender/internal/service/bid"
+	"github.com/sariya23/tender/internal/service/bid/mocks"
+	tendermocks "github.com/sariya23/tender/internal/service/tender/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRollbackBid_Success проверяет, что
+// предложение откатывается на указанную версию.
+func TestRollbackBid_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockBidRepo := new(mocks.MockBidRepo)
+	mockTenderRepo := new(tendermocks.MockTenderRepo)
+	mockEmployeeRepo := new(tendermocks.MockEmployeeRepo)
+	mockOrgRepo := new(tendermocks.MockOrgRepo)
+	mockResponsibler := new(tendermocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	expectedBid := models.Bid{ID: 2, TenderId: 1, BidName: "Bid 1", CreatorUsername: "qwe", Version: 1}
+	bidService := bid.New(logger, mockBidRepo, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockBidRepo.On("GetBidById", ctx, 2).Return(models.Bid{TenderId: 1, CreatorUsername: "qwe", Status: models.BidPublishedStatus}, nil).Once()
+	mockBidRepo.On("GetBidById", ctx, 2).Return(expectedBid, nil).Once()
+	mockBidRepo.On("GetBidVersion", ctx, 2, 1).Return(models.Bid{Status: models.BidPublishedStatus}, nil)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{Status: models.TenderPublishedStatus}, nil)
+	mockBidRepo.On("RollbackBid", ctx, 2, 1).Return(nil)
+
+	// Act
+	rollbackBid, err := bidService.RollbackBid(ctx, 2, 1, "qwe")
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, expectedBid, rollbackBid)
+}
+
+// TestRollbackBid_FailVersionNotFound проверяет, что
+// если указанной версии нет, то возвращается ошибка.
+func TestRollbackBid_FailVersionNotFound(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockBidRepo := new(mocks.MockBidRepo)
+	mockTenderRepo := new(tendermocks.MockTenderRepo)
+	mockEmployeeRepo := new(tendermocks.MockEmployeeRepo)
+	mockOrgRepo := new(tendermocks.MockOrgRepo)
+	mockResponsibler := new(tendermocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	bidService := bid.New(logger, mockBidRepo, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockBidRepo.On("GetBidById", ctx, 2).Return(models.Bid{CreatorUsername: "qwe"}, nil)
+	mockBidRepo.On("GetBidVersion", ctx, 2, 5).Return(models.Bid{}, outerror.ErrBidVersionNotFound)
+
+	// Act
+	rollbackBid, err := bidService.RollbackBid(ctx, 2, 5, "qwe")
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrBidVersionNotFound)
+	require.Equal(t, models.Bid{}, rollbackBid)
+}
+
+// TestRollbackBid_FailNotCreator проверяет, что
+// откатить предложение может только его создатель.
+func TestRollbackBid_FailNotCreator(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockBidRepo := new(mocks.MockBidRepo)
+	mockTenderRepo := new(tendermocks.MockTenderRepo)
+	mockEmployeeRepo := new(tendermocks.MockEmployeeRepo)
+	mockOrgRepo := new(tendermocks.MockOrgRepo)
+	mockResponsibler := new(tendermocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	bidService := bid.New(logger, mockBidRepo, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockBidRepo.On("GetBidById", ctx, 2).Return(models.Bid{CreatorUsername: "qwe"}, nil)
+	mockBidRepo.On("GetBidVersion", ctx, 2, 1).Return(models.Bid{Status: models.BidCreatedStatus}, nil)
+
+	// Act
+	rollbackBid, err := bidService.RollbackBid(ctx, 2, 1, "zxc")
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrEmployeeNotResponsibleForBid)
+	require.Equal(t, models.Bid{}, rollbackBid)
+}
+
+// TestRollbackBid_FailCannotSetStatus проверяет, что
+// отменённое предложение нельзя откатить на версию с другим статусом.
+func TestRollbackBid_FailCannotSetStatus(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockBidRepo := new(mocks.MockBidRepo)
+	mockTenderRepo := new(tendermocks.MockTenderRepo)
+	mockEmployeeRepo := new(tendermocks.MockEmployeeRepo)
+	mockOrgRepo := new(tendermocks.MockOrgRepo)
+	mockResponsibler := new(tendermocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	bidService := bid.New(logger, mockBidRepo, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockBidRepo.On("GetBidById", ctx, 2).Return(models.Bid{TenderId: 1, CreatorUsername: "qwe", Status: models.BidCanceledStatus}, nil)
+	mockBidRepo.On("GetBidVersion", ctx, 2, 1).Return(models.Bid{Status: models.BidPublishedStatus}, nil)
+
+	// Act
+	rollbackBid, err := bidService.RollbackBid(ctx, 2, 1, "qwe")
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrCannotSetThisBidStatus)
+	require.Equal(t, models.Bid{}, rollbackBid)
+	mockBidRepo.AssertNotCalled(t, "RollbackBid", ctx, 2, 1)
+}
+
+// TestRollbackBid_FailTenderNotPublished проверяет, что
+// предложение нельзя откатить, если тендер уже не опубликован.
+func TestRollbackBid_FailTenderNotPublished(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockBidRepo := new(mocks.MockBidRepo)
+	mockTenderRepo := new(tendermocks.MockTenderRepo)
+	mockEmployeeRepo := new(tendermocks.MockEmployeeRepo)
+	mockOrgRepo := new(tendermocks.MockOrgRepo)
+	mockResponsibler := new(tendermocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	bidService := bid.New(logger, mockBidRepo, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockBidRepo.On("GetBidById", ctx, 2).Return(models.Bid{TenderId: 1, CreatorUsername: "qwe", Status: models.BidPublishedStatus}, nil)
+	mockBidRepo.On("GetBidVersion", ctx, 2, 1).Return(models.Bid{Status: models.BidPublishedStatus}, nil)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{Status: models.TenderClosedStatus}, nil)
+
+	// Act
+	rollbackBid, err := bidService.RollbackBid(ctx, 2, 1, "qwe")
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrTenderNotPublished)
+	require.Equal(t, models.Bid{}, rollbackBid)
+	mockBidRepo.AssertNotCalled(t, "RollbackBid", ctx, 2, 1)
+}
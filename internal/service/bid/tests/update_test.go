@@ -0,0 +1,162 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/sariya23/tender/internal/service/bid"
+	"github.com/sariya23/tender/internal/service/bid/mocks"
+	tendermocks "github.com/sariya23/tender/internal/service/tender/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEditBid_Success проверяет, что создатель предложения
+// может обновить его, пока тендер опубликован.
+func TestEditBid_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockBidRepo := new(mocks.MockBidRepo)
+	mockTenderRepo := new(tendermocks.MockTenderRepo)
+	mockEmployeeRepo := new(tendermocks.MockEmployeeRepo)
+	mockOrgRepo := new(tendermocks.MockOrgRepo)
+	mockResponsibler := new(tendermocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	newName := "new name"
+	currBid := models.Bid{ID: 1, TenderId: 2, BidName: "Bid 1", Status: models.BidCreatedStatus, CreatorUsername: "qwe", Version: 1}
+	updateBid := models.BidToUpdate{BidName: &newName}
+	expectedBid := currBid
+	expectedBid.BidName = newName
+	expectedBid.Version = 2
+	bidService := bid.New(logger, mockBidRepo, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockBidRepo.On("GetBidById", ctx, 1).Return(currBid, nil)
+	mockTenderRepo.On("GetTenderById", ctx, 2).Return(models.Tender{Status: models.TenderPublishedStatus}, nil)
+	mockBidRepo.On("EditBid", ctx, currBid, 1, updateBid).Return(expectedBid, nil)
+
+	// Act
+	updatedBid, err := bidService.EditBid(ctx, 1, updateBid, "qwe")
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, expectedBid, updatedBid)
+}
+
+// TestEditBid_FailNotCreator проверяет, что
+// предложение может обновить только его создатель.
+func TestEditBid_FailNotCreator(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockBidRepo := new(mocks.MockBidRepo)
+	mockTenderRepo := new(tendermocks.MockTenderRepo)
+	mockEmployeeRepo := new(tendermocks.MockEmployeeRepo)
+	mockOrgRepo := new(tendermocks.MockOrgRepo)
+	mockResponsibler := new(tendermocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	newName := "new name"
+	bidService := bid.New(logger, mockBidRepo, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockBidRepo.On("GetBidById", ctx, 1).Return(models.Bid{CreatorUsername: "qwe"}, nil)
+
+	// Act
+	updatedBid, err := bidService.EditBid(ctx, 1, models.BidToUpdate{BidName: &newName}, "zxc")
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrEmployeeNotResponsibleForBid)
+	require.Equal(t, models.Bid{}, updatedBid)
+}
+
+// TestEditBid_FailCannotSetStatus проверяет, что
+// отмененное предложение нельзя вернуть в другой статус.
+func TestEditBid_FailCannotSetStatus(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockBidRepo := new(mocks.MockBidRepo)
+	mockTenderRepo := new(tendermocks.MockTenderRepo)
+	mockEmployeeRepo := new(tendermocks.MockEmployeeRepo)
+	mockOrgRepo := new(tendermocks.MockOrgRepo)
+	mockResponsibler := new(tendermocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	bidService := bid.New(logger, mockBidRepo, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockBidRepo.On("GetBidById", ctx, 1).Return(models.Bid{CreatorUsername: "qwe", Status: models.BidCanceledStatus}, nil)
+
+	// Act
+	updatedBid, err := bidService.EditBid(ctx, 1, models.BidToUpdate{Status: &models.BidPublishedStatus}, "qwe")
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrCannotSetThisBidStatus)
+	require.Equal(t, models.Bid{}, updatedBid)
+}
+
+// TestEditBid_FailTenderClosed проверяет, что
+// предложение нельзя изменить после закрытия тендера.
+func TestEditBid_FailTenderClosed(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockBidRepo := new(mocks.MockBidRepo)
+	mockTenderRepo := new(tendermocks.MockTenderRepo)
+	mockEmployeeRepo := new(tendermocks.MockEmployeeRepo)
+	mockOrgRepo := new(tendermocks.MockOrgRepo)
+	mockResponsibler := new(tendermocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	newName := "new name"
+	bidService := bid.New(logger, mockBidRepo, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockBidRepo.On("GetBidById", ctx, 1).Return(models.Bid{TenderId: 2, CreatorUsername: "qwe", Status: models.BidCreatedStatus}, nil)
+	mockTenderRepo.On("GetTenderById", ctx, 2).Return(models.Tender{Status: models.TenderClosedStatus}, nil)
+
+	// Act
+	updatedBid, err := bidService.EditBid(ctx, 1, models.BidToUpdate{BidName: &newName}, "qwe")
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrTenderNotPublished)
+	require.Equal(t, models.Bid{}, updatedBid)
+	mockBidRepo.AssertNotCalled(t, "EditBid")
+}
+
+// TestEditBid_FailVersionConflict проверяет, что если предложение
+// изменили параллельно, то возвращается ErrBidVersionConflict.
+func TestEditBid_FailVersionConflict(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockBidRepo := new(mocks.MockBidRepo)
+	mockTenderRepo := new(tendermocks.MockTenderRepo)
+	mockEmployeeRepo := new(tendermocks.MockEmployeeRepo)
+	mockOrgRepo := new(tendermocks.MockOrgRepo)
+	mockResponsibler := new(tendermocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	newName := "new name"
+	currBid := models.Bid{ID: 1, TenderId: 2, CreatorUsername: "qwe", Status: models.BidCreatedStatus, Version: 1}
+	updateBid := models.BidToUpdate{BidName: &newName}
+	bidService := bid.New(logger, mockBidRepo, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockBidRepo.On("GetBidById", ctx, 1).Return(currBid, nil)
+	mockTenderRepo.On("GetTenderById", ctx, 2).Return(models.Tender{Status: models.TenderPublishedStatus}, nil)
+	mockBidRepo.On("EditBid", ctx, currBid, 1, updateBid).Return(models.Bid{}, outerror.ErrBidVersionConflict)
+
+	// Act
+	updatedBid, err := bidService.EditBid(ctx, 1, updateBid, "qwe")
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrBidVersionConflict)
+	require.Equal(t, models.Bid{}, updatedBid)
+}
+
+// TestEditBid_FailNothingToUpdate проверяет, что
+// пустой запрос на обновление отклоняется.
+func TestEditBid_FailNothingToUpdate(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockBidRepo := new(mocks.MockBidRepo)
+	mockTenderRepo := new(tendermocks.MockTenderRepo)
+	mockEmployeeRepo := new(tendermocks.MockEmployeeRepo)
+	mockOrgRepo := new(tendermocks.MockOrgRepo)
+	mockResponsibler := new(tendermocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	bidService := bid.New(logger, mockBidRepo, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+
+	// Act
+	updatedBid, err := bidService.EditBid(ctx, 1, models.BidToUpdate{}, "qwe")
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrNothingToUpdate)
+	require.Equal(t, models.Bid{}, updatedBid)
+}
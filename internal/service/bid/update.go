@@ -0,0 +1,70 @@
+package bid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+// EditBid обновляет предложение. Обновить предложение может только
+// его создатель и только пока тендер, на который оно сделано, опубликован.
+// После обновления создается новая активная версия предложения.
+func (bidSrv *BidService) EditBid(ctx context.Context, bidId int, updateBid models.BidToUpdate, username string) (models.Bid, error) {
+	const operationPlace = "internal.service.bid.update.EditBid"
+	logger := bidSrv.logger.With("op", operationPlace)
+
+	if updateBid.IsEmpty() {
+		logger.Warn("nothing to update", slog.Int("bid id", bidId))
+		return models.Bid{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrNothingToUpdate)
+	}
+	if !updateBid.IsBidStatusKnown() {
+		logger.Warn(fmt.Sprintf("bid status \"%s\" unknown", *updateBid.Status))
+		return models.Bid{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrUnknownBidStatus)
+	}
+
+	currBid, err := bidSrv.bidRepo.GetBidById(ctx, bidId)
+	if err != nil {
+		if errors.Is(err, outerror.ErrBidNotFound) {
+			logger.Warn("bid not found", slog.Int("bid id", bidId))
+			return models.Bid{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrBidNotFound)
+		}
+		logger.Error("cannot get bid by id", slog.Int("bid id", bidId), slog.String("err", err.Error()))
+		return models.Bid{}, fmt.Errorf("cannot get bid by id: %w", err)
+	}
+
+	if currBid.CreatorUsername != username {
+		logger.Warn(fmt.Sprintf("employee with username \"%s\" not creator of bid with id \"%d\"", username, bidId))
+		return models.Bid{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeNotResponsibleForBid)
+	}
+
+	if !updateBid.CanSetThisBidStatus(currBid.Status) {
+		logger.Warn(fmt.Sprintf("cannot set status \"%s\" to bid with status \"%s\"", *updateBid.Status, currBid.Status))
+		return models.Bid{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrCannotSetThisBidStatus)
+	}
+
+	tender, err := bidSrv.tenderRepo.GetTenderById(ctx, currBid.TenderId)
+	if err != nil {
+		logger.Error("cannot get bid tender", slog.Int("tender id", currBid.TenderId), slog.String("err", err.Error()))
+		return models.Bid{}, fmt.Errorf("cannot get bid tender: %w", err)
+	}
+	if tender.Status != models.TenderPublishedStatus {
+		logger.Warn("bid tender not published", slog.Int("tender id", currBid.TenderId), slog.String("status", tender.Status))
+		return models.Bid{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTenderNotPublished)
+	}
+
+	updatedBid, err := bidSrv.bidRepo.EditBid(ctx, currBid, bidId, updateBid)
+	if err != nil {
+		if errors.Is(err, outerror.ErrBidVersionConflict) {
+			logger.Warn("bid was changed concurrently", slog.Int("bid id", bidId))
+			return models.Bid{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrBidVersionConflict)
+		}
+		logger.Error("cannot update bid", slog.String("err", err.Error()))
+		return models.Bid{}, fmt.Errorf("cannot update bid: %w", err)
+	}
+	logger.Info("success update bid")
+	return updatedBid, nil
+}
@@ -0,0 +1,29 @@
+package employee
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+// CreateEmployee создает сотрудника. Username должен быть уникальным.
+func (employeeSrv *EmployeeService) CreateEmployee(ctx context.Context, employee models.Employee) (models.Employee, error) {
+	const operationPlace = "internal.service.employee.create.CreateEmployee"
+	logger := employeeSrv.logger.With("op", operationPlace)
+
+	createdEmployee, err := employeeSrv.employeeRepo.CreateEmployee(ctx, employee)
+	if err != nil {
+		if errors.Is(err, outerror.ErrEmployeeAlreadyExists) {
+			logger.Warn("employee already exists", slog.String("username", employee.Username))
+			return models.Employee{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeAlreadyExists)
+		}
+		logger.Error("cannot create employee", slog.String("err", err.Error()))
+		return models.Employee{}, fmt.Errorf("cannot create employee: %w", err)
+	}
+	logger.Info("employee created", slog.Int("employee id", createdEmployee.ID))
+	return createdEmployee, nil
+}
@@ -0,0 +1,61 @@
+package employee
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+// GetEmployee возвращает сотрудника по его id.
+func (employeeSrv *EmployeeService) GetEmployee(ctx context.Context, employeeId int) (models.Employee, error) {
+	const operationPlace = "internal.service.employee.get.GetEmployee"
+	logger := employeeSrv.logger.With("op", operationPlace)
+
+	employee, err := employeeSrv.employeeRepo.GetEmployeeById(ctx, employeeId)
+	if err != nil {
+		if errors.Is(err, outerror.ErrEmployeeNotFound) {
+			logger.Warn("employee not found", slog.Int("employee id", employeeId))
+			return models.Employee{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeNotFound)
+		}
+		logger.Error("cannot get employee", slog.Int("employee id", employeeId), slog.String("err", err.Error()))
+		return models.Employee{}, fmt.Errorf("cannot get employee: %w", err)
+	}
+	logger.Info("success get employee")
+	return employee, nil
+}
+
+// GetEmployeeByUsername возвращает сотрудника по его username.
+func (employeeSrv *EmployeeService) GetEmployeeByUsername(ctx context.Context, username string) (models.Employee, error) {
+	const operationPlace = "internal.service.employee.get.GetEmployeeByUsername"
+	logger := employeeSrv.logger.With("op", operationPlace)
+
+	employee, err := employeeSrv.employeeRepo.GetEmployeeByUsername(ctx, username)
+	if err != nil {
+		if errors.Is(err, outerror.ErrEmployeeNotFound) {
+			logger.Warn("employee not found", slog.String("username", username))
+			return models.Employee{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeNotFound)
+		}
+		logger.Error("cannot get employee", slog.String("username", username), slog.String("err", err.Error()))
+		return models.Employee{}, fmt.Errorf("cannot get employee: %w", err)
+	}
+	logger.Info("success get employee")
+	return employee, nil
+}
+
+// GetEmployees возвращает страницу page списка сотрудников.
+func (employeeSrv *EmployeeService) GetEmployees(ctx context.Context, page models.Page) (models.EmployeePage, error) {
+	const operationPlace = "internal.service.employee.get.GetEmployees"
+	logger := employeeSrv.logger.With("op", operationPlace)
+
+	employees, err := employeeSrv.employeeRepo.GetEmployees(ctx, page)
+	if err != nil {
+		logger.Error("cannot get employees", slog.String("err", err.Error()))
+		return models.EmployeePage{Employees: []models.Employee{}}, fmt.Errorf("cannot get employees: %w", err)
+	}
+	logger.Info("success get employees")
+	return employees, nil
+}
@@ -0,0 +1,50 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockEmployeeManager реализует интерфейс EmployeeManager
+// для целей тестирования. Он позволяет задавать ожидаемые результаты
+// методов:
+//
+// - GetEmployeeById
+//
+// - GetEmployeeByUsername
+//
+// - CreateEmployee
+//
+// - GetEmployees
+//
+// - EditEmployee
+type MockEmployeeManager struct {
+	mock.Mock
+}
+
+func (m *MockEmployeeManager) GetEmployeeById(ctx context.Context, id int) (models.Employee, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(models.Employee), args.Error(1)
+}
+
+func (m *MockEmployeeManager) GetEmployeeByUsername(ctx context.Context, username string) (models.Employee, error) {
+	args := m.Called(ctx, username)
+	return args.Get(0).(models.Employee), args.Error(1)
+}
+
+func (m *MockEmployeeManager) CreateEmployee(ctx context.Context, employee models.Employee) (models.Employee, error) {
+	args := m.Called(ctx, employee)
+	return args.Get(0).(models.Employee), args.Error(1)
+}
+
+func (m *MockEmployeeManager) GetEmployees(ctx context.Context, page models.Page) (models.EmployeePage, error) {
+	args := m.Called(ctx, page)
+	return args.Get(0).(models.EmployeePage), args.Error(1)
+}
+
+func (m *MockEmployeeManager) EditEmployee(ctx context.Context, id int, updateEmployee models.EmployeeToUpdate) (models.Employee, error) {
+	args := m.Called(ctx, id, updateEmployee)
+	return args.Get(0).(models.Employee), args.Error(1)
+}
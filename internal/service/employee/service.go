@@ -0,0 +1,20 @@
+package employee
+
+import (
+	"log/slog"
+
+	"github.com/sariya23/tender/internal/repository"
+)
+
+// EmployeeService позволяет взаимодействовать с сотрудниками.
+type EmployeeService struct {
+	logger       *slog.Logger
+	employeeRepo repository.EmployeeManager
+}
+
+func New(logger *slog.Logger, employeeRepo repository.EmployeeManager) *EmployeeService {
+	return &EmployeeService{
+		logger:       logger,
+		employeeRepo: employeeRepo,
+	}
+}
@@ -0,0 +1,52 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/sariya23/tender/internal/service/employee"
+	"github.com/sariya23/tender/internal/service/employee/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCreateEmployee_Success проверяет, что
+// сотрудник с новым username создается.
+func TestCreateEmployee_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockEmployeeRepo := new(mocks.MockEmployeeManager)
+	logger := slogdiscard.NewDiscardLogger()
+	employeeToCreate := models.Employee{Username: "qwe", FirstName: "Ivan"}
+	expectedEmployee := models.Employee{ID: 1, Username: "qwe", FirstName: "Ivan"}
+	employeeService := employee.New(logger, mockEmployeeRepo)
+	mockEmployeeRepo.On("CreateEmployee", ctx, employeeToCreate).Return(expectedEmployee, nil)
+
+	// Act
+	createdEmployee, err := employeeService.CreateEmployee(ctx, employeeToCreate)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, expectedEmployee, createdEmployee)
+}
+
+// TestCreateEmployee_FailAlreadyExists проверяет, что
+// если username занят, то возвращается ErrEmployeeAlreadyExists.
+func TestCreateEmployee_FailAlreadyExists(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockEmployeeRepo := new(mocks.MockEmployeeManager)
+	logger := slogdiscard.NewDiscardLogger()
+	employeeToCreate := models.Employee{Username: "qwe"}
+	employeeService := employee.New(logger, mockEmployeeRepo)
+	mockEmployeeRepo.On("CreateEmployee", ctx, employeeToCreate).Return(models.Employee{}, outerror.ErrEmployeeAlreadyExists)
+
+	// Act
+	createdEmployee, err := employeeService.CreateEmployee(ctx, employeeToCreate)
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrEmployeeAlreadyExists)
+	require.Equal(t, models.Employee{}, createdEmployee)
+}
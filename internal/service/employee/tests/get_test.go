@@ -0,0 +1,88 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/sariya23/tender/internal/service/employee"
+	"github.com/sariya23/tender/internal/service/employee/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetEmployee_Success проверяет, что
+// сотрудник возвращается по id.
+func TestGetEmployee_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockEmployeeRepo := new(mocks.MockEmployeeManager)
+	logger := slogdiscard.NewDiscardLogger()
+	expectedEmployee := models.Employee{ID: 1, Username: "qwe"}
+	employeeService := employee.New(logger, mockEmployeeRepo)
+	mockEmployeeRepo.On("GetEmployeeById", ctx, 1).Return(expectedEmployee, nil)
+
+	// Act
+	gotEmployee, err := employeeService.GetEmployee(ctx, 1)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, expectedEmployee, gotEmployee)
+}
+
+// TestGetEmployee_FailNotFound проверяет, что
+// если сотрудник не найден, то возвращается ErrEmployeeNotFound.
+func TestGetEmployee_FailNotFound(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockEmployeeRepo := new(mocks.MockEmployeeManager)
+	logger := slogdiscard.NewDiscardLogger()
+	employeeService := employee.New(logger, mockEmployeeRepo)
+	mockEmployeeRepo.On("GetEmployeeById", ctx, 1).Return(models.Employee{}, outerror.ErrEmployeeNotFound)
+
+	// Act
+	gotEmployee, err := employeeService.GetEmployee(ctx, 1)
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrEmployeeNotFound)
+	require.Equal(t, models.Employee{}, gotEmployee)
+}
+
+// TestGetEmployeeByUsername_FailNotFound проверяет, что
+// если сотрудника с таким username нет, то возвращается ErrEmployeeNotFound.
+func TestGetEmployeeByUsername_FailNotFound(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockEmployeeRepo := new(mocks.MockEmployeeManager)
+	logger := slogdiscard.NewDiscardLogger()
+	employeeService := employee.New(logger, mockEmployeeRepo)
+	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, "qwe").Return(models.Employee{}, outerror.ErrEmployeeNotFound)
+
+	// Act
+	gotEmployee, err := employeeService.GetEmployeeByUsername(ctx, "qwe")
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrEmployeeNotFound)
+	require.Equal(t, models.Employee{}, gotEmployee)
+}
+
+// TestGetEmployees_Success проверяет, что
+// возвращается страница сотрудников.
+func TestGetEmployees_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockEmployeeRepo := new(mocks.MockEmployeeManager)
+	logger := slogdiscard.NewDiscardLogger()
+	page := models.Page{Limit: 20}
+	expectedPage := models.EmployeePage{Employees: []models.Employee{{ID: 1, Username: "qwe"}}, Total: 1}
+	employeeService := employee.New(logger, mockEmployeeRepo)
+	mockEmployeeRepo.On("GetEmployees", ctx, page).Return(expectedPage, nil)
+
+	// Act
+	employees, err := employeeService.GetEmployees(ctx, page)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, expectedPage, employees)
+}
@@ -0,0 +1,92 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/sariya23/tender/internal/service/employee"
+	"github.com/sariya23/tender/internal/service/employee/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEditEmployee_Success проверяет, что
+// сотрудник обновляется.
+func TestEditEmployee_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockEmployeeRepo := new(mocks.MockEmployeeManager)
+	logger := slogdiscard.NewDiscardLogger()
+	newUsername := "new"
+	updateEmployee := models.EmployeeToUpdate{Username: &newUsername}
+	expectedEmployee := models.Employee{ID: 1, Username: newUsername}
+	employeeService := employee.New(logger, mockEmployeeRepo)
+	mockEmployeeRepo.On("EditEmployee", ctx, 1, updateEmployee).Return(expectedEmployee, nil)
+
+	// Act
+	updatedEmployee, err := employeeService.EditEmployee(ctx, 1, updateEmployee)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, expectedEmployee, updatedEmployee)
+}
+
+// TestEditEmployee_FailNothingToUpdate проверяет, что
+// если нечего обновлять, то возвращается ErrNothingToUpdate.
+func TestEditEmployee_FailNothingToUpdate(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockEmployeeRepo := new(mocks.MockEmployeeManager)
+	logger := slogdiscard.NewDiscardLogger()
+	employeeService := employee.New(logger, mockEmployeeRepo)
+
+	// Act
+	updatedEmployee, err := employeeService.EditEmployee(ctx, 1, models.EmployeeToUpdate{})
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrNothingToUpdate)
+	require.Equal(t, models.Employee{}, updatedEmployee)
+	mockEmployeeRepo.AssertNotCalled(t, "EditEmployee")
+}
+
+// TestEditEmployee_FailUsernameTaken проверяет, что
+// нельзя сменить username на занятый.
+func TestEditEmployee_FailUsernameTaken(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockEmployeeRepo := new(mocks.MockEmployeeManager)
+	logger := slogdiscard.NewDiscardLogger()
+	newUsername := "taken"
+	updateEmployee := models.EmployeeToUpdate{Username: &newUsername}
+	employeeService := employee.New(logger, mockEmployeeRepo)
+	mockEmployeeRepo.On("EditEmployee", ctx, 1, updateEmployee).Return(models.Employee{}, outerror.ErrEmployeeAlreadyExists)
+
+	// Act
+	updatedEmployee, err := employeeService.EditEmployee(ctx, 1, updateEmployee)
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrEmployeeAlreadyExists)
+	require.Equal(t, models.Employee{}, updatedEmployee)
+}
+
+// TestEditEmployee_FailNotFound проверяет, что
+// если сотрудник не найден, то возвращается ErrEmployeeNotFound.
+func TestEditEmployee_FailNotFound(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockEmployeeRepo := new(mocks.MockEmployeeManager)
+	logger := slogdiscard.NewDiscardLogger()
+	newFirstName := "Ivan"
+	updateEmployee := models.EmployeeToUpdate{FirstName: &newFirstName}
+	employeeService := employee.New(logger, mockEmployeeRepo)
+	mockEmployeeRepo.On("EditEmployee", ctx, 1, updateEmployee).Return(models.Employee{}, outerror.ErrEmployeeNotFound)
+
+	// Act
+	updatedEmployee, err := employeeService.EditEmployee(ctx, 1, updateEmployee)
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrEmployeeNotFound)
+	require.Equal(t, models.Employee{}, updatedEmployee)
+}
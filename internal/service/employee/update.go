@@ -0,0 +1,38 @@
+package employee
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+// EditEmployee обновляет поля сотрудника, которые заданы в updateEmployee.
+// Новый username не должен быть занят другим сотрудником.
+func (employeeSrv *EmployeeService) EditEmployee(ctx context.Context, employeeId int, updateEmployee models.EmployeeToUpdate) (models.Employee, error) {
+	const operationPlace = "internal.service.employee.update.EditEmployee"
+	logger := employeeSrv.logger.With("op", operationPlace)
+
+	if updateEmployee.IsEmpty() {
+		logger.Warn("nothing to update", slog.Int("employee id", employeeId))
+		return models.Employee{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrNothingToUpdate)
+	}
+
+	updatedEmployee, err := employeeSrv.employeeRepo.EditEmployee(ctx, employeeId, updateEmployee)
+	if err != nil {
+		if errors.Is(err, outerror.ErrEmployeeNotFound) {
+			logger.Warn("employee not found", slog.Int("employee id", employeeId))
+			return models.Employee{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeNotFound)
+		} else if errors.Is(err, outerror.ErrEmployeeAlreadyExists) {
+			logger.Warn("username already taken", slog.String("username", *updateEmployee.Username))
+			return models.Employee{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeAlreadyExists)
+		}
+		logger.Error("cannot edit employee", slog.Int("employee id", employeeId), slog.String("err", err.Error()))
+		return models.Employee{}, fmt.Errorf("cannot edit employee: %w", err)
+	}
+	logger.Info("employee updated", slog.Int("employee id", employeeId))
+	return updatedEmployee, nil
+}
@@ -0,0 +1,36 @@
+package organization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+// CreateOrganization создает организацию. Тип организации должен
+// быть в справочнике nsi_organization_type.
+func (orgSrv *OrganizationService) CreateOrganization(ctx context.Context, organization models.Organization) (models.Organization, error) {
+	const operationPlace = "internal.service.organization.create.CreateOrganization"
+	logger := orgSrv.logger.With("op", operationPlace)
+
+	_, err := orgSrv.orgRepo.GetOrganizationType(ctx, organization.Type)
+	if err != nil {
+		if errors.Is(err, outerror.ErrUnknownOrganizationType) {
+			logger.Warn("unknown organization type", slog.String("type", organization.Type))
+			return models.Organization{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrUnknownOrganizationType)
+		}
+		logger.Error("cannot get organization type", slog.String("err", err.Error()))
+		return models.Organization{}, fmt.Errorf("cannot get organization type: %w", err)
+	}
+
+	createdOrganization, err := orgSrv.orgRepo.CreateOrganization(ctx, organization)
+	if err != nil {
+		logger.Error("cannot create organization", slog.String("err", err.Error()))
+		return models.Organization{}, fmt.Errorf("cannot create organization: %w", err)
+	}
+	logger.Info("organization created", slog.Int("organization id", createdOrganization.ID))
+	return createdOrganization, nil
+}
@@ -0,0 +1,29 @@
+package organization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+// DeleteOrganization помечает организацию удаленной. Тендеры и
+// предложения организации не удаляются.
+func (orgSrv *OrganizationService) DeleteOrganization(ctx context.Context, orgId int) error {
+	const operationPlace = "internal.service.organization.delete.DeleteOrganization"
+	logger := orgSrv.logger.With("op", operationPlace)
+
+	err := orgSrv.orgRepo.DeleteOrganization(ctx, orgId)
+	if err != nil {
+		if errors.Is(err, outerror.ErrOrganizationNotFound) {
+			logger.Warn("organization not found", slog.Int("organization id", orgId))
+			return fmt.Errorf("%s: %w", operationPlace, outerror.ErrOrganizationNotFound)
+		}
+		logger.Error("cannot delete organization", slog.Int("organization id", orgId), slog.String("err", err.Error()))
+		return fmt.Errorf("cannot delete organization: %w", err)
+	}
+	logger.Info("organization deleted", slog.Int("organization id", orgId))
+	return nil
+}
@@ -0,0 +1,43 @@
+package organization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+// GetOrganization возвращает организацию по ее id.
+func (orgSrv *OrganizationService) GetOrganization(ctx context.Context, orgId int) (models.Organization, error) {
+	const operationPlace = "internal.service.organization.get.GetOrganization"
+	logger := orgSrv.logger.With("op", operationPlace)
+
+	organization, err := orgSrv.orgRepo.GetOrganizationById(ctx, orgId)
+	if err != nil {
+		if errors.Is(err, outerror.ErrOrganizationNotFound) {
+			logger.Warn("organization not found", slog.Int("organization id", orgId))
+			return models.Organization{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrOrganizationNotFound)
+		}
+		logger.Error("cannot get organization", slog.Int("organization id", orgId), slog.String("err", err.Error()))
+		return models.Organization{}, fmt.Errorf("cannot get organization: %w", err)
+	}
+	logger.Info("success get organization")
+	return organization, nil
+}
+
+// GetOrganizations возвращает страницу page списка организаций.
+func (orgSrv *OrganizationService) GetOrganizations(ctx context.Context, page models.Page) (models.OrganizationPage, error) {
+	const operationPlace = "internal.service.organization.get.GetOrganizations"
+	logger := orgSrv.logger.With("op", operationPlace)
+
+	organizations, err := orgSrv.orgRepo.GetOrganizations(ctx, page)
+	if err != nil {
+		logger.Error("cannot get organizations", slog.String("err", err.Error()))
+		return models.OrganizationPage{Organizations: []models.Organization{}}, fmt.Errorf("cannot get organizations: %w", err)
+	}
+	logger.Info("success get organizations")
+	return organizations, nil
+}
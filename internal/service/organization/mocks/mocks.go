@@ -0,0 +1,127 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockOrganizationManager реализует интерфейс OrganizationManager
+// для целей тестирования. Он позволяет задавать ожидаемые результаты
+// методов:
+//
+// - GetOrganizationById
+//
+// - CreateOrganization
+//
+// - GetOrganizations
+//
+// - EditOrganization
+//
+// - DeleteOrganization
+//
+// - GetOrganizationType
+//
+// - CheckResponsibility
+//
+// - GetOrganizationResponsibles
+//
+// - AddOrganizationResponsible
+//
+// - RemoveOrganizationResponsible
+type MockOrganizationManager struct {
+	mock.Mock
+}
+
+func (m *MockOrganizationManager) GetOrganizationById(ctx context.Context, orgId int) (models.Organization, error) {
+	args := m.Called(ctx, orgId)
+	return args.Get(0).(models.Organization), args.Error(1)
+}
+
+func (m *MockOrganizationManager) CreateOrganization(ctx context.Context, organization models.Organization) (models.Organization, error) {
+	args := m.Called(ctx, organization)
+	return args.Get(0).(models.Organization), args.Error(1)
+}
+
+func (m *MockOrganizationManager) GetOrganizations(ctx context.Context, page models.Page) (models.OrganizationPage, error) {
+	args := m.Called(ctx, page)
+	return args.Get(0).(models.OrganizationPage), args.Error(1)
+}
+
+func (m *MockOrganizationManager) EditOrganization(ctx context.Context, orgId int, updateOrganization models.OrganizationToUpdate) (models.Organization, error) {
+	args := m.Called(ctx, orgId, updateOrganization)
+	return args.Get(0).(models.Organization), args.Error(1)
+}
+
+func (m *MockOrganizationManager) DeleteOrganization(ctx context.Context, orgId int) error {
+	args := m.Called(ctx, orgId)
+	return args.Error(0)
+}
+
+func (m *MockOrganizationManager) GetOrganizationType(ctx context.Context, orgType string) (string, error) {
+	args := m.Called(ctx, orgType)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockOrganizationManager) CheckResponsibility(ctx context.Context, emplId int, orgId int) error {
+	args := m.Called(ctx, emplId, orgId)
+	return args.Error(0)
+}
+
+func (m *MockOrganizationManager) GetOrganizationResponsibles(ctx context.Context, orgId int) ([]models.Employee, error) {
+	args := m.Called(ctx, orgId)
+	return args.Get(0).([]models.Employee), args.Error(1)
+}
+
+func (m *MockOrganizationManager) AddOrganizationResponsible(ctx context.Context, orgId int, emplId int, role string) error {
+	args := m.Called(ctx, orgId, emplId, role)
+	return args.Error(0)
+}
+
+func (m *MockOrganizationManager) RemoveOrganizationResponsible(ctx context.Context, orgId int, emplId int) error {
+	args := m.Called(ctx, orgId, emplId)
+	return args.Error(0)
+}
+
+// MockEmployeeRepo реализует интерфейс EmployeeRepository
+// для целей тестирования. Он позволяет задавать ожидаемые результаты
+// методов:
+//
+// - GetEmployeeByUsername
+//
+// - GetEmployeeById
+type MockEmployeeRepo struct {
+	mock.Mock
+}
+
+func (m *MockEmployeeRepo) GetEmployeeByUsername(ctx context.Context, username string) (models.Employee, error) {
+	args := m.Called(ctx, username)
+	return args.Get(0).(models.Employee), args.Error(1)
+}
+
+func (m *MockEmployeeRepo) GetEmployeeById(ctx context.Context, id int) (models.Employee, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(models.Employee), args.Error(1)
+}
+
+// MockRoleRepo реализует интерфейс RoleRepository
+// для целей тестирования. Он позволяет задавать ожидаемые результаты
+// методов:
+//
+// - GetEmployeeRoles
+//
+// - GetOrganizationRole
+type MockRoleRepo struct {
+	mock.Mock
+}
+
+func (m *MockRoleRepo) GetEmployeeRoles(ctx context.Context, emplId int) ([]string, error) {
+	args := m.Called(ctx, emplId)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockRoleRepo) GetOrganizationRole(ctx context.Context, emplId int, orgId int) (string, error) {
+	args := m.Called(ctx, emplId, orgId)
+	return args.String(0), args.Error(1)
+}
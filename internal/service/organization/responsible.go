@@ -0,0 +1,159 @@
+package organization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+// GetResponsibles возвращает сотрудников, ответственных за организацию.
+func (orgSrv *OrganizationService) GetResponsibles(ctx context.Context, orgId int) ([]models.Employee, error) {
+	const operationPlace = "internal.service.organization.responsible.GetResponsibles"
+	logger := orgSrv.logger.With("op", operationPlace)
+
+	_, err := orgSrv.orgRepo.GetOrganizationById(ctx, orgId)
+	if err != nil {
+		if errors.Is(err, outerror.ErrOrganizationNotFound) {
+			logger.Warn("organization not found", slog.Int("organization id", orgId))
+			return []models.Employee{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrOrganizationNotFound)
+		}
+		logger.Error("cannot get organization", slog.Int("organization id", orgId), slog.String("err", err.Error()))
+		return []models.Employee{}, fmt.Errorf("cannot get organization: %w", err)
+	}
+
+	responsibles, err := orgSrv.orgRepo.GetOrganizationResponsibles(ctx, orgId)
+	if err != nil {
+		logger.Error("cannot get organization responsibles", slog.Int("organization id", orgId), slog.String("err", err.Error()))
+		return []models.Employee{}, fmt.Errorf("cannot get organization responsibles: %w", err)
+	}
+	logger.Info("success get organization responsibles")
+	return responsibles, nil
+}
+
+// GrantResponsibility делает сотрудника ответственным за организацию с ролью role.
+// Если роль не указана, то сотрудник становится участником (MEMBER).
+// Назначать ответственных может системный администратор или
+// администратор организации (ADMIN) username.
+func (orgSrv *OrganizationService) GrantResponsibility(ctx context.Context, username string, orgId int, employeeId int, role string) error {
+	const operationPlace = "internal.service.organization.responsible.GrantResponsibility"
+	logger := orgSrv.logger.With("op", operationPlace)
+
+	_, err := orgSrv.orgRepo.GetOrganizationById(ctx, orgId)
+	if err != nil {
+		if errors.Is(err, outerror.ErrOrganizationNotFound) {
+			logger.Warn("organization not found", slog.Int("organization id", orgId))
+			return fmt.Errorf("%s: %w", operationPlace, outerror.ErrOrganizationNotFound)
+		}
+		logger.Error("cannot get organization", slog.Int("organization id", orgId), slog.String("err", err.Error()))
+		return fmt.Errorf("cannot get organization: %w", err)
+	}
+
+	err = orgSrv.requireOrganizationAdmin(ctx, username, orgId)
+	if err != nil {
+		if errors.Is(err, outerror.ErrEmployeeNotSystemAdmin) {
+			logger.Warn("employee cannot manage responsibles", slog.String("username", username), slog.Int("organization id", orgId))
+			return fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		logger.Error("cannot check employee roles", slog.String("err", err.Error()))
+		return err
+	}
+
+	if role == "" {
+		role = models.OrganizationRoleMember
+	}
+	err = orgSrv.orgRepo.AddOrganizationResponsible(ctx, orgId, employeeId, role)
+	if err != nil {
+		if errors.Is(err, outerror.ErrEmployeeNotFound) {
+			logger.Warn("employee not found", slog.Int("employee id", employeeId))
+			return fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeNotFound)
+		} else if errors.Is(err, outerror.ErrEmployeeAlreadyResponsible) {
+			logger.Warn("employee already responsible", slog.Int("employee id", employeeId), slog.Int("organization id", orgId))
+			return fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeAlreadyResponsible)
+		}
+		logger.Error("cannot add organization responsible", slog.String("err", err.Error()))
+		return fmt.Errorf("cannot add organization responsible: %w", err)
+	}
+	logger.Info("responsibility granted", slog.Int("employee id", employeeId), slog.Int("organization id", orgId), slog.String("role", role))
+	return nil
+}
+
+// RevokeResponsibility снимает с сотрудника ответственность за организацию.
+// Последнего ответственного нельзя снять, пока у организации есть
+// тендеры в статусах CREATED или PUBLISHED. Снимать ответственных может
+// системный администратор или администратор организации (ADMIN) username.
+func (orgSrv *OrganizationService) RevokeResponsibility(ctx context.Context, username string, orgId int, employeeId int) error {
+	const operationPlace = "internal.service.organization.responsible.RevokeResponsibility"
+	logger := orgSrv.logger.With("op", operationPlace)
+
+	err := orgSrv.requireOrganizationAdmin(ctx, username, orgId)
+	if err != nil {
+		if errors.Is(err, outerror.ErrEmployeeNotSystemAdmin) {
+			logger.Warn("employee cannot manage responsibles", slog.String("username", username), slog.Int("organization id", orgId))
+			return fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		logger.Error("cannot check employee roles", slog.String("err", err.Error()))
+		return err
+	}
+
+	err = orgSrv.orgRepo.RemoveOrganizationResponsible(ctx, orgId, employeeId)
+	if err != nil {
+		if errors.Is(err, outerror.ErrOrganizationNotFound) {
+			logger.Warn("organization not found", slog.Int("organization id", orgId))
+			return fmt.Errorf("%s: %w", operationPlace, outerror.ErrOrganizationNotFound)
+		} else if errors.Is(err, outerror.ErrEmployeeNotResponsibleForOrganization) {
+			logger.Warn("employee not responsible", slog.Int("employee id", employeeId), slog.Int("organization id", orgId))
+			return fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeNotResponsibleForOrganization)
+		} else if errors.Is(err, outerror.ErrLastOrganizationResponsible) {
+			logger.Warn("cannot remove last responsible", slog.Int("employee id", employeeId), slog.Int("organization id", orgId))
+			return fmt.Errorf("%s: %w", operationPlace, outerror.ErrLastOrganizationResponsible)
+		}
+		logger.Error("cannot remove organization responsible", slog.String("err", err.Error()))
+		return fmt.Errorf("cannot remove organization responsible: %w", err)
+	}
+	logger.Info("responsibility revoked", slog.Int("employee id", employeeId), slog.Int("organization id", orgId))
+	return nil
+}
+
+// errNotOrganizationAdmin возвращается, если сотрудник не системный
+// администратор и не администратор организации. Ошибка оборачивает
+// ErrEmployeeNotSystemAdmin и ErrEmployeeNotResponsibleForOrganization,
+// поэтому ее можно отличить от ошибки про сотрудника, с которого
+// снимают ответственность, по ErrEmployeeNotSystemAdmin.
+var errNotOrganizationAdmin = errors.Join(outerror.ErrEmployeeNotSystemAdmin, outerror.ErrEmployeeNotResponsibleForOrganization)
+
+// requireOrganizationAdmin проверяет, что сотрудник username - системный
+// администратор или администратор (ADMIN) организации orgId.
+// Если нет, то возвращается errNotOrganizationAdmin.
+func (orgSrv *OrganizationService) requireOrganizationAdmin(ctx context.Context, username string, orgId int) error {
+	empl, err := orgSrv.employeeRepo.GetEmployeeByUsername(ctx, username)
+	if err != nil {
+		if errors.Is(err, outerror.ErrEmployeeNotFound) {
+			return errNotOrganizationAdmin
+		}
+		return fmt.Errorf("cannot get employee: %w", err)
+	}
+	roles, err := orgSrv.roleRepo.GetEmployeeRoles(ctx, empl.ID)
+	if err != nil {
+		return fmt.Errorf("cannot get employee roles: %w", err)
+	}
+	if slices.Contains(roles, models.RoleSystemAdmin) {
+		return nil
+	}
+
+	orgRole, err := orgSrv.roleRepo.GetOrganizationRole(ctx, empl.ID, orgId)
+	if err != nil {
+		if errors.Is(err, outerror.ErrEmployeeNotResponsibleForOrganization) {
+			return errNotOrganizationAdmin
+		}
+		return fmt.Errorf("cannot get organization role: %w", err)
+	}
+	if orgRole != models.OrganizationRoleAdmin {
+		return errNotOrganizationAdmin
+	}
+	return nil
+}
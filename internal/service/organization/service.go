@@ -0,0 +1,29 @@
+package organization
+
+import (
+	"log/slog"
+
+	"github.com/sariya23/tender/internal/repository"
+)
+
+// OrganizationService позволяет взаимодействовать с организациями.
+type OrganizationService struct {
+	logger       *slog.Logger
+	orgRepo      repository.OrganizationManager
+	employeeRepo repository.EmployeeRepository
+	roleRepo     repository.RoleRepository
+}
+
+func New(
+	logger *slog.Logger,
+	orgRepo repository.OrganizationManager,
+	employeeRepo repository.EmployeeRepository,
+	roleRepo repository.RoleRepository,
+) *OrganizationService {
+	return &OrganizationService{
+		logger:       logger,
+		orgRepo:      orgRepo,
+		employeeRepo: employeeRepo,
+		roleRepo:     roleRepo,
+	}
+}
@@ -0,0 +1,54 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/sariya23/tender/internal/service/organization"
+	"github.com/sariya23/tender/internal/service/organization/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCreateOrganization_Success проверяет, что
+// организация с известным типом создается.
+func TestCreateOrganization_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockOrgRepo := new(mocks.MockOrganizationManager)
+	logger := slogdiscard.NewDiscardLogger()
+	orgToCreate := models.Organization{Name: "Org", Description: "qwe", Type: "LLC"}
+	expectedOrg := models.Organization{ID: 1, Name: "Org", Description: "qwe", Type: "LLC"}
+	orgService := organization.New(logger, mockOrgRepo, new(mocks.MockEmployeeRepo), new(mocks.MockRoleRepo))
+	mockOrgRepo.On("GetOrganizationType", ctx, "LLC").Return("LLC", nil)
+	mockOrgRepo.On("CreateOrganization", ctx, orgToCreate).Return(expectedOrg, nil)
+
+	// Act
+	org, err := orgService.CreateOrganization(ctx, orgToCreate)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, expectedOrg, org)
+}
+
+// TestCreateOrganization_FailUnknownType проверяет, что
+// организация с типом не из справочника не создается.
+func TestCreateOrganization_FailUnknownType(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockOrgRepo := new(mocks.MockOrganizationManager)
+	logger := slogdiscard.NewDiscardLogger()
+	orgToCreate := models.Organization{Name: "Org", Description: "qwe", Type: "qwe"}
+	orgService := organization.New(logger, mockOrgRepo, new(mocks.MockEmployeeRepo), new(mocks.MockRoleRepo))
+	mockOrgRepo.On("GetOrganizationType", ctx, "qwe").Return("", outerror.ErrUnknownOrganizationType)
+
+	// Act
+	org, err := orgService.CreateOrganization(ctx, orgToCreate)
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrUnknownOrganizationType)
+	require.Equal(t, models.Organization{}, org)
+	mockOrgRepo.AssertNotCalled(t, "CreateOrganization")
+}
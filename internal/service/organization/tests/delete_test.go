@@ -0,0 +1,46 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/sariya23/tender/internal/service/organization"
+	"github.com/sariya23/tender/internal/service/organization/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDeleteOrganization_Success проверяет успешное удаление организации.
+func TestDeleteOrganization_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockOrgRepo := new(mocks.MockOrganizationManager)
+	logger := slogdiscard.NewDiscardLogger()
+	orgService := organization.New(logger, mockOrgRepo, new(mocks.MockEmployeeRepo), new(mocks.MockRoleRepo))
+	mockOrgRepo.On("DeleteOrganization", ctx, 1).Return(nil)
+
+	// Act
+	err := orgService.DeleteOrganization(ctx, 1)
+
+	// Assert
+	require.NoError(t, err)
+	mockOrgRepo.AssertExpectations(t)
+}
+
+// TestDeleteOrganization_FailNotFound проверяет, что
+// нельзя удалить несуществующую или уже удаленную организацию.
+func TestDeleteOrganization_FailNotFound(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockOrgRepo := new(mocks.MockOrganizationManager)
+	logger := slogdiscard.NewDiscardLogger()
+	orgService := organization.New(logger, mockOrgRepo, new(mocks.MockEmployeeRepo), new(mocks.MockRoleRepo))
+	mockOrgRepo.On("DeleteOrganization", ctx, 1).Return(outerror.ErrOrganizationNotFound)
+
+	// Act
+	err := orgService.DeleteOrganization(ctx, 1)
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrOrganizationNotFound)
+}
@@ -0,0 +1,75 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/sariya23/tender/internal/service/organization"
+	"github.com/sariya23/tender/internal/service/organization/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetOrganization_Success проверяет, что
+// существующая организация возвращается по id.
+func TestGetOrganization_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockOrgRepo := new(mocks.MockOrganizationManager)
+	logger := slogdiscard.NewDiscardLogger()
+	expectedOrg := models.Organization{ID: 1, Name: "Org", Type: "LLC"}
+	orgService := organization.New(logger, mockOrgRepo, new(mocks.MockEmployeeRepo), new(mocks.MockRoleRepo))
+	mockOrgRepo.On("GetOrganizationById", ctx, 1).Return(expectedOrg, nil)
+
+	// Act
+	org, err := orgService.GetOrganization(ctx, 1)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, expectedOrg, org)
+}
+
+// TestGetOrganization_FailNotFound проверяет, что
+// если организация не найдена, то возвращается ErrOrganizationNotFound.
+func TestGetOrganization_FailNotFound(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockOrgRepo := new(mocks.MockOrganizationManager)
+	logger := slogdiscard.NewDiscardLogger()
+	orgService := organization.New(logger, mockOrgRepo, new(mocks.MockEmployeeRepo), new(mocks.MockRoleRepo))
+	mockOrgRepo.On("GetOrganizationById", ctx, 1).Return(models.Organization{}, outerror.ErrOrganizationNotFound)
+
+	// Act
+	org, err := orgService.GetOrganization(ctx, 1)
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrOrganizationNotFound)
+	require.Equal(t, models.Organization{}, org)
+}
+
+// TestGetOrganizations_Success проверяет, что
+// страница передается в репозиторий и возвращается список организаций.
+func TestGetOrganizations_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockOrgRepo := new(mocks.MockOrganizationManager)
+	logger := slogdiscard.NewDiscardLogger()
+	nextCursor := 2
+	page := models.Page{Limit: 2}
+	expectedPage := models.OrganizationPage{
+		Organizations: []models.Organization{{ID: 1, Name: "Org 1", Type: "LLC"}, {ID: 2, Name: "Org 2", Type: "IE"}},
+		Total:         3,
+		NextCursor:    &nextCursor,
+	}
+	orgService := organization.New(logger, mockOrgRepo, new(mocks.MockEmployeeRepo), new(mocks.MockRoleRepo))
+	mockOrgRepo.On("GetOrganizations", ctx, page).Return(expectedPage, nil)
+
+	// Act
+	orgs, err := orgService.GetOrganizations(ctx, page)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, expectedPage, orgs)
+}
@@ -0,0 +1,226 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/sariya23/tender/internal/service/organization"
+	"github.com/sariya23/tender/internal/service/organization/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+// newOrganizationAdminRepos возвращает репозитории, по которым
+// сотрудник qwe - администратор организации 1.
+func newOrganizationAdminRepos(ctx context.Context) (*mocks.MockEmployeeRepo, *mocks.MockRoleRepo) {
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockRoleRepo := new(mocks.MockRoleRepo)
+	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, "qwe").Return(models.Employee{ID: 10, Username: "qwe"}, nil)
+	mockRoleRepo.On("GetEmployeeRoles", ctx, 10).Return([]string{}, nil)
+	mockRoleRepo.On("GetOrganizationRole", ctx, 10, 1).Return(models.OrganizationRoleAdmin, nil)
+	return mockEmployeeRepo, mockRoleRepo
+}
+
+// TestGetResponsibles_Success проверяет, что
+// возвращаются ответственные за организацию сотрудники.
+func TestGetResponsibles_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockOrgRepo := new(mocks.MockOrganizationManager)
+	logger := slogdiscard.NewDiscardLogger()
+	expectedResponsibles := []models.Employee{{ID: 1, Username: "qwe"}}
+	orgService := organization.New(logger, mockOrgRepo, new(mocks.MockEmployeeRepo), new(mocks.MockRoleRepo))
+	mockOrgRepo.On("GetOrganizationById", ctx, 1).Return(models.Organization{ID: 1}, nil)
+	mockOrgRepo.On("GetOrganizationResponsibles", ctx, 1).Return(expectedResponsibles, nil)
+
+	// Act
+	responsibles, err := orgService.GetResponsibles(ctx, 1)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, expectedResponsibles, responsibles)
+}
+
+// TestGrantResponsibility_FailOrganizationNotFound проверяет, что
+// нельзя сделать сотрудника ответственным за несуществующую организацию.
+func TestGrantResponsibility_FailOrganizationNotFound(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockOrgRepo := new(mocks.MockOrganizationManager)
+	logger := slogdiscard.NewDiscardLogger()
+	orgService := organization.New(logger, mockOrgRepo, new(mocks.MockEmployeeRepo), new(mocks.MockRoleRepo))
+	mockOrgRepo.On("GetOrganizationById", ctx, 1).Return(models.Organization{}, outerror.ErrOrganizationNotFound)
+
+	// Act
+	err := orgService.GrantResponsibility(ctx, "qwe", 1, 2, "")
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrOrganizationNotFound)
+	mockOrgRepo.AssertNotCalled(t, "AddOrganizationResponsible")
+}
+
+// TestGrantResponsibility_Fail проверяет ошибки при
+// назначении ответственного.
+func TestGrantResponsibility_Fail(t *testing.T) {
+	cases := []struct {
+		name    string
+		repoErr error
+	}{
+		{name: "employee not found", repoErr: outerror.ErrEmployeeNotFound},
+		{name: "already responsible", repoErr: outerror.ErrEmployeeAlreadyResponsible},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+			mockOrgRepo := new(mocks.MockOrganizationManager)
+			logger := slogdiscard.NewDiscardLogger()
+			mockEmployeeRepo, mockRoleRepo := newOrganizationAdminRepos(ctx)
+			orgService := organization.New(logger, mockOrgRepo, mockEmployeeRepo, mockRoleRepo)
+			mockOrgRepo.On("GetOrganizationById", ctx, 1).Return(models.Organization{ID: 1}, nil)
+			mockOrgRepo.On("AddOrganizationResponsible", ctx, 1, 2, models.OrganizationRoleMember).Return(tc.repoErr)
+
+			// Act
+			err := orgService.GrantResponsibility(ctx, "qwe", 1, 2, "")
+
+			// Assert
+			require.ErrorIs(t, err, tc.repoErr)
+		})
+	}
+}
+
+// TestGrantResponsibility_Success проверяет, что сотрудник становится
+// ответственным с указанной ролью, а без роли - участником.
+func TestGrantResponsibility_Success(t *testing.T) {
+	cases := []struct {
+		name         string
+		role         string
+		expectedRole string
+	}{
+		{name: "without role", role: "", expectedRole: models.OrganizationRoleMember},
+		{name: "admin", role: models.OrganizationRoleAdmin, expectedRole: models.OrganizationRoleAdmin},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+			mockOrgRepo := new(mocks.MockOrganizationManager)
+			logger := slogdiscard.NewDiscardLogger()
+			mockEmployeeRepo, mockRoleRepo := newOrganizationAdminRepos(ctx)
+			orgService := organization.New(logger, mockOrgRepo, mockEmployeeRepo, mockRoleRepo)
+			mockOrgRepo.On("GetOrganizationById", ctx, 1).Return(models.Organization{ID: 1}, nil)
+			mockOrgRepo.On("AddOrganizationResponsible", ctx, 1, 2, tc.expectedRole).Return(nil)
+
+			// Act
+			err := orgService.GrantResponsibility(ctx, "qwe", 1, 2, tc.role)
+
+			// Assert
+			require.NoError(t, err)
+			mockOrgRepo.AssertExpectations(t)
+		})
+	}
+}
+
+// TestRevokeResponsibility_Success проверяет, что
+// ответственность снимается.
+func TestRevokeResponsibility_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockOrgRepo := new(mocks.MockOrganizationManager)
+	logger := slogdiscard.NewDiscardLogger()
+	mockEmployeeRepo, mockRoleRepo := newOrganizationAdminRepos(ctx)
+	orgService := organization.New(logger, mockOrgRepo, mockEmployeeRepo, mockRoleRepo)
+	mockOrgRepo.On("RemoveOrganizationResponsible", ctx, 1, 2).Return(nil)
+
+	// Act
+	err := orgService.RevokeResponsibility(ctx, "qwe", 1, 2)
+
+	// Assert
+	require.NoError(t, err)
+}
+
+// TestRevokeResponsibility_FailLastResponsible проверяет, что
+// нельзя снять последнего ответственного организации с незакрытыми тендерами.
+func TestRevokeResponsibility_FailLastResponsible(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockOrgRepo := new(mocks.MockOrganizationManager)
+	logger := slogdiscard.NewDiscardLogger()
+	mockEmployeeRepo, mockRoleRepo := newOrganizationAdminRepos(ctx)
+	orgService := organization.New(logger, mockOrgRepo, mockEmployeeRepo, mockRoleRepo)
+	mockOrgRepo.On("RemoveOrganizationResponsible", ctx, 1, 2).Return(outerror.ErrLastOrganizationResponsible)
+
+	// Act
+	err := orgService.RevokeResponsibility(ctx, "qwe", 1, 2)
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrLastOrganizationResponsible)
+}
+
+// TestGrantResponsibility_SuccessSystemAdmin проверяет, что системный
+// администратор может назначать ответственных любой организации.
+func TestGrantResponsibility_SuccessSystemAdmin(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockOrgRepo := new(mocks.MockOrganizationManager)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockRoleRepo := new(mocks.MockRoleRepo)
+	logger := slogdiscard.NewDiscardLogger()
+	orgService := organization.New(logger, mockOrgRepo, mockEmployeeRepo, mockRoleRepo)
+	mockOrgRepo.On("GetOrganizationById", ctx, 1).Return(models.Organization{ID: 1}, nil)
+	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, "root").Return(models.Employee{ID: 11, Username: "root"}, nil)
+	mockRoleRepo.On("GetEmployeeRoles", ctx, 11).Return([]string{models.RoleSystemAdmin}, nil)
+	mockOrgRepo.On("AddOrganizationResponsible", ctx, 1, 2, models.OrganizationRoleMember).Return(nil)
+
+	// Act
+	err := orgService.GrantResponsibility(ctx, "root", 1, 2, "")
+
+	// Assert
+	require.NoError(t, err)
+	mockRoleRepo.AssertNotCalled(t, "GetOrganizationRole")
+}
+
+// TestResponsibility_FailNotOrganizationAdmin проверяет, что назначать
+// и снимать ответственных может только системный администратор или
+// администратор организации.
+func TestResponsibility_FailNotOrganizationAdmin(t *testing.T) {
+	cases := []struct {
+		name        string
+		employeeErr error
+		orgRole     string
+		orgRoleErr  error
+	}{
+		{name: "employee not found", employeeErr: outerror.ErrEmployeeNotFound},
+		{name: "not responsible", orgRoleErr: outerror.ErrEmployeeNotResponsibleForOrganization},
+		{name: "member", orgRole: models.OrganizationRoleMember},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+			mockOrgRepo := new(mocks.MockOrganizationManager)
+			mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+			mockRoleRepo := new(mocks.MockRoleRepo)
+			logger := slogdiscard.NewDiscardLogger()
+			orgService := organization.New(logger, mockOrgRepo, mockEmployeeRepo, mockRoleRepo)
+			mockOrgRepo.On("GetOrganizationById", ctx, 1).Return(models.Organization{ID: 1}, nil)
+			mockEmployeeRepo.On("GetEmployeeByUsername", ctx, "asd").Return(models.Employee{ID: 12, Username: "asd"}, tc.employeeErr)
+			mockRoleRepo.On("GetEmployeeRoles", ctx, 12).Return([]string{models.RoleAuditor}, nil)
+			mockRoleRepo.On("GetOrganizationRole", ctx, 12, 1).Return(tc.orgRole, tc.orgRoleErr)
+
+			// Act
+			grantErr := orgService.GrantResponsibility(ctx, "asd", 1, 2, "")
+			revokeErr := orgService.RevokeResponsibility(ctx, "asd", 1, 2)
+
+			// Assert
+			for _, err := range []error{grantErr, revokeErr} {
+				require.ErrorIs(t, err, outerror.ErrEmployeeNotSystemAdmin)
+				require.ErrorIs(t, err, outerror.ErrEmployeeNotResponsibleForOrganization)
+			}
+			mockOrgRepo.AssertNotCalled(t, "AddOrganizationResponsible")
+			mockOrgRepo.AssertNotCalled(t, "RemoveOrganizationResponsible")
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/sariya23/tender/internal/service/organization"
+	"github.com/sariya23/tender/internal/service/organization/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEditOrganization_Success проверяет, что
+// организация обновляется, если новый тип есть в справочнике.
+func TestEditOrganization_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockOrgRepo := new(mocks.MockOrganizationManager)
+	logger := slogdiscard.NewDiscardLogger()
+	newName := "New org"
+	newType := "JSC"
+	updateOrg := models.OrganizationToUpdate{Name: &newName, Type: &newType}
+	expectedOrg := models.Organization{ID: 1, Name: newName, Type: newType}
+	orgService := organization.New(logger, mockOrgRepo, new(mocks.MockEmployeeRepo), new(mocks.MockRoleRepo))
+	mockOrgRepo.On("GetOrganizationType", ctx, newType).Return(newType, nil)
+	mockOrgRepo.On("EditOrganization", ctx, 1, updateOrg).Return(expectedOrg, nil)
+
+	// Act
+	org, err := orgService.EditOrganization(ctx, 1, updateOrg)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, expectedOrg, org)
+}
+
+// TestEditOrganization_FailNothingToUpdate проверяет, что
+// если нечего обновлять, то возвращается ErrNothingToUpdate.
+func TestEditOrganization_FailNothingToUpdate(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockOrgRepo := new(mocks.MockOrganizationManager)
+	logger := slogdiscard.NewDiscardLogger()
+	orgService := organization.New(logger, mockOrgRepo, new(mocks.MockEmployeeRepo), new(mocks.MockRoleRepo))
+
+	// Act
+	org, err := orgService.EditOrganization(ctx, 1, models.OrganizationToUpdate{})
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrNothingToUpdate)
+	require.Equal(t, models.Organization{}, org)
+	mockOrgRepo.AssertNotCalled(t, "EditOrganization")
+}
+
+// TestEditOrganization_FailUnknownType проверяет, что
+// нельзя установить тип не из справочника.
+func TestEditOrganization_FailUnknownType(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockOrgRepo := new(mocks.MockOrganizationManager)
+	logger := slogdiscard.NewDiscardLogger()
+	newType := "qwe"
+	orgService := organization.New(logger, mockOrgRepo, new(mocks.MockEmployeeRepo), new(mocks.MockRoleRepo))
+	mockOrgRepo.On("GetOrganizationType", ctx, newType).Return("", outerror.ErrUnknownOrganizationType)
+
+	// Act
+	org, err := orgService.EditOrganization(ctx, 1, models.OrganizationToUpdate{Type: &newType})
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrUnknownOrganizationType)
+	require.Equal(t, models.Organization{}, org)
+	mockOrgRepo.AssertNotCalled(t, "EditOrganization")
+}
+
+// TestEditOrganization_FailNotFound проверяет, что
+// если организация не найдена, то возвращается ErrOrganizationNotFound.
+func TestEditOrganization_FailNotFound(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockOrgRepo := new(mocks.MockOrganizationManager)
+	logger := slogdiscard.NewDiscardLogger()
+	newName := "New org"
+	updateOrg := models.OrganizationToUpdate{Name: &newName}
+	orgService := organization.New(logger, mockOrgRepo, new(mocks.MockEmployeeRepo), new(mocks.MockRoleRepo))
+	mockOrgRepo.On("EditOrganization", ctx, 1, updateOrg).Return(models.Organization{}, outerror.ErrOrganizationNotFound)
+
+	// Act
+	org, err := orgService.EditOrganization(ctx, 1, updateOrg)
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrOrganizationNotFound)
+	require.Equal(t, models.Organization{}, org)
+}
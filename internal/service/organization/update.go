@@ -0,0 +1,45 @@
+package organization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+// EditOrganization обновляет поля организации, которые заданы в updateOrganization.
+func (orgSrv *OrganizationService) EditOrganization(ctx context.Context, orgId int, updateOrganization models.OrganizationToUpdate) (models.Organization, error) {
+	const operationPlace = "internal.service.organization.update.EditOrganization"
+	logger := orgSrv.logger.With("op", operationPlace)
+
+	if updateOrganization.IsEmpty() {
+		logger.Warn("nothing to update", slog.Int("organization id", orgId))
+		return models.Organization{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrNothingToUpdate)
+	}
+	if updateOrganization.Type != nil {
+		_, err := orgSrv.orgRepo.GetOrganizationType(ctx, *updateOrganization.Type)
+		if err != nil {
+			if errors.Is(err, outerror.ErrUnknownOrganizationType) {
+				logger.Warn("unknown organization type", slog.String("type", *updateOrganization.Type))
+				return models.Organization{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrUnknownOrganizationType)
+			}
+			logger.Error("cannot get organization type", slog.String("err", err.Error()))
+			return models.Organization{}, fmt.Errorf("cannot get organization type: %w", err)
+		}
+	}
+
+	updatedOrganization, err := orgSrv.orgRepo.EditOrganization(ctx, orgId, updateOrganization)
+	if err != nil {
+		if errors.Is(err, outerror.ErrOrganizationNotFound) {
+			logger.Warn("organization not found", slog.Int("organization id", orgId))
+			return models.Organization{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrOrganizationNotFound)
+		}
+		logger.Error("cannot edit organization", slog.Int("organization id", orgId), slog.String("err", err.Error()))
+		return models.Organization{}, fmt.Errorf("cannot edit organization: %w", err)
+	}
+	logger.Info("organization updated", slog.Int("organization id", orgId))
+	return updatedOrganization, nil
+}
@@ -0,0 +1,71 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockServiceTypeManager реализует интерфейс ServiceTypeManager
+// для целей тестирования. Он позволяет задавать ожидаемые результаты
+// методов:
+//
+// - GetServiceTypeByName
+//
+// - GetServiceTypes
+//
+// - CreateServiceType
+//
+// - EditServiceType
+//
+// - DeleteServiceType
+type MockServiceTypeManager struct {
+	mock.Mock
+}
+
+func (m *MockServiceTypeManager) GetServiceTypeByName(ctx context.Context, name string) (models.ServiceType, error) {
+	args := m.Called(ctx, name)
+	return args.Get(0).(models.ServiceType), args.Error(1)
+}
+
+func (m *MockServiceTypeManager) GetServiceTypes(ctx context.Context) ([]models.ServiceType, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]models.ServiceType), args.Error(1)
+}
+
+func (m *MockServiceTypeManager) CreateServiceType(ctx context.Context, name string) (models.ServiceType, error) {
+	args := m.Called(ctx, name)
+	return args.Get(0).(models.ServiceType), args.Error(1)
+}
+
+func (m *MockServiceTypeManager) EditServiceType(ctx context.Context, id int, name string) (models.ServiceType, error) {
+	args := m.Called(ctx, id, name)
+	return args.Get(0).(models.ServiceType), args.Error(1)
+}
+
+func (m *MockServiceTypeManager) DeleteServiceType(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// MockRoleRepo реализует интерфейс RoleRepository
+// для целей тестирования. Он позволяет задавать ожидаемые результаты
+// методов:
+//
+// - GetEmployeeRoles
+//
+// - GetOrganizationRole
+type MockRoleRepo struct {
+	mock.Mock
+}
+
+func (m *MockRoleRepo) GetEmployeeRoles(ctx context.Context, emplId int) ([]string, error) {
+	args := m.Called(ctx, emplId)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockRoleRepo) GetOrganizationRole(ctx context.Context, emplId int, orgId int) (string, error) {
+	args := m.Called(ctx, emplId, orgId)
+	return args.String(0), args.Error(1)
+}
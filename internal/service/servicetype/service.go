@@ -0,0 +1,22 @@
+package servicetype
+
+import (
+	"log/slog"
+
+	"github.com/sariya23/tender/internal/repository"
+)
+
+// ServiceTypeService позволяет вести справочник типов услуг тендеров.
+type ServiceTypeService struct {
+	logger          *slog.Logger
+	serviceTypeRepo repository.ServiceTypeManager
+	roleRepo        repository.RoleRepository
+}
+
+func New(logger *slog.Logger, serviceTypeRepo repository.ServiceTypeManager, roleRepo repository.RoleRepository) *ServiceTypeService {
+	return &ServiceTypeService{
+		logger:          logger,
+		serviceTypeRepo: serviceTypeRepo,
+		roleRepo:        roleRepo,
+	}
+}
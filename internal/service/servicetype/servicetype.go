@@ -0,0 +1,135 @@
+package servicetype
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+// GetServiceTypes возвращает типы услуг из справочника с количеством
+// опубликованных тендеров каждого типа.
+func (serviceTypeSrv *ServiceTypeService) GetServiceTypes(ctx context.Context) ([]models.ServiceType, error) {
+	const operationPlace = "internal.service.servicetype.servicetype.GetServiceTypes"
+	logger := serviceTypeSrv.logger.With("op", operationPlace)
+
+	serviceTypes, err := serviceTypeSrv.serviceTypeRepo.GetServiceTypes(ctx)
+	if err != nil {
+		logger.Error("cannot get service types", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("cannot get service types: %w", err)
+	}
+	logger.Info("success get service types")
+	return serviceTypes, nil
+}
+
+// CreateServiceType добавляет тип услуг name в справочник. Справочник
+// ведет только системный администратор.
+func (serviceTypeSrv *ServiceTypeService) CreateServiceType(ctx context.Context, employeeId int, name string) (models.ServiceType, error) {
+	const operationPlace = "internal.service.servicetype.servicetype.CreateServiceType"
+	logger := serviceTypeSrv.logger.With("op", operationPlace)
+
+	err := serviceTypeSrv.requireSystemAdmin(ctx, employeeId)
+	if err != nil {
+		if errors.Is(err, outerror.ErrEmployeeNotSystemAdmin) {
+			logger.Warn("employee is not system admin", slog.Int("employee id", employeeId))
+			return models.ServiceType{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeNotSystemAdmin)
+		}
+		logger.Error("cannot check employee roles", slog.String("err", err.Error()))
+		return models.ServiceType{}, err
+	}
+
+	createdServiceType, err := serviceTypeSrv.serviceTypeRepo.CreateServiceType(ctx, name)
+	if err != nil {
+		if errors.Is(err, outerror.ErrServiceTypeAlreadyExists) {
+			logger.Warn("service type already exists", slog.String("name", name))
+			return models.ServiceType{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrServiceTypeAlreadyExists)
+		}
+		logger.Error("cannot create service type", slog.String("err", err.Error()))
+		return models.ServiceType{}, fmt.Errorf("cannot create service type: %w", err)
+	}
+	logger.Info("service type created", slog.Int("service type id", createdServiceType.ID))
+	return createdServiceType, nil
+}
+
+// EditServiceType переименовывает тип услуг serviceTypeId в name. Тендеры
+// с этим типом переходят на новое название. Справочник ведет только
+// системный администратор.
+func (serviceTypeSrv *ServiceTypeService) EditServiceType(ctx context.Context, employeeId int, serviceTypeId int, name string) (models.ServiceType, error) {
+	const operationPlace = "internal.service.servicetype.servicetype.EditServiceType"
+	logger := serviceTypeSrv.logger.With("op", operationPlace)
+
+	err := serviceTypeSrv.requireSystemAdmin(ctx, employeeId)
+	if err != nil {
+		if errors.Is(err, outerror.ErrEmployeeNotSystemAdmin) {
+			logger.Warn("employee is not system admin", slog.Int("employee id", employeeId))
+			return models.ServiceType{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeNotSystemAdmin)
+		}
+		logger.Error("cannot check employee roles", slog.String("err", err.Error()))
+		return models.ServiceType{}, err
+	}
+
+	updatedServiceType, err := serviceTypeSrv.serviceTypeRepo.EditServiceType(ctx, serviceTypeId, name)
+	if err != nil {
+		if errors.Is(err, outerror.ErrServiceTypeNotFound) {
+			logger.Warn("service type not found", slog.Int("service type id", serviceTypeId))
+			return models.ServiceType{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrServiceTypeNotFound)
+		} else if errors.Is(err, outerror.ErrServiceTypeAlreadyExists) {
+			logger.Warn("service type already exists", slog.String("name", name))
+			return models.ServiceType{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrServiceTypeAlreadyExists)
+		}
+		logger.Error("cannot edit service type", slog.String("err", err.Error()))
+		return models.ServiceType{}, fmt.Errorf("cannot edit service type: %w", err)
+	}
+	logger.Info("service type renamed", slog.Int("service type id", serviceTypeId))
+	return updatedServiceType, nil
+}
+
+// DeleteServiceType удаляет тип услуг serviceTypeId из справочника. Тип,
+// который есть хотя бы у одной версии тендера, удалить нельзя. Справочник
+// ведет только системный администратор.
+func (serviceTypeSrv *ServiceTypeService) DeleteServiceType(ctx context.Context, employeeId int, serviceTypeId int) error {
+	const operationPlace = "internal.service.servicetype.servicetype.DeleteServiceType"
+	logger := serviceTypeSrv.logger.With("op", operationPlace)
+
+	err := serviceTypeSrv.requireSystemAdmin(ctx, employeeId)
+	if err != nil {
+		if errors.Is(err, outerror.ErrEmployeeNotSystemAdmin) {
+			logger.Warn("employee is not system admin", slog.Int("employee id", employeeId))
+			return fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeNotSystemAdmin)
+		}
+		logger.Error("cannot check employee roles", slog.String("err", err.Error()))
+		return err
+	}
+
+	err = serviceTypeSrv.serviceTypeRepo.DeleteServiceType(ctx, serviceTypeId)
+	if err != nil {
+		if errors.Is(err, outerror.ErrServiceTypeNotFound) {
+			logger.Warn("service type not found", slog.Int("service type id", serviceTypeId))
+			return fmt.Errorf("%s: %w", operationPlace, outerror.ErrServiceTypeNotFound)
+		} else if errors.Is(err, outerror.ErrServiceTypeInUse) {
+			logger.Warn("service type is used by tenders", slog.Int("service type id", serviceTypeId))
+			return fmt.Errorf("%s: %w", operationPlace, outerror.ErrServiceTypeInUse)
+		}
+		logger.Error("cannot delete service type", slog.String("err", err.Error()))
+		return fmt.Errorf("cannot delete service type: %w", err)
+	}
+	logger.Info("service type deleted", slog.Int("service type id", serviceTypeId))
+	return nil
+}
+
+// requireSystemAdmin проверяет, что у сотрудника employeeId есть роль
+// системного администратора. Если нет, то возвращается ErrEmployeeNotSystemAdmin.
+func (serviceTypeSrv *ServiceTypeService) requireSystemAdmin(ctx context.Context, employeeId int) error {
+	roles, err := serviceTypeSrv.roleRepo.GetEmployeeRoles(ctx, employeeId)
+	if err != nil {
+		return fmt.Errorf("cannot get employee roles: %w", err)
+	}
+	if !slices.Contains(roles, models.RoleSystemAdmin) {
+		return outerror.ErrEmployeeNotSystemAdmin
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/sariya23/tender/internal/service/servicetype"
+	"github.com/sariya23/tender/internal/service/servicetype/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetServiceTypes_Success проверяет, что типы услуг
+// возвращаются из справочника без проверки ролей.
+func TestGetServiceTypes_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockServiceTypeRepo := new(mocks.MockServiceTypeManager)
+	mockRoleRepo := new(mocks.MockRoleRepo)
+	logger := slogdiscard.NewDiscardLogger()
+	serviceTypeService := servicetype.New(logger, mockServiceTypeRepo, mockRoleRepo)
+	expectedServiceTypes := []models.ServiceType{{ID: 1, Name: "Construction", PublishedTenders: 2}}
+	mockServiceTypeRepo.On("GetServiceTypes", ctx).Return(expectedServiceTypes, nil)
+
+	// Act
+	serviceTypes, err := serviceTypeService.GetServiceTypes(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, expectedServiceTypes, serviceTypes)
+	mockRoleRepo.AssertNotCalled(t, "GetEmployeeRoles")
+}
+
+// TestCreateServiceType_Success проверяет, что системный
+// администратор может добавить тип услуг.
+func TestCreateServiceType_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockServiceTypeRepo := new(mocks.MockServiceTypeManager)
+	mockRoleRepo := new(mocks.MockRoleRepo)
+	logger := slogdiscard.NewDiscardLogger()
+	serviceTypeService := servicetype.New(logger, mockServiceTypeRepo, mockRoleRepo)
+	mockRoleRepo.On("GetEmployeeRoles", ctx, 1).Return([]string{models.RoleSystemAdmin}, nil)
+	mockServiceTypeRepo.On("CreateServiceType", ctx, "Consulting").Return(models.ServiceType{ID: 4, Name: "Consulting"}, nil)
+
+	// Act
+	serviceType, err := serviceTypeService.CreateServiceType(ctx, 1, "Consulting")
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, models.ServiceType{ID: 4, Name: "Consulting"}, serviceType)
+}
+
+// TestCreateServiceType_FailNotSystemAdmin проверяет, что сотрудник
+// без роли системного администратора не может менять справочник.
+func TestCreateServiceType_FailNotSystemAdmin(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockServiceTypeRepo := new(mocks.MockServiceTypeManager)
+	mockRoleRepo := new(mocks.MockRoleRepo)
+	logger := slogdiscard.NewDiscardLogger()
+	serviceTypeService := servicetype.New(logger, mockServiceTypeRepo, mockRoleRepo)
+	mockRoleRepo.On("GetEmployeeRoles", ctx, 1).Return([]string{models.RoleAuditor}, nil)
+
+	// Act
+	serviceType, err := serviceTypeService.CreateServiceType(ctx, 1, "Consulting")
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrEmployeeNotSystemAdmin)
+	require.Equal(t, models.ServiceType{}, serviceType)
+	mockServiceTypeRepo.AssertNotCalled(t, "CreateServiceType")
+}
+
+// TestCreateServiceType_FailAlreadyExists проверяет, что если тип
+// с таким названием уже есть, то возвращается ErrServiceTypeAlreadyExists.
+func TestCreateServiceType_FailAlreadyExists(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockServiceTypeRepo := new(mocks.MockServiceTypeManager)
+	mockRoleRepo := new(mocks.MockRoleRepo)
+	logger := slogdiscard.NewDiscardLogger()
+	serviceTypeService := servicetype.New(logger, mockServiceTypeRepo, mockRoleRepo)
+	mockRoleRepo.On("GetEmployeeRoles", ctx, 1).Return([]string{models.RoleSystemAdmin}, nil)
+	mockServiceTypeRepo.On("CreateServiceType", ctx, "construction").Return(models.ServiceType{}, outerror.ErrServiceTypeAlreadyExists)
+
+	// Act
+	_, err := serviceTypeService.CreateServiceType(ctx, 1, "construction")
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrServiceTypeAlreadyExists)
+}
+
+// TestCreateServiceType_FailRolesError проверяет, что ошибка получения
+// ролей не выдается за отказ в доступе.
+func TestCreateServiceType_FailRolesError(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockServiceTypeRepo := new(mocks.MockServiceTypeManager)
+	mockRoleRepo := new(mocks.MockRoleRepo)
+	logger := slogdiscard.NewDiscardLogger()
+	serviceTypeService := servicetype.New(logger, mockServiceTypeRepo, mockRoleRepo)
+	mockRoleRepo.On("GetEmployeeRoles", ctx, 1).Return([]string{}, errors.New("connection refused"))
+
+	// Act
+	_, err := serviceTypeService.CreateServiceType(ctx, 1, "Consulting")
+
+	// Assert
+	require.Error(t, err)
+	require.NotErrorIs(t, err, outerror.ErrEmployeeNotSystemAdmin)
+	mockServiceTypeRepo.AssertNotCalled(t, "CreateServiceType")
+}
+
+// TestEditServiceType_FailNotFound проверяет, что при переименовании
+// несуществующего типа возвращается ErrServiceTypeNotFound.
+func TestEditServiceType_FailNotFound(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockServiceTypeRepo := new(mocks.MockServiceTypeManager)
+	mockRoleRepo := new(mocks.MockRoleRepo)
+	logger := slogdiscard.NewDiscardLogger()
+	serviceTypeService := servicetype.New(logger, mockServiceTypeRepo, mockRoleRepo)
+	mockRoleRepo.On("GetEmployeeRoles", ctx, 1).Return([]string{models.RoleSystemAdmin}, nil)
+	mockServiceTypeRepo.On("EditServiceType", ctx, 10, "Consulting").Return(models.ServiceType{}, outerror.ErrServiceTypeNotFound)
+
+	// Act
+	_, err := serviceTypeService.EditServiceType(ctx, 1, 10, "Consulting")
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrServiceTypeNotFound)
+}
+
+// TestDeleteServiceType_FailInUse проверяет, что тип услуг,
+// который есть у тендеров, не удаляется.
+func TestDeleteServiceType_FailInUse(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockServiceTypeRepo := new(mocks.MockServiceTypeManager)
+	mockRoleRepo := new(mocks.MockRoleRepo)
+	logger := slogdiscard.NewDiscardLogger()
+	serviceTypeService := servicetype.New(logger, mockServiceTypeRepo, mockRoleRepo)
+	mockRoleRepo.On("GetEmployeeRoles", ctx, 1).Return([]string{models.RoleSystemAdmin}, nil)
+	mockServiceTypeRepo.On("DeleteServiceType", ctx, 1).Return(outerror.ErrServiceTypeInUse)
+
+	// Act
+	err := serviceTypeService.DeleteServiceType(ctx, 1, 1)
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrServiceTypeInUse)
+}
+
+// TestDeleteServiceType_Success проверяет, что системный
+// администратор может удалить неиспользуемый тип услуг.
+func TestDeleteServiceType_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockServiceTypeRepo := new(mocks.MockServiceTypeManager)
+	mockRoleRepo := new(mocks.MockRoleRepo)
+	logger := slogdiscard.NewDiscardLogger()
+	serviceTypeService := servicetype.New(logger, mockServiceTypeRepo, mockRoleRepo)
+	mockRoleRepo.On("GetEmployeeRoles", ctx, 1).Return([]string{models.RoleSystemAdmin}, nil)
+	mockServiceTypeRepo.On("DeleteServiceType", ctx, 4).Return(nil)
+
+	// Act
+	err := serviceTypeService.DeleteServiceType(ctx, 1, 4)
+
+	// Assert
+	require.NoError(t, err)
+	mockServiceTypeRepo.AssertExpectations(t)
+}
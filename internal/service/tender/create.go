@@ -11,13 +11,52 @@ import (
 )
 
 // CreateTender создает тендер с данными, переданными в tender.
+// Тип услуг должен быть в справочнике, у тендера он сохраняется
+// в написании из справочника.
 func (tenderSrv *TenderService) CreateTender(ctx context.Context, tender models.Tender) (models.Tender, error) {
 	const operationPlace = "internal.service.tender.create.CreateTender"
 	logger := tenderSrv.logger.With("op", operationPlace)
 
+	tender, err := tenderSrv.checkNewTender(ctx, tender)
+	if err != nil {
+		return models.Tender{}, err
+	}
+
+	createdTender, err := tenderSrv.tenderRepo.CreateTender(ctx, tender)
+	if err != nil {
+		logger.Error("cannot create tender", slog.String("err", err.Error()))
+		return models.Tender{}, fmt.Errorf("cannot create tender: %w", err)
+	}
+	logger.Info("success create tender")
+	return createdTender, nil
+}
+
+// checkNewTender проверяет, что тендер tender можно создать: статус CREATED,
+// дедлайн после публикации, тип услуг из справочника, сотрудник и
+// организация существуют и сотрудник ответственный за организацию.
+// Возвращает тендер с типом услуг в написании из справочника.
+func (tenderSrv *TenderService) checkNewTender(ctx context.Context, tender models.Tender) (models.Tender, error) {
+	const operationPlace = "internal.service.tender.create.checkNewTender"
+	logger := tenderSrv.logger.With("op", operationPlace)
+
 	if !tender.IsNewTenderHasStatusCreated() {
 		return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrNewTenderCannotCreatedWithStatusNotCreated)
 	}
+	if !tender.IsDeadlineAfterPublishAt() {
+		logger.Warn("tender deadline before publish_at")
+		return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTenderDeadlineBeforePublishAt)
+	}
+
+	serviceType, err := tenderSrv.serviceType(ctx, tender.ServiceType)
+	if err != nil {
+		if errors.Is(err, outerror.ErrUnknownServiceType) {
+			logger.Warn("unknown service type", slog.String("service type", tender.ServiceType))
+			return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrUnknownServiceType)
+		}
+		logger.Error("cannot check service type", slog.String("err", err.Error()))
+		return models.Tender{}, err
+	}
+	tender.ServiceType = serviceType
 
 	empl, err := tenderSrv.employeeRepo.GetEmployeeByUsername(ctx, tender.CreatorUsername)
 	if err != nil {
@@ -56,11 +95,5 @@ func (tenderSrv *TenderService) CreateTender(ctx context.Context, tender models.
 	}
 	logger.Info("success check employee responsible")
 
-	createdTender, err := tenderSrv.tenderRepo.CreateTender(ctx, tender)
-	if err != nil {
-		logger.Error("cannot create tender", slog.String("err", err.Error()))
-		return models.Tender{}, fmt.Errorf("cannot create tender: %w", err)
-	}
-	logger.Info("success create tender")
-	return createdTender, nil
+	return tender, nil
 }
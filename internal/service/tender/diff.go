@@ -0,0 +1,53 @@
+package tender
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/domain/tenderpolicy"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+// DiffTenderVersions возвращает поля, которые изменились между версиями
+// fromVersion и toVersion тендера. Права те же, что и у GetTenderVersions.
+func (tenderSrv *TenderService) DiffTenderVersions(ctx context.Context, tenderId int, fromVersion int, toVersion int, username string) (models.TenderDiff, error) {
+	const operationPlace = "internal.service.tender.diff.DiffTenderVersions"
+	logger := tenderSrv.logger.With("op", operationPlace)
+
+	err := tenderSrv.authorizeTender(ctx, tenderId, username, tenderpolicy.ActionRead)
+	if err != nil {
+		logger.Warn("cannot diff tender versions", slog.Int("tender id", tenderId), slog.String("err", err.Error()))
+		return models.TenderDiff{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	from, err := tenderSrv.tenderRepo.GetTenderVersion(ctx, tenderId, fromVersion)
+	if err != nil {
+		if errors.Is(err, outerror.ErrTenderVersionNotFound) {
+			logger.Warn(fmt.Sprintf("tender version=\"%d\" not found", fromVersion))
+			return models.TenderDiff{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTenderVersionNotFound)
+		}
+		logger.Error("cannot get tender version", slog.Int("version", fromVersion), slog.String("err", err.Error()))
+		return models.TenderDiff{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	to, err := tenderSrv.tenderRepo.GetTenderVersion(ctx, tenderId, toVersion)
+	if err != nil {
+		if errors.Is(err, outerror.ErrTenderVersionNotFound) {
+			logger.Warn(fmt.Sprintf("tender version=\"%d\" not found", toVersion))
+			return models.TenderDiff{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTenderVersionNotFound)
+		}
+		logger.Error("cannot get tender version", slog.Int("version", toVersion), slog.String("err", err.Error()))
+		return models.TenderDiff{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	diff := models.TenderDiff{
+		TenderId:    tenderId,
+		FromVersion: fromVersion,
+		ToVersion:   toVersion,
+		Changes:     models.DiffTenders(from.Tender, to.Tender),
+	}
+	logger.Info("success diff tender versions", slog.Int("changes", len(diff.Changes)))
+	return diff, nil
+}
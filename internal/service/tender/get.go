@@ -10,36 +10,59 @@ import (
 	outerror "github.com/sariya23/tender/internal/out_error"
 )
 
-// GetTenders возвращает список тендеров, который удовлетворяют переданному serviceType.
-func (tenderSrv *TenderService) GetTenders(ctx context.Context, serviceType string) ([]models.Tender, error) {
+// GetTenders возвращает страницу page списка тендеров, которые удовлетворяют фильтру filter.
+//
+// Без статусов в фильтре возвращаются только опубликованные тендеры.
+// Тендеры в других статусах может запросить только существующий
+// сотрудник filter.Viewer, и он увидит только свои тендеры. Если политика
+// доступа разрешает ему читать все тендеры (аудитор), то он увидит все.
+func (tenderSrv *TenderService) GetTenders(ctx context.Context, filter models.TenderFilter, page models.Page) (models.TenderPage, error) {
 	const operationPlace = "internal.service.tender.getall.GetTenders"
 	logger := tenderSrv.logger.With("op", operationPlace)
 
-	var err error
-	var tenders []models.Tender
-
-	if serviceType == "all" {
-		logger.Info("get all tenders")
-		tenders, err = tenderSrv.tenderRepo.GetAllTenders(ctx)
-	} else {
-		logger.Info("get tenders with service type", slog.String("service type", serviceType))
-		tenders, err = tenderSrv.tenderRepo.GetTendersByServiceType(ctx, serviceType)
+	for _, status := range filter.Statuses {
+		if !tenderSrv.statuses.IsKnown(status) {
+			logger.Warn("unknown tender status in filter", slog.String("status", status))
+			return models.TenderPage{Tenders: []models.Tender{}}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrUnknownTenderStatus)
+		}
+	}
+	if !filter.HasOnlyPublishedStatus() && filter.Viewer == "" {
+		logger.Warn("status filter without username")
+		return models.TenderPage{Tenders: []models.Tender{}}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTenderStatusFilterRequiresUsername)
+	}
+	if filter.Viewer != "" {
+		_, err := tenderSrv.employeeRepo.GetEmployeeByUsername(ctx, filter.Viewer)
+		if err != nil {
+			if errors.Is(err, outerror.ErrEmployeeNotFound) {
+				logger.Warn("employee not found", slog.String("username", filter.Viewer))
+				return models.TenderPage{Tenders: []models.Tender{}}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeNotFound)
+			}
+			logger.Error("cannot get employee", slog.String("username", filter.Viewer), slog.String("err", err.Error()))
+			return models.TenderPage{Tenders: []models.Tender{}}, fmt.Errorf("cannot get employee: %w", err)
+		}
+		filter.ViewAll, err = tenderSrv.policy.CanReadAll(ctx, filter.Viewer)
+		if err != nil {
+			logger.Error("cannot check employee roles", slog.String("username", filter.Viewer), slog.String("err", err.Error()))
+			return models.TenderPage{Tenders: []models.Tender{}}, fmt.Errorf("cannot check employee roles: %w", err)
+		}
 	}
 
+	logger.Info("get tenders by filter", slog.Any("service types", filter.ServiceTypes), slog.Any("statuses", filter.Statuses))
+	tenders, err := tenderSrv.tenderRepo.GetTenders(ctx, filter, page)
 	if err != nil {
-		if errors.Is(err, outerror.ErrTendersWithThisServiceTypeNotFound) {
+		if errors.Is(err, outerror.ErrTendersNotFound) {
 			logger.Warn("no tenders found", slog.String("err", err.Error()))
-			return []models.Tender{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTendersWithThisServiceTypeNotFound)
+			return models.TenderPage{Tenders: []models.Tender{}}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTendersNotFound)
 		}
 		logger.Error("cannot get tenders", slog.String("err", err.Error()))
-		return []models.Tender{}, fmt.Errorf("cannot get tenders: %w", err)
+		return models.TenderPage{Tenders: []models.Tender{}}, fmt.Errorf("cannot get tenders: %w", err)
 	}
 	logger.Info("success get tenders")
 	return tenders, nil
 }
 
-// GetEmployeeTendersByUsername возвращает список тендоров, которые связаны с переданным юзером.
-func (s *TenderService) GetEmployeeTendersByUsername(ctx context.Context, username string) ([]models.Tender, error) {
+// GetEmployeeTendersByUsername возвращает страницу page списка тендоров, которые связаны с переданным юзером.
+func (s *TenderService) GetEmployeeTendersByUsername(ctx context.Context, username string, page models.Page) (models.TenderPage, error) {
 	const op = "internal.service.tender.getall.GetEmployeeTendersByUsername"
 	logger := s.logger.With("op", op)
 
@@ -47,21 +70,96 @@ func (s *TenderService) GetEmployeeTendersByUsername(ctx context.Context, userna
 	if err != nil {
 		if errors.Is(err, outerror.ErrEmployeeNotFound) {
 			logger.Warn("employee not found", slog.String("username", username))
-			return []models.Tender{}, fmt.Errorf("%s: %w", op, outerror.ErrEmployeeNotFound)
+			return models.TenderPage{Tenders: []models.Tender{}}, fmt.Errorf("%s: %w", op, outerror.ErrEmployeeNotFound)
 		}
 		logger.Error("cannot get employee", slog.String("username", username), slog.String("err", err.Error()))
-		return []models.Tender{}, fmt.Errorf("cannot get employee: %w", err)
+		return models.TenderPage{Tenders: []models.Tender{}}, fmt.Errorf("cannot get employee: %w", err)
 	}
 	logger.Info("success check employee by username")
-	tenders, err := s.tenderRepo.GetEmployeeTenders(ctx, empl)
+	tenders, err := s.tenderRepo.GetEmployeeTenders(ctx, empl, page)
 	if err != nil {
 		if errors.Is(err, outerror.ErrEmployeeTendersNotFound) {
 			logger.Warn("no tenders for employee", slog.String("username", username), slog.String("err", err.Error()))
-			return []models.Tender{}, fmt.Errorf("%s: %w", op, outerror.ErrEmployeeTendersNotFound)
+			return models.TenderPage{Tenders: []models.Tender{}}, fmt.Errorf("%s: %w", op, outerror.ErrEmployeeTendersNotFound)
 		}
 		logger.Error("cannot get tenders", slog.String("err", err.Error()))
-		return []models.Tender{}, fmt.Errorf("cannot get tenders: %w", err)
+		return models.TenderPage{Tenders: []models.Tender{}}, fmt.Errorf("cannot get tenders: %w", err)
 	}
 	logger.Info("success get employee tenders")
 	return tenders, nil
 }
+
+// GetOrganizationTenders возвращает страницу page списка активных тендеров
+// организации orgId в любом статусе, которые удовлетворяют фильтру filter.
+//
+// Список доступен сотрудникам, ответственным за организацию, и тем,
+// кому политика доступа разрешает читать все тендеры. Без статусов в
+// фильтре возвращаются тендеры во всех статусах.
+func (tenderSrv *TenderService) GetOrganizationTenders(ctx context.Context, orgId int, username string, filter models.TenderFilter, page models.Page) (models.TenderPage, error) {
+	const operationPlace = "internal.service.tender.getall.GetOrganizationTenders"
+	logger := tenderSrv.logger.With("op", operationPlace)
+
+	for _, status := range filter.Statuses {
+		if !tenderSrv.statuses.IsKnown(status) {
+			logger.Warn("unknown tender status in filter", slog.String("status", status))
+			return models.TenderPage{Tenders: []models.Tender{}}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrUnknownTenderStatus)
+		}
+	}
+
+	_, err := tenderSrv.orgRepo.GetOrganizationById(ctx, orgId)
+	if err != nil {
+		if errors.Is(err, outerror.ErrOrganizationNotFound) {
+			logger.Warn("organization not found", slog.Int("org id", orgId))
+			return models.TenderPage{Tenders: []models.Tender{}}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrOrganizationNotFound)
+		}
+		logger.Error("cannot get organization", slog.Int("org id", orgId), slog.String("err", err.Error()))
+		return models.TenderPage{Tenders: []models.Tender{}}, fmt.Errorf("cannot get organization: %w", err)
+	}
+
+	empl, err := tenderSrv.employeeRepo.GetEmployeeByUsername(ctx, username)
+	if err != nil {
+		if errors.Is(err, outerror.ErrEmployeeNotFound) {
+			logger.Warn("employee not found", slog.String("username", username))
+			return models.TenderPage{Tenders: []models.Tender{}}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeNotFound)
+		}
+		logger.Error("cannot get employee", slog.String("username", username), slog.String("err", err.Error()))
+		return models.TenderPage{Tenders: []models.Tender{}}, fmt.Errorf("cannot get employee: %w", err)
+	}
+
+	canReadAll, err := tenderSrv.policy.CanReadAll(ctx, username)
+	if err != nil {
+		logger.Error("cannot check employee roles", slog.String("username", username), slog.String("err", err.Error()))
+		return models.TenderPage{Tenders: []models.Tender{}}, fmt.Errorf("cannot check employee roles: %w", err)
+	}
+	if !canReadAll {
+		err = tenderSrv.employeeResponsibler.CheckResponsibility(ctx, empl.ID, orgId)
+		if err != nil {
+			if errors.Is(err, outerror.ErrEmployeeNotResponsibleForOrganization) {
+				logger.Warn("employee not responsible for organization", slog.Int("empl id", empl.ID), slog.Int("org id", orgId))
+				return models.TenderPage{Tenders: []models.Tender{}}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeNotResponsibleForOrganization)
+			}
+			logger.Error("cannot check employee responsibility", slog.String("err", err.Error()))
+			return models.TenderPage{Tenders: []models.Tender{}}, fmt.Errorf("cannot check employee responsibility: %w", err)
+		}
+	}
+
+	filter.OrganizationId = orgId
+	filter.Viewer = username
+	filter.ViewAll = true
+	if len(filter.Statuses) == 0 {
+		filter.Statuses = tenderSrv.statuses.Statuses()
+	}
+
+	logger.Info("get organization tenders by filter", slog.Int("org id", orgId), slog.Any("statuses", filter.Statuses))
+	tenders, err := tenderSrv.tenderRepo.GetTenders(ctx, filter, page)
+	if err != nil {
+		if errors.Is(err, outerror.ErrTendersNotFound) {
+			logger.Warn("no tenders found", slog.String("err", err.Error()))
+			return models.TenderPage{Tenders: []models.Tender{}}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTendersNotFound)
+		}
+		logger.Error("cannot get tenders", slog.String("err", err.Error()))
+		return models.TenderPage{Tenders: []models.Tender{}}, fmt.Errorf("cannot get tenders: %w", err)
+	}
+	logger.Info("success get organization tenders")
+	return tenders, nil
+}
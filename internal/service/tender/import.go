@@ -0,0 +1,115 @@
+package tender
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/domain/tenderpolicy"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+// ImportTenders создает тендеры из строк файла импорта от имени сотрудника
+// с username и возвращает строки с результатом.
+//
+// Каждая строка проходит те же проверки, что и в CreateTender. Если создатель
+// тендера не username, то сотрудник должен иметь право передавать тендеры
+// организации. Строки, в которых уже есть ошибка, пропускаются.
+//
+// Проверенные тендеры создаются транзакциями по importBatchSize штук. Если
+// транзакция не удалась, то ошибка записывается во все ее строки, остальные
+// транзакции выполняются. У созданных строк Tender - созданный тендер.
+// При dryRun тендеры только проверяются.
+//
+// Ошибка возвращается, только если контекст отменен до конца импорта.
+// Тогда вместе с ней возвращается отчет: уже созданные тендеры остаются
+// в нем, а в строки, до которых импорт не дошел, записывается
+// ErrImportRowNotAttempted.
+func (tenderSrv *TenderService) ImportTenders(ctx context.Context, rows []models.TenderImportRow, username string, dryRun bool) ([]models.TenderImportRow, error) {
+	const operationPlace = "internal.service.tender.import.ImportTenders"
+	logger := tenderSrv.logger.With("op", operationPlace)
+
+	rows = append([]models.TenderImportRow(nil), rows...)
+	valid := make([]int, 0, len(rows))
+	for i := range rows {
+		if rows[i].Err != nil {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			logger.Warn("import canceled while checking rows", slog.Int("line", rows[i].Line))
+			markNotAttempted(rows, err)
+			return rows, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		rows[i].Tender, rows[i].Err = tenderSrv.checkImportTender(ctx, rows[i].Tender, username)
+		if rows[i].Err != nil {
+			logger.Warn("import row check failed", slog.Int("line", rows[i].Line), slog.String("err", rows[i].Err.Error()))
+			continue
+		}
+		valid = append(valid, i)
+	}
+	logger.Info("import rows checked", slog.Int("rows", len(rows)), slog.Int("valid", len(valid)))
+	if dryRun {
+		return rows, nil
+	}
+
+	for start := 0; start < len(valid); start += tenderSrv.importBatchSize {
+		if err := ctx.Err(); err != nil {
+			logger.Warn("import canceled", slog.Int("processed", start), slog.Int("valid", len(valid)))
+			for _, i := range valid[start:] {
+				rows[i].Err = fmt.Errorf("%w: %w", outerror.ErrImportRowNotAttempted, err)
+			}
+			return rows, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		batch := valid[start:min(start+tenderSrv.importBatchSize, len(valid))]
+		tenders := make([]models.Tender, 0, len(batch))
+		for _, i := range batch {
+			tenders = append(tenders, rows[i].Tender)
+		}
+		createdTenders, err := tenderSrv.tenderRepo.CreateTenders(ctx, tenders)
+		if err != nil {
+			logger.Error("cannot create tenders", slog.Int("from line", rows[batch[0]].Line), slog.String("err", err.Error()))
+			for _, i := range batch {
+				rows[i].Err = fmt.Errorf("cannot create tenders: %w", err)
+			}
+			continue
+		}
+		for j, i := range batch {
+			rows[i].Tender = createdTenders[j]
+		}
+	}
+	logger.Info("success import tenders")
+	return rows, nil
+}
+
+// markNotAttempted записывает ErrImportRowNotAttempted во все строки
+// без ошибки. Вызывается, пока ни один тендер импорта не создан.
+func markNotAttempted(rows []models.TenderImportRow, err error) {
+	for i := range rows {
+		if rows[i].Err == nil {
+			rows[i].Err = fmt.Errorf("%w: %w", outerror.ErrImportRowNotAttempted, err)
+		}
+	}
+}
+
+// checkImportTender проверяет тендер из файла импорта. Передавать тендер
+// другому создателю можно только с правом tenderpolicy.ActionReassign.
+func (tenderSrv *TenderService) checkImportTender(ctx context.Context, tender models.Tender, username string) (models.Tender, error) {
+	const operationPlace = "internal.service.tender.import.checkImportTender"
+
+	tender, err := tenderSrv.checkNewTender(ctx, tender)
+	if err != nil {
+		return models.Tender{}, err
+	}
+	if tender.CreatorUsername != username {
+		err = tenderSrv.authorize(ctx, username, tenderpolicy.ActionReassign, tender)
+		if err != nil {
+			if errors.Is(err, outerror.ErrEmployeeNotResponsibleForTender) {
+				return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, err)
+			}
+			return models.Tender{}, err
+		}
+	}
+	return tender, nil
+}
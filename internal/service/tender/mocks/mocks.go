@@ -2,6 +2,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/sariya23/tender/internal/domain/models"
 	"github.com/stretchr/testify/mock"
@@ -13,9 +14,9 @@ import (
 //
 // - CreateTender
 //
-// - GetAllTenders
+// - CreateTenders
 //
-// - GetTendersByServiceType
+// - GetTenders
 //
 // - GetEmployeeTendersByUsername
 //
@@ -23,14 +24,22 @@ import (
 //
 // - RollbackTender
 //
+// - RollbackTenderAsNewVersion
+//
+// - GetTenderVersions
+//
+// - GetTenderVersion
+//
 // - GetTenderById
+//
+// - GetDueTenders
 type MockTenderRepo struct {
 	mock.Mock
 }
 
-func (m *MockTenderRepo) GetLastInsertedTenderId(ctx context.Context) (int, error) {
-	args := m.Called(ctx)
-	return args.Get(0).(int), args.Error(1)
+func (m *MockTenderRepo) GetDueTenders(ctx context.Context, now time.Time) ([]models.Tender, error) {
+	args := m.Called(ctx, now)
+	return args.Get(0).([]models.Tender), args.Error(1)
 }
 
 func (m *MockTenderRepo) CreateTender(ctx context.Context, tender models.Tender) (models.Tender, error) {
@@ -38,28 +47,33 @@ func (m *MockTenderRepo) CreateTender(ctx context.Context, tender models.Tender)
 	return args.Get(0).(models.Tender), args.Error(1)
 }
 
-func (m *MockTenderRepo) GetAllTenders(ctx context.Context) ([]models.Tender, error) {
-	args := m.Called(ctx)
+func (m *MockTenderRepo) CreateTenders(ctx context.Context, tenders []models.Tender) ([]models.Tender, error) {
+	args := m.Called(ctx, tenders)
 	return args.Get(0).([]models.Tender), args.Error(1)
 }
 
-func (m *MockTenderRepo) GetTendersByServiceType(ctx context.Context, serviceType string) ([]models.Tender, error) {
-	args := m.Called(ctx, serviceType)
-	return args.Get(0).([]models.Tender), args.Error(1)
+func (m *MockTenderRepo) GetTenders(ctx context.Context, filter models.TenderFilter, page models.Page) (models.TenderPage, error) {
+	args := m.Called(ctx, filter, page)
+	return args.Get(0).(models.TenderPage), args.Error(1)
 }
 
-func (m *MockTenderRepo) GetEmployeeTenders(ctx context.Context, empl models.Employee) ([]models.Tender, error) {
-	args := m.Called(ctx, empl)
-	return args.Get(0).([]models.Tender), args.Error(1)
+func (m *MockTenderRepo) GetEmployeeTenders(ctx context.Context, empl models.Employee, page models.Page) (models.TenderPage, error) {
+	args := m.Called(ctx, empl, page)
+	return args.Get(0).(models.TenderPage), args.Error(1)
 }
 
-func (m *MockTenderRepo) EditTender(ctx context.Context, oldTender models.Tender, tenderId int, updateTender models.TenderToUpdate) (models.Tender, error) {
-	args := m.Called(ctx, oldTender, tenderId, updateTender)
+func (m *MockTenderRepo) EditTender(ctx context.Context, oldTender models.Tender, tenderId int, updateTender models.TenderToUpdate, modifiedBy string) (models.Tender, error) {
+	args := m.Called(ctx, oldTender, tenderId, updateTender, modifiedBy)
 	return args.Get(0).(models.Tender), args.Error(1)
 }
 
-func (m *MockTenderRepo) RollbackTender(ctx context.Context, tenderId int, toVersionRollback int) error {
-	args := m.Called(ctx, tenderId, toVersionRollback)
+func (m *MockTenderRepo) RollbackTender(ctx context.Context, tenderId int, toVersionRollback int, activeVersion int) error {
+	args := m.Called(ctx, tenderId, toVersionRollback, activeVersion)
+	return args.Error(0)
+}
+
+func (m *MockTenderRepo) RollbackTenderAsNewVersion(ctx context.Context, tenderId int, toVersionRollback int, activeVersion int, modifiedBy string) error {
+	args := m.Called(ctx, tenderId, toVersionRollback, activeVersion, modifiedBy)
 	return args.Error(0)
 }
 
@@ -73,11 +87,26 @@ func (m *MockTenderRepo) FindTenderVersion(ctx context.Context, tenderId int, ve
 	return args.Error(0)
 }
 
+func (m *MockTenderRepo) GetTenderVersions(ctx context.Context, tenderId int) ([]models.TenderVersion, error) {
+	args := m.Called(ctx, tenderId)
+	return args.Get(0).([]models.TenderVersion), args.Error(1)
+}
+
+func (m *MockTenderRepo) GetTenderVersion(ctx context.Context, tenderId int, version int) (models.TenderVersion, error) {
+	args := m.Called(ctx, tenderId, version)
+	return args.Get(0).(models.TenderVersion), args.Error(1)
+}
+
 func (m *MockTenderRepo) GetTenderStatus(ctx context.Context, tenderStatus string) (string, error) {
 	args := m.Called(ctx, tenderStatus)
 	return args.Get(0).(string), args.Error(1)
 }
 
+func (m *MockTenderRepo) VoteTenderClose(ctx context.Context, tenderId int, vote models.TenderCloseVote, quorum int) (models.TenderCloseVoting, error) {
+	args := m.Called(ctx, tenderId, vote, quorum)
+	return args.Get(0).(models.TenderCloseVoting), args.Error(1)
+}
+
 // MockTenderRepo реализует интерфейс MockEmployeeRepo
 // для целей тестирования. Он позволяет задавать ожидаемые результаты
 // методов:
@@ -118,6 +147,8 @@ func (m *MockOrgRepo) GetOrganizationById(ctx context.Context, orgId int) (model
 // методов:
 //
 // - CheckResponsibility
+//
+// - GetOrganizationResponsibles
 type MockEmployeeResponsibler struct {
 	mock.Mock
 }
@@ -126,3 +157,57 @@ func (m *MockEmployeeResponsibler) CheckResponsibility(ctx context.Context, empl
 	args := m.Called(ctx, emplId, orgId)
 	return args.Error(0)
 }
+
+func (m *MockEmployeeResponsibler) GetOrganizationResponsibles(ctx context.Context, orgId int) ([]models.Employee, error) {
+	args := m.Called(ctx, orgId)
+	return args.Get(0).([]models.Employee), args.Error(1)
+}
+
+// MockTenderSearcher реализует интерфейс TenderSearcher
+// для целей тестирования. Он позволяет задавать ожидаемые результаты
+// методов:
+//
+// - SearchTenders
+type MockTenderSearcher struct {
+	mock.Mock
+}
+
+func (m *MockTenderSearcher) SearchTenders(ctx context.Context, query string, page models.Page) (models.TenderSearchPage, error) {
+	args := m.Called(ctx, query, page)
+	return args.Get(0).(models.TenderSearchPage), args.Error(1)
+}
+
+// MockRoleRepo реализует интерфейс RoleRepository
+// для целей тестирования. Он позволяет задавать ожидаемые результаты
+// методов:
+//
+// - GetEmployeeRoles
+//
+// - GetOrganizationRole
+type MockRoleRepo struct {
+	mock.Mock
+}
+
+func (m *MockRoleRepo) GetEmployeeRoles(ctx context.Context, emplId int) ([]string, error) {
+	args := m.Called(ctx, emplId)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockRoleRepo) GetOrganizationRole(ctx context.Context, emplId int, orgId int) (string, error) {
+	args := m.Called(ctx, emplId, orgId)
+	return args.String(0), args.Error(1)
+}
+
+// MockServiceTypeRepo реализует интерфейс ServiceTypeRepository
+// для целей тестирования. Он позволяет задавать ожидаемые результаты
+// методов:
+//
+// - GetServiceTypeByName
+type MockServiceTypeRepo struct {
+	mock.Mock
+}
+
+func (m *MockServiceTypeRepo) GetServiceTypeByName(ctx context.Context, name string) (models.ServiceType, error) {
+	args := m.Called(ctx, name)
+	return args.Get(0).(models.ServiceType), args.Error(1)
+}
@@ -0,0 +1,37 @@
+package tender
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/domain/tenderpolicy"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+// authorize проверяет политикой доступа, что сотрудник с username может
+// выполнить action с тендером. Если нельзя, то возвращается ErrEmployeeNotResponsibleForTender.
+func (tenderSrv *TenderService) authorize(ctx context.Context, username string, action tenderpolicy.Action, tender models.Tender) error {
+	err := tenderSrv.policy.Authorize(ctx, username, action, tender)
+	if err != nil {
+		if errors.Is(err, outerror.ErrEmployeeNotResponsibleForTender) {
+			return outerror.ErrEmployeeNotResponsibleForTender
+		}
+		return fmt.Errorf("cannot authorize employee: %w", err)
+	}
+	return nil
+}
+
+// authorizeTender проверяет, что тендер существует и сотрудник
+// с username может выполнить с ним action.
+func (tenderSrv *TenderService) authorizeTender(ctx context.Context, tenderId int, username string, action tenderpolicy.Action) error {
+	tender, err := tenderSrv.tenderRepo.GetTenderById(ctx, tenderId)
+	if err != nil {
+		if errors.Is(err, outerror.ErrTenderNotFound) {
+			return outerror.ErrTenderNotFound
+		}
+		return fmt.Errorf("cannot get tender by id: %w", err)
+	}
+	return tenderSrv.authorize(ctx, username, action, tender)
+}
@@ -7,10 +7,26 @@ import (
 	"log/slog"
 
 	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/domain/tenderpolicy"
+	"github.com/sariya23/tender/internal/domain/tenderstatus"
 	outerror "github.com/sariya23/tender/internal/out_error"
 )
 
-func (tenderSrv *TenderService) RollbackTender(ctx context.Context, tenderId int, version int, username string) (models.Tender, error) {
+// RollbackTender откатывает тендер на версию version. Как именно
+// происходит откат, зависит от режима, см. WithRollbackMode.
+//
+// Если у версии другой статус, то откат проверяется как смена статуса
+// в EditTender: переход должен быть в автомате статусов и не требовать
+// причины, а опубликованный тендер нельзя закрыть без голосования.
+// После смены статуса вызываются хуки переходов статуса.
+//
+// Если у версии другой создатель или организация, то откат проверяется как
+// передача тендера в EditTender: нужно право на передачу, а создатель версии
+// должен существовать и быть ответственным за организацию версии.
+//
+// Если expectedVersion не 0, то откат произойдет, только если текущая
+// версия тендера равна expectedVersion, иначе вернется ErrTenderVersionConflict.
+func (tenderSrv *TenderService) RollbackTender(ctx context.Context, tenderId int, version int, username string, expectedVersion int) (models.Tender, error) {
 	const operationPlace = "internal.service.tender.rollback.RollbackTender"
 	logger := tenderSrv.logger.With("op", operationPlace)
 
@@ -24,7 +40,7 @@ func (tenderSrv *TenderService) RollbackTender(ctx context.Context, tenderId int
 		return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, err)
 	}
 
-	err = tenderSrv.tenderRepo.FindTenderVersion(ctx, tenderId, version)
+	tenderVersion, err := tenderSrv.tenderRepo.GetTenderVersion(ctx, tenderId, version)
 	if err != nil {
 		if errors.Is(err, outerror.ErrTenderVersionNotFound) {
 			logger.Warn(fmt.Sprintf("tender version=\"%d\" not found", version))
@@ -33,20 +49,98 @@ func (tenderSrv *TenderService) RollbackTender(ctx context.Context, tenderId int
 		logger.Error("cannot get tender version")
 		return models.Tender{}, err
 	}
-	if tender.CreatorUsername != username {
-		logger.Warn(fmt.Sprintf("employee with username=<%s> not responsible for tender with id=<%d>", tender.CreatorUsername, tenderId))
-		return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeNotResponsibleForTender)
+	err = tenderSrv.authorize(ctx, username, tenderpolicy.ActionRollback, tender)
+	if err != nil {
+		if errors.Is(err, outerror.ErrEmployeeNotResponsibleForTender) {
+			logger.Warn(fmt.Sprintf("employee with username=<%s> cannot rollback tender with id=<%d>", username, tenderId))
+			return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeNotResponsibleForTender)
+		}
+		logger.Error("cannot authorize employee", slog.String("err", err.Error()))
+		return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	reassign := rollbackReassign(tender, tenderVersion.Tender)
+	if reassign.CreatorUsername != nil || reassign.OrganizationId != nil {
+		err = tenderSrv.authorize(ctx, username, tenderpolicy.ActionReassign, tender)
+		if err != nil {
+			if errors.Is(err, outerror.ErrEmployeeNotResponsibleForTender) {
+				logger.Warn(fmt.Sprintf("employee with username=<%s> cannot reassign tender with id=<%d>", username, tenderId))
+				return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeNotResponsibleForTender)
+			}
+			logger.Error("cannot authorize employee", slog.String("err", err.Error()))
+			return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+		err = tenderSrv.checkReassign(ctx, tender, reassign)
+		if err != nil {
+			logger.Warn("cannot rollback tender to version with other creator or organization", slog.String("err", err.Error()))
+			return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+	}
+	if expectedVersion != 0 && tender.Version != expectedVersion {
+		logger.Warn("tender version conflict", slog.Int("expected version", expectedVersion), slog.Int("current version", tender.Version))
+		return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTenderVersionConflict)
+	}
+	if tenderVersion.Status != tender.Status {
+		err = tenderSrv.checkRollbackStatus(tender.Status, tenderVersion.Status)
+		if err != nil {
+			logger.Warn(fmt.Sprintf("cannot rollback tender with status \"%s\" to version with status \"%s\"", tender.Status, tenderVersion.Status))
+			return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, err)
+		}
+	}
+	if tenderSrv.rollbackMode == RollbackModeReactivate {
+		err = tenderSrv.tenderRepo.RollbackTender(ctx, tenderId, version, tender.Version)
+	} else {
+		err = tenderSrv.tenderRepo.RollbackTenderAsNewVersion(ctx, tenderId, version, tender.Version, username)
 	}
-	err = tenderSrv.tenderRepo.RollbackTender(ctx, tenderId, version)
 	if err != nil {
+		if errors.Is(err, outerror.ErrTenderVersionConflict) {
+			logger.Warn("tender was changed concurrently", slog.Int("tender id", tenderId))
+			return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTenderVersionConflict)
+		}
 		logger.Error("cannot rollback tender", slog.String("err", err.Error()))
 		return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, err)
 	}
 
+	prevStatus := tender.Status
 	tender, err = tenderSrv.tenderRepo.GetTenderById(ctx, tenderId)
 	if err != nil {
 		logger.Error("unexpected error", slog.String("err", err.Error()))
 		return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, err)
 	}
+	tenderSrv.statuses.Fire(ctx, tenderstatus.Event{
+		From:     prevStatus,
+		To:       tender.Status,
+		Reason:   fmt.Sprintf("rollback to version %d", version),
+		Username: username,
+		Tender:   tender,
+	})
 	return tender, nil
 }
+
+// rollbackReassign возвращает создателя и организацию версии tenderVersion,
+// которые отличаются от текущих у tender.
+func rollbackReassign(tender models.Tender, tenderVersion models.Tender) models.TenderToUpdate {
+	var reassign models.TenderToUpdate
+	if tenderVersion.CreatorUsername != tender.CreatorUsername {
+		reassign.CreatorUsername = &tenderVersion.CreatorUsername
+	}
+	if tenderVersion.OrganizationId != tender.OrganizationId {
+		reassign.OrganizationId = &tenderVersion.OrganizationId
+	}
+	return reassign
+}
+
+// checkRollbackStatus проверяет, что откат может сменить статус тендера
+// с from на to так же, как EditTender.
+func (tenderSrv *TenderService) checkRollbackStatus(from string, to string) error {
+	transition, ok := tenderSrv.statuses.Transition(from, to)
+	if !ok {
+		return outerror.ErrCannotSetThisTenderStatus
+	}
+	if transition.RequiresReason {
+		return outerror.ErrTenderStatusReasonRequired
+	}
+	if requiresQuorum(from, to) {
+		return outerror.ErrTenderCloseRequiresQuorum
+	}
+	return nil
+}
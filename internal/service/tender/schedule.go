@@ -0,0 +1,91 @@
+package tender
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/domain/tenderstatus"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+// SchedulerUsername пишется в modified_by версий,
+// которые создал планировщик.
+const SchedulerUsername = "scheduler"
+
+// RunScheduledTransitions публикует тендеры, у которых наступил publish_at,
+// и закрывает опубликованные тендеры, у которых прошел deadline.
+//
+// Каждый переход создает новую версию тендера, как EditTender, и вызывает
+// хуки переходов статуса. Закрытие по дедлайну не требует кворума.
+// Если тендер изменили одновременно с планировщиком, то он будет
+// обработан при следующем запуске. Ошибка перевода одного тендера не
+// останавливает обработку остальных: ошибки собираются и возвращаются
+// вместе после обработки всех тендеров. Возвращает количество переведенных тендеров.
+func (tenderSrv *TenderService) RunScheduledTransitions(ctx context.Context, now time.Time) (int, error) {
+	const operationPlace = "internal.service.tender.schedule.RunScheduledTransitions"
+	logger := tenderSrv.logger.With("op", operationPlace)
+
+	dueTenders, err := tenderSrv.tenderRepo.GetDueTenders(ctx, now)
+	if err != nil {
+		logger.Error("cannot get due tenders", slog.String("err", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	transited := 0
+	var errs []error
+	for _, tender := range dueTenders {
+		var newStatus, reason string
+		switch tender.Status {
+		case models.TenderCreatedStatus:
+			newStatus, reason = models.TenderPublishedStatus, "publish_at reached"
+		case models.TenderPublishedStatus:
+			newStatus, reason = models.TenderClosedStatus, "deadline passed"
+		default:
+			continue
+		}
+		if _, ok := tenderSrv.statuses.Transition(tender.Status, newStatus); !ok {
+			logger.Warn(
+				"scheduled transition not allowed",
+				slog.Int("tender id", tender.ID),
+				slog.String("from", tender.Status),
+				slog.String("to", newStatus),
+			)
+			continue
+		}
+
+		updatedTender, err := tenderSrv.tenderRepo.EditTender(ctx, tender, tender.ID, models.TenderToUpdate{Status: &newStatus}, SchedulerUsername)
+		if err != nil {
+			if errors.Is(err, outerror.ErrTenderVersionConflict) || errors.Is(err, outerror.ErrTenderNotFound) {
+				logger.Warn("tender was changed concurrently, skip", slog.Int("tender id", tender.ID))
+				continue
+			}
+			logger.Error("cannot change tender status", slog.Int("tender id", tender.ID), slog.String("err", err.Error()))
+			errs = append(errs, fmt.Errorf("tender id=%d: %w", tender.ID, err))
+			continue
+		}
+		transited++
+		logger.Info(
+			"scheduled tender status change",
+			slog.Int("tender id", tender.ID),
+			slog.String("from", tender.Status),
+			slog.String("to", newStatus),
+		)
+
+		tenderSrv.statuses.Fire(ctx, tenderstatus.Event{
+			From:     tender.Status,
+			To:       updatedTender.Status,
+			Reason:   reason,
+			Username: SchedulerUsername,
+			Tender:   updatedTender,
+		})
+	}
+	if len(errs) > 0 {
+		logger.Error("some scheduled transitions failed", slog.Int("failed", len(errs)), slog.Int("transited", transited))
+		return transited, fmt.Errorf("%s: %w", operationPlace, errors.Join(errs...))
+	}
+	return transited, nil
+}
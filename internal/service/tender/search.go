@@ -0,0 +1,38 @@
+package tender
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+// SearchTenders ищет опубликованные тендеры по словам из названия и описания.
+// Возвращает страницу page результатов, отсортированных по релевантности.
+func (tenderSrv *TenderService) SearchTenders(ctx context.Context, query string, page models.Page) (models.TenderSearchPage, error) {
+	const operationPlace = "internal.service.tender.search.SearchTenders"
+	logger := tenderSrv.logger.With("op", operationPlace)
+
+	query = strings.TrimSpace(query)
+	if query == "" {
+		logger.Warn("empty search query")
+		return models.TenderSearchPage{Results: []models.TenderSearchResult{}}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmptySearchQuery)
+	}
+	if tenderSrv.searcher == nil {
+		logger.Error("tender searcher is not configured")
+		return models.TenderSearchPage{Results: []models.TenderSearchResult{}}, fmt.Errorf("%s: %w", operationPlace, errors.New("tender searcher is not configured"))
+	}
+
+	logger.Info("search tenders", slog.String("query", query))
+	results, err := tenderSrv.searcher.SearchTenders(ctx, query, page)
+	if err != nil {
+		logger.Error("cannot search tenders", slog.String("err", err.Error()))
+		return models.TenderSearchPage{Results: []models.TenderSearchResult{}}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	logger.Info("success search tenders", slog.Int("total", results.Total))
+	return results, nil
+}
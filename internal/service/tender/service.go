@@ -2,10 +2,32 @@ package tender
 
 import (
 	"log/slog"
+	"time"
 
+	"github.com/sariya23/tender/internal/domain/tenderpolicy"
+	"github.com/sariya23/tender/internal/domain/tenderstatus"
 	"github.com/sariya23/tender/internal/repository"
 )
 
+// DefaultCloseQuorum количество голосов ответственных сотрудников,
+// необходимое для закрытия опубликованного тендера, если не задано иное.
+const DefaultCloseQuorum = 3
+
+// DefaultImportBatchSize сколько тендеров импорта создается
+// в одной транзакции, если не задано иное.
+const DefaultImportBatchSize = 100
+
+// Режимы отката тендера.
+//
+// - RollbackModeAppend - версия, на которую откатывают, копируется в новую версию,
+// история версий остается линейной. Режим по умолчанию;
+//
+// - RollbackModeReactivate - активной снова становится старая версия.
+const (
+	RollbackModeAppend     = "append"
+	RollbackModeReactivate = "reactivate"
+)
+
 // TenderService позволяет взаимодействовать с тендерами.
 type TenderService struct {
 	logger               *slog.Logger
@@ -13,6 +35,85 @@ type TenderService struct {
 	employeeRepo         repository.EmployeeRepository
 	orgRepo              repository.OrganizationRepository
 	employeeResponsibler repository.EmployeeResponsibler
+	closeQuorum          int
+	rollbackMode         string
+	statuses             *tenderstatus.Machine
+	searcher             repository.TenderSearcher
+	policy               tenderpolicy.Policy
+	serviceTypes         repository.ServiceTypeRepository
+	importBatchSize      int
+	now                  func() time.Time
+}
+
+// Option позволяет настроить необязательные параметры TenderService.
+type Option func(*TenderService)
+
+// WithCloseQuorum задает максимальный кворум для закрытия опубликованного тендера.
+// Фактический кворум - min(quorum, количество ответственных за организацию).
+func WithCloseQuorum(quorum int) Option {
+	return func(s *TenderService) {
+		if quorum > 0 {
+			s.closeQuorum = quorum
+		}
+	}
+}
+
+// WithRollbackMode задает режим отката тендера. Неизвестный режим игнорируется.
+func WithRollbackMode(mode string) Option {
+	return func(s *TenderService) {
+		if mode == RollbackModeAppend || mode == RollbackModeReactivate {
+			s.rollbackMode = mode
+		}
+	}
+}
+
+// WithStatusHook регистрирует хук, который вызывается после
+// каждого перехода статуса тендера.
+func WithStatusHook(hook tenderstatus.Hook) Option {
+	return func(s *TenderService) {
+		s.statuses.OnTransition(hook)
+	}
+}
+
+// WithSearcher задает хранилище для полнотекстового поиска тендеров.
+func WithSearcher(searcher repository.TenderSearcher) Option {
+	return func(s *TenderService) {
+		s.searcher = searcher
+	}
+}
+
+// WithPolicy задает политику доступа к тендерам. По умолчанию
+// все действия с тендером доступны только его создателю.
+func WithPolicy(policy tenderpolicy.Policy) Option {
+	return func(s *TenderService) {
+		s.policy = policy
+	}
+}
+
+// WithServiceTypes задает справочник типов услуг. Тип услуг создаваемого
+// или редактируемого тендера должен быть в справочнике.
+func WithServiceTypes(serviceTypes repository.ServiceTypeRepository) Option {
+	return func(s *TenderService) {
+		s.serviceTypes = serviceTypes
+	}
+}
+
+// WithImportBatchSize задает, сколько тендеров импорта создается в одной транзакции.
+func WithImportBatchSize(size int) Option {
+	return func(s *TenderService) {
+		if size > 0 {
+			s.importBatchSize = size
+		}
+	}
+}
+
+// WithClock задает источник текущего времени. По умолчанию time.Now.
+func WithClock(now func() time.Time) Option {
+	return func(s *TenderService) {
+		if now != nil {
+			s.now = now
+		}
+	}
 }
 
 func New(
@@ -21,12 +122,23 @@ func New(
 	employeeRepo repository.EmployeeRepository,
 	orgRepo repository.OrganizationRepository,
 	employeeOrgResponsibler repository.EmployeeResponsibler,
+	opts ...Option,
 ) *TenderService {
-	return &TenderService{
+	tenderService := &TenderService{
 		logger:               logger,
 		tenderRepo:           tenderRepo,
 		employeeRepo:         employeeRepo,
 		orgRepo:              orgRepo,
 		employeeResponsibler: employeeOrgResponsibler,
+		closeQuorum:          DefaultCloseQuorum,
+		rollbackMode:         RollbackModeAppend,
+		statuses:             tenderstatus.NewDefault(),
+		policy:               tenderpolicy.CreatorPolicy{},
+		importBatchSize:      DefaultImportBatchSize,
+		now:                  time.Now,
+	}
+	for _, opt := range opts {
+		opt(tenderService)
 	}
+	return tenderService
 }
@@ -0,0 +1,27 @@
+package tender
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+// serviceType ищет тип услуг name в справочнике без учета регистра и
+// возвращает его название из справочника. Если справочник не задан
+// (см. WithServiceTypes), то name возвращается как есть. Если типа
+// нет в справочнике, то возвращается ErrUnknownServiceType.
+func (tenderSrv *TenderService) serviceType(ctx context.Context, name string) (string, error) {
+	if tenderSrv.serviceTypes == nil {
+		return name, nil
+	}
+	serviceType, err := tenderSrv.serviceTypes.GetServiceTypeByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, outerror.ErrUnknownServiceType) {
+			return "", outerror.ErrUnknownServiceType
+		}
+		return "", fmt.Errorf("cannot get service type: %w", err)
+	}
+	return serviceType.Name, nil
+}
@@ -0,0 +1,80 @@
+package tender
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/domain/tenderpolicy"
+	"github.com/sariya23/tender/internal/domain/tenderstatus"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+// GetTenderStatus возвращает текущий статус тендера и переходы,
+// которые из него доступны. Переходы, для которых нужно голосование
+// (см. VoteCloseTender), возвращаются отдельно от остальных.
+// Посмотреть статус может тот, кому политика доступа разрешает читать тендер.
+func (tenderSrv *TenderService) GetTenderStatus(ctx context.Context, tenderId int, username string) (tenderstatus.State, error) {
+	const operationPlace = "internal.service.tender.status.GetTenderStatus"
+	logger := tenderSrv.logger.With("op", operationPlace)
+
+	tender, err := tenderSrv.tenderRepo.GetTenderById(ctx, tenderId)
+	if err != nil {
+		if errors.Is(err, outerror.ErrTenderNotFound) {
+			logger.Warn("tender not found", slog.Int("tender id", tenderId))
+			return tenderstatus.State{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTenderNotFound)
+		}
+		logger.Error("cannot get tender by id", slog.Int("tender id", tenderId), slog.String("err", err.Error()))
+		return tenderstatus.State{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	err = tenderSrv.authorize(ctx, username, tenderpolicy.ActionRead, tender)
+	if err != nil {
+		if errors.Is(err, outerror.ErrEmployeeNotResponsibleForTender) {
+			logger.Warn(fmt.Sprintf("employee with username \"%s\" cannot read tender with id \"%d\"", username, tenderId))
+			return tenderstatus.State{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeNotResponsibleForTender)
+		}
+		logger.Error("cannot authorize employee", slog.String("err", err.Error()))
+		return tenderstatus.State{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	allowed, quorumOnly := tenderSrv.allowedTransitions(tender.Status)
+	logger.Info("success get tender status")
+	return tenderstatus.State{
+		TenderId:   tender.ID,
+		Version:    tender.Version,
+		Status:     tender.Status,
+		Allowed:    allowed,
+		QuorumOnly: quorumOnly,
+	}, nil
+}
+
+// allowedTransitions делит переходы из статуса from на те, что доступны
+// сменой статуса, и те, что доступны только голосованием.
+func (tenderSrv *TenderService) allowedTransitions(from string) ([]tenderstatus.Transition, []tenderstatus.Transition) {
+	allowed := []tenderstatus.Transition{}
+	var quorumOnly []tenderstatus.Transition
+	for _, transition := range tenderSrv.statuses.Allowed(from) {
+		if requiresQuorum(transition.From, transition.To) {
+			quorumOnly = append(quorumOnly, transition)
+			continue
+		}
+		allowed = append(allowed, transition)
+	}
+	return allowed, quorumOnly
+}
+
+// requiresQuorum сообщает, что переход из from в to делается только
+// голосованием ответственных сотрудников: опубликованный тендер
+// закрывается через VoteCloseTender.
+func requiresQuorum(from string, to string) bool {
+	return from == models.TenderPublishedStatus && to == models.TenderClosedStatus
+}
+
+// SetTenderStatus переводит тендер в статус status. Проверки те же,
+// что и в EditTender, но можно указать причину перехода reason,
+// которая нужна, например, для повторного открытия закрытого тендера.
+func (tenderSrv *TenderService) SetTenderStatus(ctx context.Context, tenderId int, status string, reason string, username string, expectedVersion int) (models.Tender, error) {
+	return tenderSrv.editTender(ctx, tenderId, models.TenderToUpdate{Status: &status}, username, expectedVersion, reason)
+}
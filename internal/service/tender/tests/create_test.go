@@ -3,6 +3,7 @@ package tests
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/sariya23/tender/internal/domain/models"
 	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
@@ -173,3 +174,103 @@ func TestCreateTenders_FailNewTenderWithStatusNotCreated(t *testing.T) {
 	require.ErrorIs(t, err, outerror.ErrNewTenderCannotCreatedWithStatusNotCreated)
 	require.Empty(t, tender)
 }
+
+// TestCreateTenders_FailDeadlineBeforePublishAt проверяет, что тендер
+// не создается, если дедлайн наступает раньше публикации.
+func TestCreateTenders_FailDeadlineBeforePublishAt(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	publishAt := time.Date(2024, 12, 20, 10, 0, 0, 0, time.UTC)
+	deadline := publishAt.Add(-time.Hour)
+	tenderToCreate := models.Tender{
+		TenderName:      "Tender 1",
+		Description:     "qwe",
+		ServiceType:     "op",
+		Status:          "CREATED",
+		OrganizationId:  1,
+		CreatorUsername: "qwe",
+		PublishAt:       &publishAt,
+		Deadline:        &deadline,
+	}
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+
+	// Act
+	tender, err := tenderService.CreateTender(ctx, tenderToCreate)
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrTenderDeadlineBeforePublishAt)
+	require.Equal(t, models.Tender{}, tender)
+	mockTenderRepo.AssertNotCalled(t, "CreateTender")
+}
+
+// TestCreateTenders_SuccessServiceTypeFromDictionary проверяет, что тип
+// услуг сохраняется в написании из справочника.
+func TestCreateTenders_SuccessServiceTypeFromDictionary(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	mockServiceTypeRepo := new(mocks.MockServiceTypeRepo)
+	logger := slogdiscard.NewDiscardLogger()
+	tenderToCreate := models.Tender{
+		TenderName:      "Tender 1",
+		Description:     "qwe",
+		ServiceType:     "construction",
+		Status:          "CREATED",
+		OrganizationId:  1,
+		CreatorUsername: "qwe",
+	}
+	savedTender := tenderToCreate
+	savedTender.ServiceType = "Construction"
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler, tender.WithServiceTypes(mockServiceTypeRepo))
+	mockServiceTypeRepo.On("GetServiceTypeByName", ctx, "construction").Return(models.ServiceType{ID: 1, Name: "Construction"}, nil)
+	mockTenderRepo.On("CreateTender", ctx, savedTender).Return(savedTender, nil)
+	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, "qwe").Return(models.Employee{}, nil)
+	mockOrgRepo.On("GetOrganizationById", ctx, 1).Return(models.Organization{}, nil)
+	mockResponsibler.On("CheckResponsibility", ctx, 0, 1).Return(nil)
+
+	// Act
+	tender, err := tenderService.CreateTender(ctx, tenderToCreate)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, savedTender, tender)
+}
+
+// TestCreateTenders_FailUnknownServiceType проверяет, что тендер
+// с типом услуг не из справочника не создается.
+func TestCreateTenders_FailUnknownServiceType(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	mockServiceTypeRepo := new(mocks.MockServiceTypeRepo)
+	logger := slogdiscard.NewDiscardLogger()
+	tenderToCreate := models.Tender{
+		TenderName:      "Tender 1",
+		Description:     "qwe",
+		ServiceType:     "Painting",
+		Status:          "CREATED",
+		OrganizationId:  1,
+		CreatorUsername: "qwe",
+	}
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler, tender.WithServiceTypes(mockServiceTypeRepo))
+	mockServiceTypeRepo.On("GetServiceTypeByName", ctx, "Painting").Return(models.ServiceType{}, outerror.ErrUnknownServiceType)
+
+	// Act
+	tender, err := tenderService.CreateTender(ctx, tenderToCreate)
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrUnknownServiceType)
+	require.Equal(t, models.Tender{}, tender)
+	mockTenderRepo.AssertNotCalled(t, "CreateTender")
+}
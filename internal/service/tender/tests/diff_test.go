@@ -0,0 +1,156 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/sariya23/tender/internal/service/tender"
+	"github.com/sariya23/tender/internal/service/tender/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDiffTenderVersions_Success проверяет, что
+// в дифф попадают только изменившиеся поля.
+func TestDiffTenderVersions_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	from := models.TenderVersion{Tender: models.Tender{
+		ID: 1, Version: 1, TenderName: "qwe", Description: "old", ServiceType: "op", Status: "CREATED", OrganizationId: 1, CreatorUsername: "qwe",
+	}}
+	to := models.TenderVersion{Tender: models.Tender{
+		ID: 1, Version: 3, TenderName: "qwe", Description: "new", ServiceType: "op", Status: "PUBLISHED", OrganizationId: 2, CreatorUsername: "qwe",
+	}}
+	expectedDiff := models.TenderDiff{
+		TenderId:    1,
+		FromVersion: 1,
+		ToVersion:   3,
+		Changes: []models.TenderFieldDiff{
+			{Field: "description", From: "old", To: "new"},
+			{Field: "status", From: "CREATED", To: "PUBLISHED"},
+			{Field: "organization_id", From: 1, To: 2},
+		},
+	}
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{CreatorUsername: "qwe"}, nil)
+	mockTenderRepo.On("GetTenderVersion", ctx, 1, 1).Return(from, nil)
+	mockTenderRepo.On("GetTenderVersion", ctx, 1, 3).Return(to, nil)
+
+	// Act
+	diff, err := tenderService.DiffTenderVersions(ctx, 1, 1, 3, "qwe")
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, expectedDiff, diff)
+}
+
+// TestDiffTenderVersions_SuccessNoChanges проверяет, что
+// при сравнении одинаковых версий список изменений пустой.
+func TestDiffTenderVersions_SuccessNoChanges(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	version := models.TenderVersion{Tender: models.Tender{ID: 1, Version: 2, TenderName: "qwe", CreatorUsername: "qwe"}}
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{CreatorUsername: "qwe"}, nil)
+	mockTenderRepo.On("GetTenderVersion", ctx, 1, 2).Return(version, nil)
+
+	// Act
+	diff, err := tenderService.DiffTenderVersions(ctx, 1, 2, 2, "qwe")
+
+	// Assert
+	require.NoError(t, err)
+	require.Empty(t, diff.Changes)
+}
+
+// TestDiffTenderVersions_FailVersionNotFound проверяет, что
+// если одной из версий нет, то возвращается ошибка.
+func TestDiffTenderVersions_FailVersionNotFound(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{CreatorUsername: "qwe"}, nil)
+	mockTenderRepo.On("GetTenderVersion", ctx, 1, 1).Return(models.TenderVersion{}, nil)
+	mockTenderRepo.On("GetTenderVersion", ctx, 1, 9).Return(models.TenderVersion{}, outerror.ErrTenderVersionNotFound)
+
+	// Act
+	diff, err := tenderService.DiffTenderVersions(ctx, 1, 1, 9, "qwe")
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrTenderVersionNotFound)
+	require.Equal(t, models.TenderDiff{}, diff)
+}
+
+// TestDiffTenderVersions_FailNotCreator проверяет, что
+// дифф не может смотреть не создатель тендера.
+func TestDiffTenderVersions_FailNotCreator(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{CreatorUsername: "qwe"}, nil)
+
+	// Act
+	diff, err := tenderService.DiffTenderVersions(ctx, 1, 1, 2, "zxc")
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrEmployeeNotResponsibleForTender)
+	require.Equal(t, models.TenderDiff{}, diff)
+}
+
+// TestDiffTenderVersions_SuccessScheduleFields проверяет, что publish_at и
+// deadline сравниваются по значению, а не по указателю.
+func TestDiffTenderVersions_SuccessScheduleFields(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	publishAt := time.Date(2024, 12, 20, 10, 0, 0, 0, time.UTC)
+	samePublishAt := publishAt.In(time.FixedZone("MSK", 3*60*60))
+	deadline := time.Date(2024, 12, 25, 10, 0, 0, 0, time.UTC)
+	from := models.TenderVersion{Tender: models.Tender{ID: 1, Version: 1, PublishAt: &publishAt}}
+	to := models.TenderVersion{Tender: models.Tender{ID: 1, Version: 2, PublishAt: &samePublishAt, Deadline: &deadline}}
+	expectedDiff := models.TenderDiff{
+		TenderId:    1,
+		FromVersion: 1,
+		ToVersion:   2,
+		Changes: []models.TenderFieldDiff{
+			{Field: "deadline", From: nil, To: deadline},
+		},
+	}
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{CreatorUsername: "qwe"}, nil)
+	mockTenderRepo.On("GetTenderVersion", ctx, 1, 1).Return(from, nil)
+	mockTenderRepo.On("GetTenderVersion", ctx, 1, 2).Return(to, nil)
+
+	// Act
+	diff, err := tenderService.DiffTenderVersions(ctx, 1, 1, 2, "qwe")
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, expectedDiff, diff)
+}
@@ -13,8 +13,8 @@ import (
 )
 
 // TestGetAllTenders_Success проверяет, что
-// если передать serviceType = all, то вернутся
-// все тендеры, которые есть.
+// фильтр и страница передаются в репозиторий
+// и возвращается найденная страница тендеров.
 func TestGetAllTenders_Success(t *testing.T) {
 	// Arrange
 	ctx := context.Background()
@@ -27,15 +27,18 @@ func TestGetAllTenders_Success(t *testing.T) {
 		{TenderName: "Tender 1", Description: "qwe", ServiceType: "op", Status: "open", OrganizationId: 1, CreatorUsername: "qwe"},
 		{TenderName: "Tender 2", Description: "qwe", ServiceType: "op", Status: "open", OrganizationId: 2, CreatorUsername: "zxc"},
 	}
+	page := models.Page{Limit: 2}
+	nextCursor := 2
+	expectedPage := models.TenderPage{Tenders: expectedTenders, Total: 5, NextCursor: &nextCursor}
 	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
-	mockTenderRepo.On("GetAllTenders", ctx).Return(expectedTenders, nil)
+	mockTenderRepo.On("GetTenders", ctx, models.TenderFilter{}, page).Return(expectedPage, nil)
 
 	// Act
-	tenders, err := tenderService.GetTenders(ctx, "all")
+	tenders, err := tenderService.GetTenders(ctx, models.TenderFilter{}, page)
 
 	// Assert
 	require.NoError(t, err)
-	require.Equal(t, expectedTenders, tenders)
+	require.Equal(t, expectedPage, tenders)
 }
 
 // TestGetAllTenders_FailGetAllTenders проверяет, что в случае
@@ -49,19 +52,18 @@ func TestGetAllTenders_FailGetAllTenders(t *testing.T) {
 	mockResponsibler := new(mocks.MockEmployeeResponsibler)
 	logger := slogdiscard.NewDiscardLogger()
 	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
-	mockTenderRepo.On("GetAllTenders", ctx).Return([]models.Tender{}, outerror.ErrTendersWithThisServiceTypeNotFound)
+	mockTenderRepo.On("GetTenders", ctx, models.TenderFilter{}, models.Page{Limit: 20}).Return(models.TenderPage{Tenders: []models.Tender{}}, outerror.ErrTendersNotFound)
 
 	// Act
-	tenders, err := tenderService.GetTenders(ctx, "all")
+	tenders, err := tenderService.GetTenders(ctx, models.TenderFilter{}, models.Page{Limit: 20})
 
 	// Assert
-	require.ErrorIs(t, err, outerror.ErrTendersWithThisServiceTypeNotFound)
-	require.Empty(t, tenders)
+	require.ErrorIs(t, err, outerror.ErrTendersNotFound)
+	require.Empty(t, tenders.Tenders)
 }
 
-// TestGetAllTenders_Success проверяет, что
-// если передать serviceType = all, то вернутся
-// все тендеры, которые есть.
+// TestGetTendersByServiceType_Success проверяет, что
+// фильтр по нескольким типам услуг передается в репозиторий.
 func TestGetTendersByServiceType_Success(t *testing.T) {
 	// Arrange
 	ctx := context.Background()
@@ -74,15 +76,113 @@ func TestGetTendersByServiceType_Success(t *testing.T) {
 		{TenderName: "Tender 1", Description: "qwe", ServiceType: "op", Status: "open", OrganizationId: 1, CreatorUsername: "qwe"},
 		{TenderName: "Tender 2", Description: "qwe", ServiceType: "op", Status: "open", OrganizationId: 2, CreatorUsername: "zxc"},
 	}
+	page := models.Page{Limit: 20, AfterId: 10}
+	filter := models.TenderFilter{ServiceTypes: []string{"qwe", "op"}, OrganizationId: 1}
+	expectedPage := models.TenderPage{Tenders: expectedTenders, Total: 2}
 	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
-	mockTenderRepo.On("GetTendersByServiceType", ctx, "qwe").Return(expectedTenders, nil)
+	mockTenderRepo.On("GetTenders", ctx, filter, page).Return(expectedPage, nil)
 
 	// Act
-	tenders, err := tenderService.GetTenders(ctx, "qwe")
+	tenders, err := tenderService.GetTenders(ctx, filter, page)
 
 	// Assert
 	require.NoError(t, err)
-	require.Equal(t, expectedTenders, tenders)
+	require.Equal(t, expectedPage, tenders)
+}
+
+// TestGetTenders_SuccessOwnerStatusFilter проверяет, что
+// существующий сотрудник может запросить тендеры в любом статусе.
+func TestGetTenders_SuccessOwnerStatusFilter(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	filter := models.TenderFilter{Statuses: []string{models.TenderCreatedStatus, models.TenderClosedStatus}, Viewer: "qwe"}
+	page := models.Page{Limit: 20}
+	expectedPage := models.TenderPage{
+		Tenders: []models.Tender{{ID: 1, TenderName: "Tender 1", Status: models.TenderCreatedStatus, CreatorUsername: "qwe"}},
+		Total:   1,
+	}
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, "qwe").Return(models.Employee{Username: "qwe"}, nil)
+	mockTenderRepo.On("GetTenders", ctx, filter, page).Return(expectedPage, nil)
+
+	// Act
+	tenders, err := tenderService.GetTenders(ctx, filter, page)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, expectedPage, tenders)
+}
+
+// TestGetTenders_FailStatusFilterWithoutUsername проверяет, что
+// тендеры в статусах, отличных от PUBLISHED, нельзя запросить без username.
+func TestGetTenders_FailStatusFilterWithoutUsername(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	filter := models.TenderFilter{Statuses: []string{models.TenderPublishedStatus, models.TenderCreatedStatus}}
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+
+	// Act
+	tenders, err := tenderService.GetTenders(ctx, filter, models.Page{Limit: 20})
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrTenderStatusFilterRequiresUsername)
+	require.Empty(t, tenders.Tenders)
+	mockTenderRepo.AssertNotCalled(t, "GetTenders")
+}
+
+// TestGetTenders_FailUnknownStatus проверяет, что
+// при неизвестном статусе в фильтре возвращается ошибка.
+func TestGetTenders_FailUnknownStatus(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	filter := models.TenderFilter{Statuses: []string{"qwe"}, Viewer: "qwe"}
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+
+	// Act
+	tenders, err := tenderService.GetTenders(ctx, filter, models.Page{Limit: 20})
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrUnknownTenderStatus)
+	require.Empty(t, tenders.Tenders)
+	mockTenderRepo.AssertNotCalled(t, "GetTenders")
+}
+
+// TestGetTenders_FailViewerNotFound проверяет, что
+// если сотрудник из фильтра не найден, то возвращается ошибка.
+func TestGetTenders_FailViewerNotFound(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	filter := models.TenderFilter{Statuses: []string{models.TenderCreatedStatus}, Viewer: "qwe"}
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, "qwe").Return(models.Employee{}, outerror.ErrEmployeeNotFound)
+
+	// Act
+	tenders, err := tenderService.GetTenders(ctx, filter, models.Page{Limit: 20})
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrEmployeeNotFound)
+	require.Empty(t, tenders.Tenders)
+	mockTenderRepo.AssertNotCalled(t, "GetTenders")
 }
 
 // TestGetEmployeeTenders_Success проверяет, что
@@ -101,16 +201,18 @@ func TestGetEmployeeTenders_Success(t *testing.T) {
 		{TenderName: "Tender 1", Description: "qwe", ServiceType: "op", Status: "open", OrganizationId: 1, CreatorUsername: empl.Username},
 		{TenderName: "Tender 2", Description: "qwe", ServiceType: "op", Status: "open", OrganizationId: 2, CreatorUsername: empl.Username},
 	}
+	page := models.Page{Limit: 20, Offset: 20}
+	expectedPage := models.TenderPage{Tenders: expectedTenders, Total: 22}
 	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
 	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, empl.Username).Return(empl, nil)
-	mockTenderRepo.On("GetEmployeeTenders", ctx, empl).Return(expectedTenders, nil)
+	mockTenderRepo.On("GetEmployeeTenders", ctx, empl, page).Return(expectedPage, nil)
 
 	// Act
-	tenders, err := tenderService.GetEmployeeTendersByUsername(ctx, empl.Username)
+	tenders, err := tenderService.GetEmployeeTendersByUsername(ctx, empl.Username, page)
 
 	// Assert
 	require.NoError(t, err)
-	require.Equal(t, expectedTenders, tenders)
+	require.Equal(t, expectedPage, tenders)
 }
 
 // TestGetEmployeeTenders_FailEmployeeNotFound проверяет, что
@@ -130,11 +232,11 @@ func TestGetEmployeeTenders_FailEmployeeNotFound(t *testing.T) {
 	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, usermame).Return(models.Employee{}, outerror.ErrEmployeeNotFound)
 
 	// Act
-	tenders, err := tenderService.GetEmployeeTendersByUsername(ctx, usermame)
+	tenders, err := tenderService.GetEmployeeTendersByUsername(ctx, usermame, models.Page{Limit: 20})
 
 	// Assert
 	require.ErrorIs(t, err, outerror.ErrEmployeeNotFound)
-	require.Equal(t, expectedTenders, tenders)
+	require.Equal(t, expectedTenders, tenders.Tenders)
 }
 
 // TestGetEmployeeTenders_FailEmployeeTendersNotFound проверяет,
@@ -152,12 +254,93 @@ func TestGetEmployeeTenders_FailEmployeeTendersNotFound(t *testing.T) {
 	expectedTenders := []models.Tender{}
 	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
 	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, empl.Username).Return(empl, nil)
-	mockTenderRepo.On("GetEmployeeTenders", ctx, empl).Return(expectedTenders, outerror.ErrEmployeeTendersNotFound)
+	mockTenderRepo.On("GetEmployeeTenders", ctx, empl, models.Page{Limit: 20}).Return(models.TenderPage{Tenders: expectedTenders}, outerror.ErrEmployeeTendersNotFound)
 
 	// Act
-	tenders, err := tenderService.GetEmployeeTendersByUsername(ctx, empl.Username)
+	tenders, err := tenderService.GetEmployeeTendersByUsername(ctx, empl.Username, models.Page{Limit: 20})
 
 	// Assert
 	require.ErrorIs(t, err, outerror.ErrEmployeeTendersNotFound)
-	require.Equal(t, expectedTenders, tenders)
+	require.Equal(t, expectedTenders, tenders.Tenders)
+}
+
+// TestGetOrganizationTenders_Success проверяет, что ответственный
+// за организацию сотрудник получает тендеры организации во всех статусах.
+func TestGetOrganizationTenders_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	page := models.Page{Limit: 20}
+	expectedFilter := models.TenderFilter{
+		OrganizationId: 1,
+		Statuses:       []string{models.TenderCreatedStatus, models.TenderPublishedStatus, models.TenderClosedStatus},
+		Viewer:         "qwe",
+		ViewAll:        true,
+	}
+	expectedPage := models.TenderPage{
+		Tenders: []models.Tender{{ID: 1, Status: models.TenderCreatedStatus, OrganizationId: 1, CreatorUsername: "zxc"}},
+		Total:   1,
+	}
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockOrgRepo.On("GetOrganizationById", ctx, 1).Return(models.Organization{ID: 1}, nil)
+	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, "qwe").Return(models.Employee{ID: 2, Username: "qwe"}, nil)
+	mockResponsibler.On("CheckResponsibility", ctx, 2, 1).Return(nil)
+	mockTenderRepo.On("GetTenders", ctx, expectedFilter, page).Return(expectedPage, nil)
+
+	// Act
+	tenders, err := tenderService.GetOrganizationTenders(ctx, 1, "qwe", models.TenderFilter{}, page)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, expectedPage, tenders)
+}
+
+// TestGetOrganizationTenders_FailNotResponsible проверяет, что
+// сотрудник, не ответственный за организацию, не получает ее тендеры.
+func TestGetOrganizationTenders_FailNotResponsible(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockOrgRepo.On("GetOrganizationById", ctx, 1).Return(models.Organization{ID: 1}, nil)
+	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, "qwe").Return(models.Employee{ID: 2, Username: "qwe"}, nil)
+	mockResponsibler.On("CheckResponsibility", ctx, 2, 1).Return(outerror.ErrEmployeeNotResponsibleForOrganization)
+
+	// Act
+	tenders, err := tenderService.GetOrganizationTenders(ctx, 1, "qwe", models.TenderFilter{}, models.Page{Limit: 20})
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrEmployeeNotResponsibleForOrganization)
+	require.Empty(t, tenders.Tenders)
+	mockTenderRepo.AssertNotCalled(t, "GetTenders")
+}
+
+// TestGetOrganizationTenders_FailOrganizationNotFound проверяет, что
+// для несуществующей организации возвращается ошибка.
+func TestGetOrganizationTenders_FailOrganizationNotFound(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockOrgRepo.On("GetOrganizationById", ctx, 1).Return(models.Organization{}, outerror.ErrOrganizationNotFound)
+
+	// Act
+	tenders, err := tenderService.GetOrganizationTenders(ctx, 1, "qwe", models.TenderFilter{}, models.Page{Limit: 20})
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrOrganizationNotFound)
+	require.Empty(t, tenders.Tenders)
+	mockTenderRepo.AssertNotCalled(t, "GetTenders")
 }
@@ -0,0 +1,217 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/sariya23/tender/internal/service/tender"
+	"github.com/sariya23/tender/internal/service/tender/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// importTender тендер из файла импорта с названием name.
+func importTender(name string, orgId int) models.Tender {
+	return models.Tender{
+		TenderName:      name,
+		Description:     "qwe",
+		ServiceType:     "Construction",
+		Status:          models.TenderCreatedStatus,
+		OrganizationId:  orgId,
+		CreatorUsername: "qwe",
+	}
+}
+
+// TestImportTenders_Success проверяет, что проверенные тендеры создаются
+// транзакциями по размеру пачки, а строки с ошибкой пропускаются.
+func TestImportTenders_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	parseErr := errors.New("parse error")
+	rows := []models.TenderImportRow{
+		{Line: 2, Tender: importTender("Тендер 1", 1)},
+		{Line: 3, Err: parseErr},
+		{Line: 4, Tender: importTender("Тендер 2", 1)},
+		{Line: 5, Tender: importTender("Тендер 3", 1)},
+	}
+	created := func(id int, tender models.Tender) models.Tender {
+		tender.ID = id
+		tender.Version = 1
+		return tender
+	}
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler, tender.WithImportBatchSize(2))
+	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, "qwe").Return(models.Employee{ID: 1, Username: "qwe"}, nil)
+	mockOrgRepo.On("GetOrganizationById", ctx, 1).Return(models.Organization{}, nil)
+	mockResponsibler.On("CheckResponsibility", ctx, 1, 1).Return(nil)
+	mockTenderRepo.On("CreateTenders", ctx, []models.Tender{importTender("Тендер 1", 1), importTender("Тендер 2", 1)}).
+		Return([]models.Tender{created(1, importTender("Тендер 1", 1)), created(2, importTender("Тендер 2", 1))}, nil).Once()
+	mockTenderRepo.On("CreateTenders", ctx, []models.Tender{importTender("Тендер 3", 1)}).
+		Return([]models.Tender{created(3, importTender("Тендер 3", 1))}, nil).Once()
+
+	// Act
+	result, err := tenderService.ImportTenders(ctx, rows, "qwe", false)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, []models.TenderImportRow{
+		{Line: 2, Tender: created(1, importTender("Тендер 1", 1))},
+		{Line: 3, Err: parseErr},
+		{Line: 4, Tender: created(2, importTender("Тендер 2", 1))},
+		{Line: 5, Tender: created(3, importTender("Тендер 3", 1))},
+	}, result)
+	mockTenderRepo.AssertExpectations(t)
+}
+
+// TestImportTenders_DryRun проверяет, что при dry run строки проверяются
+// так же, как при создании тендера, но тендеры не создаются. Тендер
+// другого сотрудника без права передачи не проходит проверку.
+func TestImportTenders_DryRun(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	otherCreator := importTender("Тендер 3", 1)
+	otherCreator.CreatorUsername = "asd"
+	rows := []models.TenderImportRow{
+		{Line: 1, Tender: importTender("Тендер 1", 1)},
+		{Line: 2, Tender: importTender("Тендер 2", 2)},
+		{Line: 3, Tender: otherCreator},
+	}
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, "qwe").Return(models.Employee{ID: 1, Username: "qwe"}, nil)
+	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, "asd").Return(models.Employee{ID: 2, Username: "asd"}, nil)
+	mockOrgRepo.On("GetOrganizationById", ctx, 1).Return(models.Organization{}, nil)
+	mockOrgRepo.On("GetOrganizationById", ctx, 2).Return(models.Organization{}, outerror.ErrOrganizationNotFound)
+	mockResponsibler.On("CheckResponsibility", ctx, 1, 1).Return(nil)
+	mockResponsibler.On("CheckResponsibility", ctx, 2, 1).Return(nil)
+
+	// Act
+	result, err := tenderService.ImportTenders(ctx, rows, "qwe", true)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result, 3)
+	require.NoError(t, result[0].Err)
+	require.Equal(t, importTender("Тендер 1", 1), result[0].Tender)
+	require.ErrorIs(t, result[1].Err, outerror.ErrOrganizationNotFound)
+	require.ErrorIs(t, result[2].Err, outerror.ErrEmployeeNotResponsibleForTender)
+	mockTenderRepo.AssertNotCalled(t, "CreateTenders")
+}
+
+// TestImportTenders_FailBatch проверяет, что ошибка транзакции
+// записывается во все строки пачки, а следующие пачки создаются.
+func TestImportTenders_FailBatch(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	dbErr := errors.New("db error")
+	rows := []models.TenderImportRow{
+		{Line: 1, Tender: importTender("Тендер 1", 1)},
+		{Line: 2, Tender: importTender("Тендер 2", 1)},
+		{Line: 3, Tender: importTender("Тендер 3", 1)},
+	}
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler, tender.WithImportBatchSize(2))
+	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, "qwe").Return(models.Employee{ID: 1, Username: "qwe"}, nil)
+	mockOrgRepo.On("GetOrganizationById", ctx, 1).Return(models.Organization{}, nil)
+	mockResponsibler.On("CheckResponsibility", ctx, 1, 1).Return(nil)
+	mockTenderRepo.On("CreateTenders", ctx, []models.Tender{importTender("Тендер 1", 1), importTender("Тендер 2", 1)}).
+		Return([]models.Tender{}, dbErr).Once()
+	mockTenderRepo.On("CreateTenders", ctx, []models.Tender{importTender("Тендер 3", 1)}).
+		Return([]models.Tender{importTender("Тендер 3", 1)}, nil).Once()
+
+	// Act
+	result, err := tenderService.ImportTenders(ctx, rows, "qwe", false)
+
+	// Assert
+	require.NoError(t, err)
+	require.ErrorIs(t, result[0].Err, dbErr)
+	require.ErrorIs(t, result[1].Err, dbErr)
+	require.NoError(t, result[2].Err)
+	mockTenderRepo.AssertExpectations(t)
+}
+
+// TestImportTenders_CanceledAfterBatch проверяет, что если контекст
+// отменен после созданной пачки, то вместе с ошибкой возвращается отчет:
+// созданные тендеры в нем остаются, а остальные строки помечены
+// как не импортированные.
+func TestImportTenders_CanceledAfterBatch(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	createdTender := importTender("Тендер 1", 1)
+	createdTender.ID = 1
+	rows := []models.TenderImportRow{
+		{Line: 1, Tender: importTender("Тендер 1", 1)},
+		{Line: 2, Tender: importTender("Тендер 2", 1)},
+	}
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler, tender.WithImportBatchSize(1))
+	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, "qwe").Return(models.Employee{ID: 1, Username: "qwe"}, nil)
+	mockOrgRepo.On("GetOrganizationById", ctx, 1).Return(models.Organization{}, nil)
+	mockResponsibler.On("CheckResponsibility", ctx, 1, 1).Return(nil)
+	mockTenderRepo.On("CreateTenders", ctx, []models.Tender{importTender("Тендер 1", 1)}).
+		Run(func(mock.Arguments) { cancel() }).
+		Return([]models.Tender{createdTender}, nil).Once()
+
+	// Act
+	result, err := tenderService.ImportTenders(ctx, rows, "qwe", false)
+
+	// Assert
+	require.ErrorIs(t, err, context.Canceled)
+	require.Len(t, result, 2)
+	require.NoError(t, result[0].Err)
+	require.Equal(t, createdTender, result[0].Tender)
+	require.ErrorIs(t, result[1].Err, outerror.ErrImportRowNotAttempted)
+	require.ErrorIs(t, result[1].Err, context.Canceled)
+	mockTenderRepo.AssertNumberOfCalls(t, "CreateTenders", 1)
+}
+
+// TestImportTenders_CanceledBeforeCheck проверяет, что если контекст
+// отменен до проверки строк, то все строки без ошибки помечены
+// как не импортированные, а строки с ошибкой разбора не меняются.
+func TestImportTenders_CanceledBeforeCheck(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	parseErr := errors.New("parse error")
+	rows := []models.TenderImportRow{
+		{Line: 1, Err: parseErr},
+		{Line: 2, Tender: importTender("Тендер 1", 1)},
+	}
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+
+	// Act
+	result, err := tenderService.ImportTenders(ctx, rows, "qwe", false)
+
+	// Assert
+	require.ErrorIs(t, err, context.Canceled)
+	require.Len(t, result, 2)
+	require.Equal(t, parseErr, result[0].Err)
+	require.ErrorIs(t, result[1].Err, outerror.ErrImportRowNotAttempted)
+	mockTenderRepo.AssertNotCalled(t, "CreateTenders")
+}
@@ -0,0 +1,358 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/domain/tenderpolicy"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/sariya23/tender/internal/service/tender"
+	"github.com/sariya23/tender/internal/service/tender/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRolePolicy_Authorize проверяет, какие действия с тендером
+// разрешены сотруднику в зависимости от его ролей.
+func TestRolePolicy_Authorize(t *testing.T) {
+	cases := []struct {
+		name        string
+		roles       []string
+		orgRole     string
+		orgRoleErr  error
+		action      tenderpolicy.Action
+		expectedErr error
+	}{
+		{name: "system admin can reassign", roles: []string{models.RoleSystemAdmin}, action: tenderpolicy.ActionReassign},
+		{name: "auditor can read", roles: []string{models.RoleAuditor}, action: tenderpolicy.ActionRead},
+		{
+			name:        "auditor cannot edit",
+			roles:       []string{models.RoleAuditor},
+			orgRoleErr:  outerror.ErrEmployeeNotResponsibleForOrganization,
+			action:      tenderpolicy.ActionEdit,
+			expectedErr: outerror.ErrEmployeeNotResponsibleForTender,
+		},
+		{name: "member can edit", roles: []string{}, orgRole: models.OrganizationRoleMember, action: tenderpolicy.ActionEdit},
+		{name: "member can rollback", roles: []string{}, orgRole: models.OrganizationRoleMember, action: tenderpolicy.ActionRollback},
+		{
+			name:        "member cannot reassign",
+			roles:       []string{},
+			orgRole:     models.OrganizationRoleMember,
+			action:      tenderpolicy.ActionReassign,
+			expectedErr: outerror.ErrEmployeeNotResponsibleForTender,
+		},
+		{name: "organization admin can reassign", roles: []string{}, orgRole: models.OrganizationRoleAdmin, action: tenderpolicy.ActionReassign},
+		{
+			name:        "employee of other organization cannot read",
+			roles:       []string{},
+			orgRoleErr:  outerror.ErrEmployeeNotResponsibleForOrganization,
+			action:      tenderpolicy.ActionRead,
+			expectedErr: outerror.ErrEmployeeNotResponsibleForTender,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+			mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+			mockRoleRepo := new(mocks.MockRoleRepo)
+			policy := tenderpolicy.NewRolePolicy(mockEmployeeRepo, mockRoleRepo)
+			mockEmployeeRepo.On("GetEmployeeByUsername", ctx, "qwe").Return(models.Employee{ID: 2, Username: "qwe"}, nil)
+			mockRoleRepo.On("GetEmployeeRoles", ctx, 2).Return(tc.roles, nil)
+			mockRoleRepo.On("GetOrganizationRole", ctx, 2, 1).Return(tc.orgRole, tc.orgRoleErr)
+
+			// Act
+			err := policy.Authorize(ctx, "qwe", tc.action, models.Tender{ID: 1, OrganizationId: 1, CreatorUsername: "zxc"})
+
+			// Assert
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+// TestRolePolicy_AuthorizeUnknownEmployee проверяет, что
+// несуществующему сотруднику ничего не разрешено.
+func TestRolePolicy_AuthorizeUnknownEmployee(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockRoleRepo := new(mocks.MockRoleRepo)
+	policy := tenderpolicy.NewRolePolicy(mockEmployeeRepo, mockRoleRepo)
+	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, "qwe").Return(models.Employee{}, outerror.ErrEmployeeNotFound)
+
+	// Act
+	err := policy.Authorize(ctx, "qwe", tenderpolicy.ActionRead, models.Tender{ID: 1, OrganizationId: 1})
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrEmployeeNotResponsibleForTender)
+	mockRoleRepo.AssertNotCalled(t, "GetEmployeeRoles")
+}
+
+// TestUpdateTender_SuccessByOrganizationMember проверяет, что с политикой
+// по ролям тендер может изменить любой ответственный за организацию,
+// а не только создатель.
+func TestUpdateTender_SuccessByOrganizationMember(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	mockRoleRepo := new(mocks.MockRoleRepo)
+	logger := slogdiscard.NewDiscardLogger()
+	desc := "new"
+	currTender := models.Tender{ID: 1, Version: 1, Status: models.TenderCreatedStatus, OrganizationId: 1, CreatorUsername: "zxc"}
+	updateTender := models.TenderToUpdate{Description: &desc}
+	expectedTender := models.Tender{ID: 1, Version: 2, Description: desc, Status: models.TenderCreatedStatus, OrganizationId: 1, CreatorUsername: "zxc"}
+	tenderService := tender.New(
+		logger,
+		mockTenderRepo,
+		mockEmployeeRepo,
+		mockOrgRepo,
+		mockResponsibler,
+		tender.WithPolicy(tenderpolicy.NewRolePolicy(mockEmployeeRepo, mockRoleRepo)),
+	)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(currTender, nil)
+	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, "qwe").Return(models.Employee{ID: 2, Username: "qwe"}, nil)
+	mockRoleRepo.On("GetEmployeeRoles", ctx, 2).Return([]string{}, nil)
+	mockRoleRepo.On("GetOrganizationRole", ctx, 2, 1).Return(models.OrganizationRoleMember, nil)
+	mockTenderRepo.On("EditTender", ctx, currTender, 1, updateTender, "qwe").Return(expectedTender, nil)
+
+	// Act
+	updatedTender, err := tenderService.EditTender(ctx, 1, updateTender, "qwe", 0)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, expectedTender, updatedTender)
+}
+
+// TestUpdateTender_FailMemberReassign проверяет, что участник организации
+// не может передать тендер другому сотруднику.
+func TestUpdateTender_FailMemberReassign(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	mockRoleRepo := new(mocks.MockRoleRepo)
+	logger := slogdiscard.NewDiscardLogger()
+	newCreator := "asd"
+	currTender := models.Tender{ID: 1, Version: 1, Status: models.TenderCreatedStatus, OrganizationId: 1, CreatorUsername: "qwe"}
+	updateTender := models.TenderToUpdate{CreatorUsername: &newCreator}
+	tenderService := tender.New(
+		logger,
+		mockTenderRepo,
+		mockEmployeeRepo,
+		mockOrgRepo,
+		mockResponsibler,
+		tender.WithPolicy(tenderpolicy.NewRolePolicy(mockEmployeeRepo, mockRoleRepo)),
+	)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(currTender, nil)
+	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, "qwe").Return(models.Employee{ID: 2, Username: "qwe"}, nil)
+	mockRoleRepo.On("GetEmployeeRoles", ctx, 2).Return([]string{}, nil)
+	mockRoleRepo.On("GetOrganizationRole", ctx, 2, 1).Return(models.OrganizationRoleMember, nil)
+
+	// Act
+	updatedTender, err := tenderService.EditTender(ctx, 1, updateTender, "qwe", 0)
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrEmployeeNotResponsibleForTender)
+	require.Equal(t, models.Tender{}, updatedTender)
+	mockTenderRepo.AssertNotCalled(t, "EditTender")
+}
+
+// TestRollbackTender_FailMemberReassign проверяет, что рядовой сотрудник
+// организации не может откатить тендер на версию с другим создателем.
+func TestRollbackTender_FailMemberReassign(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	mockRoleRepo := new(mocks.MockRoleRepo)
+	logger := slogdiscard.NewDiscardLogger()
+	currTender := models.Tender{ID: 1, Version: 2, Status: models.TenderCreatedStatus, OrganizationId: 1, CreatorUsername: "qwe"}
+	tenderVersion := models.TenderVersion{Tender: models.Tender{ID: 1, Version: 1, Status: models.TenderCreatedStatus, OrganizationId: 1, CreatorUsername: "asd"}}
+	tenderService := tender.New(
+		logger,
+		mockTenderRepo,
+		mockEmployeeRepo,
+		mockOrgRepo,
+		mockResponsibler,
+		tender.WithPolicy(tenderpolicy.NewRolePolicy(mockEmployeeRepo, mockRoleRepo)),
+	)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(currTender, nil)
+	mockTenderRepo.On("GetTenderVersion", ctx, 1, 1).Return(tenderVersion, nil)
+	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, "qwe").Return(models.Employee{ID: 2, Username: "qwe"}, nil)
+	mockRoleRepo.On("GetEmployeeRoles", ctx, 2).Return([]string{}, nil)
+	mockRoleRepo.On("GetOrganizationRole", ctx, 2, 1).Return(models.OrganizationRoleMember, nil)
+
+	// Act
+	rollbackTender, err := tenderService.RollbackTender(ctx, 1, 1, "qwe", 0)
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrEmployeeNotResponsibleForTender)
+	require.Equal(t, models.Tender{}, rollbackTender)
+	mockTenderRepo.AssertNotCalled(t, "RollbackTenderAsNewVersion", ctx, 1, 1, 2, "qwe")
+}
+
+// TestRollbackTender_SuccessAdminReassign проверяет, что администратор
+// организации может откатить тендер на версию с другим создателем.
+func TestRollbackTender_SuccessAdminReassign(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	mockRoleRepo := new(mocks.MockRoleRepo)
+	logger := slogdiscard.NewDiscardLogger()
+	currTender := models.Tender{ID: 1, Version: 2, Status: models.TenderCreatedStatus, OrganizationId: 1, CreatorUsername: "qwe"}
+	tenderVersion := models.TenderVersion{Tender: models.Tender{ID: 1, Version: 1, Status: models.TenderCreatedStatus, OrganizationId: 1, CreatorUsername: "asd"}}
+	expectedTender := models.Tender{ID: 1, Version: 3, Status: models.TenderCreatedStatus, OrganizationId: 1, CreatorUsername: "asd"}
+	tenderService := tender.New(
+		logger,
+		mockTenderRepo,
+		mockEmployeeRepo,
+		mockOrgRepo,
+		mockResponsibler,
+		tender.WithPolicy(tenderpolicy.NewRolePolicy(mockEmployeeRepo, mockRoleRepo)),
+	)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(currTender, nil).Once()
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(expectedTender, nil).Once()
+	mockTenderRepo.On("GetTenderVersion", ctx, 1, 1).Return(tenderVersion, nil)
+	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, "qwe").Return(models.Employee{ID: 2, Username: "qwe"}, nil)
+	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, "asd").Return(models.Employee{ID: 3, Username: "asd"}, nil)
+	mockRoleRepo.On("GetEmployeeRoles", ctx, 2).Return([]string{}, nil)
+	mockRoleRepo.On("GetOrganizationRole", ctx, 2, 1).Return(models.OrganizationRoleAdmin, nil)
+	mockResponsibler.On("CheckResponsibility", ctx, 3, 1).Return(nil)
+	mockTenderRepo.On("RollbackTenderAsNewVersion", ctx, 1, 1, 2, "qwe").Return(nil)
+
+	// Act
+	rollbackTender, err := tenderService.RollbackTender(ctx, 1, 1, "qwe", 0)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, expectedTender, rollbackTender)
+}
+
+// TestGetTenderVersions_SuccessAuditor проверяет, что аудитор
+// может смотреть историю чужого тендера.
+func TestGetTenderVersions_SuccessAuditor(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	mockRoleRepo := new(mocks.MockRoleRepo)
+	logger := slogdiscard.NewDiscardLogger()
+	expectedVersions := []models.TenderVersion{
+		{Tender: models.Tender{ID: 1, Version: 1, CreatorUsername: "zxc"}, IsActiveVersion: true, ModifiedBy: "zxc"},
+	}
+	tenderService := tender.New(
+		logger,
+		mockTenderRepo,
+		mockEmployeeRepo,
+		mockOrgRepo,
+		mockResponsibler,
+		tender.WithPolicy(tenderpolicy.NewRolePolicy(mockEmployeeRepo, mockRoleRepo)),
+	)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{ID: 1, OrganizationId: 1, CreatorUsername: "zxc"}, nil)
+	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, "qwe").Return(models.Employee{ID: 2, Username: "qwe"}, nil)
+	mockRoleRepo.On("GetEmployeeRoles", ctx, 2).Return([]string{models.RoleAuditor}, nil)
+	mockTenderRepo.On("GetTenderVersions", ctx, 1).Return(expectedVersions, nil)
+
+	// Act
+	versions, err := tenderService.GetTenderVersions(ctx, 1, "qwe")
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, expectedVersions, versions)
+	mockRoleRepo.AssertNotCalled(t, "GetOrganizationRole")
+}
+
+// TestGetTenders_SuccessAuditorViewAll проверяет, что аудитор
+// видит тендеры всех сотрудников в запрошенных статусах.
+func TestGetTenders_SuccessAuditorViewAll(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	mockRoleRepo := new(mocks.MockRoleRepo)
+	logger := slogdiscard.NewDiscardLogger()
+	filter := models.TenderFilter{Statuses: []string{models.TenderCreatedStatus}, Viewer: "qwe"}
+	expectedFilter := models.TenderFilter{Statuses: []string{models.TenderCreatedStatus}, Viewer: "qwe", ViewAll: true}
+	page := models.Page{Limit: 20}
+	expectedPage := models.TenderPage{
+		Tenders: []models.Tender{{ID: 1, Status: models.TenderCreatedStatus, CreatorUsername: "zxc"}},
+		Total:   1,
+	}
+	tenderService := tender.New(
+		logger,
+		mockTenderRepo,
+		mockEmployeeRepo,
+		mockOrgRepo,
+		mockResponsibler,
+		tender.WithPolicy(tenderpolicy.NewRolePolicy(mockEmployeeRepo, mockRoleRepo)),
+	)
+	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, "qwe").Return(models.Employee{ID: 2, Username: "qwe"}, nil)
+	mockRoleRepo.On("GetEmployeeRoles", ctx, 2).Return([]string{models.RoleAuditor}, nil)
+	mockTenderRepo.On("GetTenders", ctx, expectedFilter, page).Return(expectedPage, nil)
+
+	// Act
+	tenders, err := tenderService.GetTenders(ctx, filter, page)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, expectedPage, tenders)
+}
+
+// TestGetOrganizationTenders_SuccessAuditor проверяет, что аудитор
+// видит тендеры организации, не будучи ответственным за нее.
+func TestGetOrganizationTenders_SuccessAuditor(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	mockRoleRepo := new(mocks.MockRoleRepo)
+	logger := slogdiscard.NewDiscardLogger()
+	filter := models.TenderFilter{Statuses: []string{models.TenderCreatedStatus}}
+	expectedFilter := models.TenderFilter{OrganizationId: 1, Statuses: []string{models.TenderCreatedStatus}, Viewer: "qwe", ViewAll: true}
+	page := models.Page{Limit: 20}
+	expectedPage := models.TenderPage{
+		Tenders: []models.Tender{{ID: 1, Status: models.TenderCreatedStatus, OrganizationId: 1, CreatorUsername: "zxc"}},
+		Total:   1,
+	}
+	tenderService := tender.New(
+		logger,
+		mockTenderRepo,
+		mockEmployeeRepo,
+		mockOrgRepo,
+		mockResponsibler,
+		tender.WithPolicy(tenderpolicy.NewRolePolicy(mockEmployeeRepo, mockRoleRepo)),
+	)
+	mockOrgRepo.On("GetOrganizationById", ctx, 1).Return(models.Organization{ID: 1}, nil)
+	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, "qwe").Return(models.Employee{ID: 2, Username: "qwe"}, nil)
+	mockRoleRepo.On("GetEmployeeRoles", ctx, 2).Return([]string{models.RoleAuditor}, nil)
+	mockTenderRepo.On("GetTenders", ctx, expectedFilter, page).Return(expectedPage, nil)
+
+	// Act
+	tenders, err := tenderService.GetOrganizationTenders(ctx, 1, "qwe", filter, page)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, expectedPage, tenders)
+	mockResponsibler.AssertNotCalled(t, "CheckResponsibility")
+}
@@ -35,11 +35,11 @@ func TestRollbackTender_Success(t *testing.T) {
 	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
 	mockTenderRepo.On("GetTenderById", ctx, 2).Return(models.Tender{CreatorUsername: "qwe"}, nil).Once()
 	mockTenderRepo.On("GetTenderById", ctx, 2).Return(expectedTender, nil).Once()
-	mockTenderRepo.On("FindTenderVersion", ctx, 2, 1).Return(nil)
-	mockTenderRepo.On("RollbackTender", ctx, 2, 1).Return(nil)
+	mockTenderRepo.On("GetTenderVersion", ctx, 2, 1).Return(models.TenderVersion{Tender: models.Tender{CreatorUsername: "qwe"}}, nil)
+	mockTenderRepo.On("RollbackTenderAsNewVersion", ctx, 2, 1, 0, "qwe").Return(nil)
 
 	// Act
-	tender, err := tenderService.RollbackTender(ctx, 2, 1, "qwe")
+	tender, err := tenderService.RollbackTender(ctx, 2, 1, "qwe", 0)
 
 	// Assert
 	require.NoError(t, err)
@@ -60,7 +60,7 @@ func TestRollbackTender_FailTenderNotFound(t *testing.T) {
 	mockTenderRepo.On("GetTenderById", ctx, 2).Return(models.Tender{}, outerror.ErrTenderNotFound)
 
 	// Act
-	tender, err := tenderService.RollbackTender(ctx, 2, 1, "qwe")
+	tender, err := tenderService.RollbackTender(ctx, 2, 1, "qwe", 0)
 
 	// Assert
 	require.ErrorIs(t, err, outerror.ErrTenderNotFound)
@@ -79,10 +79,10 @@ func TestRollbackTender_FailVersionNotFound(t *testing.T) {
 	logger := slogdiscard.NewDiscardLogger()
 	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
 	mockTenderRepo.On("GetTenderById", ctx, 2).Return(models.Tender{CreatorUsername: "qwe"}, nil)
-	mockTenderRepo.On("FindTenderVersion", ctx, 2, 1).Return(outerror.ErrTenderVersionNotFound)
+	mockTenderRepo.On("GetTenderVersion", ctx, 2, 1).Return(models.TenderVersion{}, outerror.ErrTenderVersionNotFound)
 
 	// Act
-	tender, err := tenderService.RollbackTender(ctx, 2, 1, "qwe")
+	tender, err := tenderService.RollbackTender(ctx, 2, 1, "qwe", 0)
 
 	// Assert
 	require.ErrorIs(t, err, outerror.ErrTenderVersionNotFound)
@@ -102,11 +102,11 @@ func TestRollbackTender_FailCannotRollbackTender(t *testing.T) {
 	someErr := errors.New("some err")
 	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
 	mockTenderRepo.On("GetTenderById", ctx, 2).Return(models.Tender{CreatorUsername: "qwe"}, nil)
-	mockTenderRepo.On("FindTenderVersion", ctx, 2, 1).Return(nil)
-	mockTenderRepo.On("RollbackTender", ctx, 2, 1).Return(someErr)
+	mockTenderRepo.On("GetTenderVersion", ctx, 2, 1).Return(models.TenderVersion{Tender: models.Tender{CreatorUsername: "qwe"}}, nil)
+	mockTenderRepo.On("RollbackTenderAsNewVersion", ctx, 2, 1, 0, "qwe").Return(someErr)
 
 	// Act
-	tender, err := tenderService.RollbackTender(ctx, 2, 1, "qwe")
+	tender, err := tenderService.RollbackTender(ctx, 2, 1, "qwe", 0)
 
 	// Assert
 	require.ErrorIs(t, err, someErr)
@@ -126,12 +126,186 @@ func TestRollbackTender_FailEmployeeNotResponsibleForTender(t *testing.T) {
 	logger := slogdiscard.NewDiscardLogger()
 	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
 	mockTenderRepo.On("GetTenderById", ctx, 2).Return(models.Tender{CreatorUsername: "qwe"}, nil)
-	mockTenderRepo.On("FindTenderVersion", ctx, 2, 1).Return(nil)
+	mockTenderRepo.On("GetTenderVersion", ctx, 2, 1).Return(models.TenderVersion{Tender: models.Tender{CreatorUsername: "qwe"}}, nil)
 
 	// Act
-	tender, err := tenderService.RollbackTender(ctx, 2, 1, "zxc")
+	tender, err := tenderService.RollbackTender(ctx, 2, 1, "zxc", 0)
 
 	// Assert
 	require.ErrorIs(t, err, outerror.ErrEmployeeNotResponsibleForTender)
 	require.Equal(t, models.Tender{}, tender)
 }
+
+// TestRollbackTender_SuccessReactivateMode проверяет, что
+// в режиме reactivate активной становится старая версия, а новая не создается.
+func TestRollbackTender_SuccessReactivateMode(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	expectedTender := models.Tender{ID: 2, Version: 1, TenderName: "Tender 1", CreatorUsername: "qwe"}
+
+	tenderService := tender.New(
+		logger,
+		mockTenderRepo,
+		mockEmployeeRepo,
+		mockOrgRepo,
+		mockResponsibler,
+		tender.WithRollbackMode(tender.RollbackModeReactivate),
+	)
+	mockTenderRepo.On("GetTenderById", ctx, 2).Return(models.Tender{CreatorUsername: "qwe"}, nil).Once()
+	mockTenderRepo.On("GetTenderById", ctx, 2).Return(expectedTender, nil).Once()
+	mockTenderRepo.On("GetTenderVersion", ctx, 2, 1).Return(models.TenderVersion{Tender: models.Tender{CreatorUsername: "qwe"}}, nil)
+	mockTenderRepo.On("RollbackTender", ctx, 2, 1, 0).Return(nil)
+
+	// Act
+	tender, err := tenderService.RollbackTender(ctx, 2, 1, "qwe", 0)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, expectedTender, tender)
+	mockTenderRepo.AssertNotCalled(t, "RollbackTenderAsNewVersion", ctx, 2, 1, 0, "qwe")
+}
+
+// TestRollbackTender_FailExpectedVersionConflict проверяет, что
+// если текущая версия тендера не равна ожидаемой, то откат не происходит.
+func TestRollbackTender_FailExpectedVersionConflict(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetTenderById", ctx, 2).Return(models.Tender{Version: 5, CreatorUsername: "qwe"}, nil)
+	mockTenderRepo.On("GetTenderVersion", ctx, 2, 1).Return(models.TenderVersion{Tender: models.Tender{CreatorUsername: "qwe"}}, nil)
+
+	// Act
+	tender, err := tenderService.RollbackTender(ctx, 2, 1, "qwe", 4)
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrTenderVersionConflict)
+	require.Equal(t, models.Tender{}, tender)
+	mockTenderRepo.AssertNotCalled(t, "RollbackTenderAsNewVersion", ctx, 2, 1, 5, "qwe")
+}
+
+// TestRollbackTender_FailStatusTransition проверяет, что откат на версию
+// с другим статусом проверяется как смена статуса: опубликованный тендер
+// нельзя закрыть без голосования, а закрытый - открыть без причины.
+func TestRollbackTender_FailStatusTransition(t *testing.T) {
+	cases := []struct {
+		name          string
+		currentStatus string
+		versionStatus string
+		expectedErr   error
+	}{
+		{name: "close published", currentStatus: models.TenderPublishedStatus, versionStatus: models.TenderClosedStatus, expectedErr: outerror.ErrTenderCloseRequiresQuorum},
+		{name: "reopen closed", currentStatus: models.TenderClosedStatus, versionStatus: models.TenderPublishedStatus, expectedErr: outerror.ErrTenderStatusReasonRequired},
+		{name: "unpublish", currentStatus: models.TenderPublishedStatus, versionStatus: models.TenderCreatedStatus, expectedErr: outerror.ErrCannotSetThisTenderStatus},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+			mockTenderRepo := new(mocks.MockTenderRepo)
+			mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+			mockOrgRepo := new(mocks.MockOrgRepo)
+			mockResponsibler := new(mocks.MockEmployeeResponsibler)
+			logger := slogdiscard.NewDiscardLogger()
+			tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+			mockTenderRepo.On("GetTenderById", ctx, 2).Return(models.Tender{Status: tc.currentStatus, Version: 3, CreatorUsername: "qwe"}, nil)
+			mockTenderRepo.On("GetTenderVersion", ctx, 2, 1).Return(models.TenderVersion{Tender: models.Tender{Status: tc.versionStatus, CreatorUsername: "qwe"}}, nil)
+
+			// Act
+			tender, err := tenderService.RollbackTender(ctx, 2, 1, "qwe", 0)
+
+			// Assert
+			require.ErrorIs(t, err, tc.expectedErr)
+			require.Equal(t, models.Tender{}, tender)
+			mockTenderRepo.AssertNotCalled(t, "RollbackTenderAsNewVersion", ctx, 2, 1, 3, "qwe")
+		})
+	}
+}
+
+// TestRollbackTender_SuccessReassign проверяет, что откат на версию
+// с другой организацией проходит, если создатель ответственный за нее.
+func TestRollbackTender_SuccessReassign(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	expectedTender := models.Tender{ID: 2, Version: 4, OrganizationId: 3, CreatorUsername: "qwe"}
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetTenderById", ctx, 2).Return(models.Tender{Version: 3, OrganizationId: 1, CreatorUsername: "qwe"}, nil).Once()
+	mockTenderRepo.On("GetTenderById", ctx, 2).Return(expectedTender, nil).Once()
+	mockTenderRepo.On("GetTenderVersion", ctx, 2, 1).Return(models.TenderVersion{Tender: models.Tender{OrganizationId: 3, CreatorUsername: "qwe"}}, nil)
+	mockOrgRepo.On("GetOrganizationById", ctx, 3).Return(models.Organization{ID: 3}, nil)
+	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, "qwe").Return(models.Employee{ID: 7, Username: "qwe"}, nil)
+	mockResponsibler.On("CheckResponsibility", ctx, 7, 3).Return(nil)
+	mockTenderRepo.On("RollbackTenderAsNewVersion", ctx, 2, 1, 3, "qwe").Return(nil)
+
+	// Act
+	tender, err := tenderService.RollbackTender(ctx, 2, 1, "qwe", 0)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, expectedTender, tender)
+	mockResponsibler.AssertCalled(t, "CheckResponsibility", ctx, 7, 3)
+}
+
+// TestRollbackTender_FailReassignCreatorNotResponsible проверяет, что тендер
+// нельзя откатить на версию с другим создателем, если тот уже не
+// ответственный за организацию тендера.
+func TestRollbackTender_FailReassignCreatorNotResponsible(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetTenderById", ctx, 2).Return(models.Tender{Version: 3, OrganizationId: 1, CreatorUsername: "qwe"}, nil)
+	mockTenderRepo.On("GetTenderVersion", ctx, 2, 1).Return(models.TenderVersion{Tender: models.Tender{OrganizationId: 1, CreatorUsername: "zxc"}}, nil)
+	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, "zxc").Return(models.Employee{ID: 8, Username: "zxc"}, nil)
+	mockResponsibler.On("CheckResponsibility", ctx, 8, 1).Return(outerror.ErrEmployeeNotResponsibleForOrganization)
+
+	// Act
+	tender, err := tenderService.RollbackTender(ctx, 2, 1, "qwe", 0)
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrUpdatedEmployeeNotResponsibleForCurrentOrg)
+	require.Equal(t, models.Tender{}, tender)
+	mockTenderRepo.AssertNotCalled(t, "RollbackTenderAsNewVersion", ctx, 2, 1, 3, "qwe")
+}
+
+// TestRollbackTender_FailReassignCreatorNotFound проверяет, что тендер
+// нельзя откатить на версию, создателя которой больше нет.
+func TestRollbackTender_FailReassignCreatorNotFound(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetTenderById", ctx, 2).Return(models.Tender{Version: 3, OrganizationId: 1, CreatorUsername: "qwe"}, nil)
+	mockTenderRepo.On("GetTenderVersion", ctx, 2, 1).Return(models.TenderVersion{Tender: models.Tender{OrganizationId: 1, CreatorUsername: "zxc"}}, nil)
+	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, "zxc").Return(models.Employee{}, outerror.ErrEmployeeNotFound)
+
+	// Act
+	tender, err := tenderService.RollbackTender(ctx, 2, 1, "qwe", 0)
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrEmployeeNotFound)
+	require.Equal(t, models.Tender{}, tender)
+	mockTenderRepo.AssertNotCalled(t, "RollbackTenderAsNewVersion", ctx, 2, 1, 3, "qwe")
+}
@@ -0,0 +1,136 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/domain/tenderstatus"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/sariya23/tender/internal/service/tender"
+	"github.com/sariya23/tender/internal/service/tender/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRunScheduledTransitions_Success проверяет, что тендер с наступившим
+// publish_at публикуется, а тендер с прошедшим deadline закрывается.
+func TestRunScheduledTransitions_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	now := time.Date(2024, 12, 20, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+	toPublish := models.Tender{ID: 1, Version: 1, Status: models.TenderCreatedStatus, CreatorUsername: "qwe", PublishAt: &past}
+	toClose := models.Tender{ID: 2, Version: 3, Status: models.TenderPublishedStatus, CreatorUsername: "zxc", Deadline: &past}
+	published := toPublish
+	published.Version, published.Status = 2, models.TenderPublishedStatus
+	closed := toClose
+	closed.Version, closed.Status = 4, models.TenderClosedStatus
+	events := []tenderstatus.Event{}
+	hook := func(ctx context.Context, event tenderstatus.Event) {
+		events = append(events, event)
+	}
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler, tender.WithStatusHook(hook))
+	mockTenderRepo.On("GetDueTenders", ctx, now).Return([]models.Tender{toPublish, toClose}, nil)
+	mockTenderRepo.On("EditTender", ctx, toPublish, 1, models.TenderToUpdate{Status: &models.TenderPublishedStatus}, tender.SchedulerUsername).Return(published, nil)
+	mockTenderRepo.On("EditTender", ctx, toClose, 2, models.TenderToUpdate{Status: &models.TenderClosedStatus}, tender.SchedulerUsername).Return(closed, nil)
+
+	// Act
+	transited, err := tenderService.RunScheduledTransitions(ctx, now)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, 2, transited)
+	require.Equal(t, []tenderstatus.Event{
+		{From: models.TenderCreatedStatus, To: models.TenderPublishedStatus, Reason: "publish_at reached", Username: tender.SchedulerUsername, Tender: published},
+		{From: models.TenderPublishedStatus, To: models.TenderClosedStatus, Reason: "deadline passed", Username: tender.SchedulerUsername, Tender: closed},
+	}, events)
+}
+
+// TestRunScheduledTransitions_SkipConcurrentEdit проверяет, что тендер,
+// который изменили одновременно с планировщиком, пропускается.
+func TestRunScheduledTransitions_SkipConcurrentEdit(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	now := time.Date(2024, 12, 20, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+	toPublish := models.Tender{ID: 1, Version: 1, Status: models.TenderCreatedStatus, PublishAt: &past}
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetDueTenders", ctx, now).Return([]models.Tender{toPublish}, nil)
+	mockTenderRepo.On("EditTender", ctx, toPublish, 1, models.TenderToUpdate{Status: &models.TenderPublishedStatus}, tender.SchedulerUsername).Return(models.Tender{}, outerror.ErrTenderVersionConflict)
+
+	// Act
+	transited, err := tenderService.RunScheduledTransitions(ctx, now)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, 0, transited)
+}
+
+// TestRunScheduledTransitions_FailGetDueTenders проверяет, что
+// ошибка получения тендеров возвращается наружу.
+func TestRunScheduledTransitions_FailGetDueTenders(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	now := time.Date(2024, 12, 20, 12, 0, 0, 0, time.UTC)
+	someErr := errors.New("some err")
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetDueTenders", ctx, now).Return([]models.Tender{}, someErr)
+
+	// Act
+	transited, err := tenderService.RunScheduledTransitions(ctx, now)
+
+	// Assert
+	require.ErrorIs(t, err, someErr)
+	require.Equal(t, 0, transited)
+}
+
+// TestRunScheduledTransitions_ContinueAfterError проверяет, что ошибка
+// перевода одного тендера не мешает перевести остальные, а возвращается
+// после обработки всех тендеров.
+func TestRunScheduledTransitions_ContinueAfterError(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	now := time.Date(2024, 12, 20, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+	broken := models.Tender{ID: 1, Version: 1, Status: models.TenderCreatedStatus, PublishAt: &past}
+	toPublish := models.Tender{ID: 2, Version: 1, Status: models.TenderCreatedStatus, PublishAt: &past}
+	published := toPublish
+	published.Version = 2
+	published.Status = models.TenderPublishedStatus
+	someErr := errors.New("some err")
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetDueTenders", ctx, now).Return([]models.Tender{broken, toPublish}, nil)
+	mockTenderRepo.On("EditTender", ctx, broken, 1, models.TenderToUpdate{Status: &models.TenderPublishedStatus}, tender.SchedulerUsername).Return(models.Tender{}, someErr)
+	mockTenderRepo.On("EditTender", ctx, toPublish, 2, models.TenderToUpdate{Status: &models.TenderPublishedStatus}, tender.SchedulerUsername).Return(published, nil)
+
+	// Act
+	transited, err := tenderService.RunScheduledTransitions(ctx, now)
+
+	// Assert
+	require.ErrorIs(t, err, someErr)
+	require.Equal(t, 1, transited)
+	mockTenderRepo.AssertExpectations(t)
+}
@@ -0,0 +1,89 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/sariya23/tender/internal/service/tender"
+	"github.com/sariya23/tender/internal/service/tender/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSearchTenders_Success проверяет, что запрос без
+// лишних пробелов и страница передаются в поиск
+// и возвращаются найденные тендеры.
+func TestSearchTenders_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	mockSearcher := new(mocks.MockTenderSearcher)
+	logger := slogdiscard.NewDiscardLogger()
+	page := models.Page{Limit: 10, Offset: 10}
+	expectedPage := models.TenderSearchPage{
+		Results: []models.TenderSearchResult{
+			{Tender: models.Tender{ID: 1, TenderName: "Ремонт дороги"}, Rank: 0.6, Snippet: "<mark>Ремонт</mark> дороги"},
+		},
+		Total: 11,
+	}
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler, tender.WithSearcher(mockSearcher))
+	mockSearcher.On("SearchTenders", ctx, "ремонт", page).Return(expectedPage, nil)
+
+	// Act
+	results, err := tenderService.SearchTenders(ctx, "  ремонт ", page)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, expectedPage, results)
+}
+
+// TestSearchTenders_FailEmptyQuery проверяет, что
+// пустой запрос не передается в поиск.
+func TestSearchTenders_FailEmptyQuery(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	mockSearcher := new(mocks.MockTenderSearcher)
+	logger := slogdiscard.NewDiscardLogger()
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler, tender.WithSearcher(mockSearcher))
+
+	// Act
+	results, err := tenderService.SearchTenders(ctx, "   ", models.Page{Limit: 20})
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrEmptySearchQuery)
+	require.Empty(t, results.Results)
+	mockSearcher.AssertNotCalled(t, "SearchTenders")
+}
+
+// TestSearchTenders_FailSearchError проверяет, что
+// ошибка поиска возвращается вызывающему.
+func TestSearchTenders_FailSearchError(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	mockSearcher := new(mocks.MockTenderSearcher)
+	logger := slogdiscard.NewDiscardLogger()
+	someErr := errors.New("some err")
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler, tender.WithSearcher(mockSearcher))
+	mockSearcher.On("SearchTenders", ctx, "ремонт", models.Page{Limit: 20}).Return(models.TenderSearchPage{}, someErr)
+
+	// Act
+	results, err := tenderService.SearchTenders(ctx, "ремонт", models.Page{Limit: 20})
+
+	// Assert
+	require.ErrorIs(t, err, someErr)
+	require.Empty(t, results.Results)
+}
@@ -0,0 +1,248 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/domain/tenderstatus"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/sariya23/tender/internal/service/tender"
+	"github.com/sariya23/tender/internal/service/tender/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetTenderStatus_Success проверяет, что создатель
+// тендера получает статус и доступные переходы.
+func TestGetTenderStatus_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{ID: 1, Version: 2, Status: models.TenderClosedStatus, CreatorUsername: "qwe"}, nil)
+	expectedState := tenderstatus.State{
+		TenderId: 1,
+		Version:  2,
+		Status:   models.TenderClosedStatus,
+		Allowed: []tenderstatus.Transition{
+			{From: models.TenderClosedStatus, To: models.TenderPublishedStatus, RequiresReason: true},
+		},
+	}
+
+	// Act
+	state, err := tenderService.GetTenderStatus(ctx, 1, "qwe")
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, expectedState, state)
+}
+
+// TestGetTenderStatus_SuccessPublished проверяет, что закрытие
+// опубликованного тендера не попадает в доступные переходы,
+// а возвращается отдельно как переход только голосованием.
+func TestGetTenderStatus_SuccessPublished(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{ID: 1, Version: 2, Status: models.TenderPublishedStatus, CreatorUsername: "qwe"}, nil)
+	expectedState := tenderstatus.State{
+		TenderId: 1,
+		Version:  2,
+		Status:   models.TenderPublishedStatus,
+		Allowed:  []tenderstatus.Transition{},
+		QuorumOnly: []tenderstatus.Transition{
+			{From: models.TenderPublishedStatus, To: models.TenderClosedStatus},
+		},
+	}
+
+	// Act
+	state, err := tenderService.GetTenderStatus(ctx, 1, "qwe")
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, expectedState, state)
+}
+
+// TestGetTenderStatus_FailNotCreator проверяет, что статус
+// не доступен сотруднику, который не создавал тендер.
+func TestGetTenderStatus_FailNotCreator(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{Status: models.TenderCreatedStatus, CreatorUsername: "qwe"}, nil)
+
+	// Act
+	state, err := tenderService.GetTenderStatus(ctx, 1, "zxc")
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrEmployeeNotResponsibleForTender)
+	require.Equal(t, tenderstatus.State{}, state)
+}
+
+// TestSetTenderStatus_SuccessReopenWithReason проверяет, что закрытый
+// тендер можно открыть повторно с причиной и хук получит переход.
+func TestSetTenderStatus_SuccessReopenWithReason(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	events := []tenderstatus.Event{}
+	hook := func(ctx context.Context, event tenderstatus.Event) {
+		events = append(events, event)
+	}
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler, tender.WithStatusHook(hook))
+	currTender := models.Tender{ID: 1, Version: 3, Status: models.TenderClosedStatus, CreatorUsername: "qwe"}
+	updatedTender := models.Tender{ID: 1, Version: 4, Status: models.TenderPublishedStatus, CreatorUsername: "qwe"}
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(currTender, nil)
+	mockTenderRepo.On("EditTender", ctx, currTender, 1, models.TenderToUpdate{Status: &models.TenderPublishedStatus}, "qwe").Return(updatedTender, nil)
+
+	// Act
+	tender, err := tenderService.SetTenderStatus(ctx, 1, models.TenderPublishedStatus, "deadline extended", "qwe", 3)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, updatedTender, tender)
+	require.Equal(t, []tenderstatus.Event{
+		{
+			From:     models.TenderClosedStatus,
+			To:       models.TenderPublishedStatus,
+			Reason:   "deadline extended",
+			Username: "qwe",
+			Tender:   updatedTender,
+		},
+	}, events)
+}
+
+// TestSetTenderStatus_FailReopenWithoutReason проверяет, что
+// закрытый тендер нельзя открыть повторно без причины.
+func TestSetTenderStatus_FailReopenWithoutReason(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{Status: models.TenderClosedStatus, CreatorUsername: "qwe"}, nil)
+
+	// Act
+	tender, err := tenderService.SetTenderStatus(ctx, 1, models.TenderPublishedStatus, "", "qwe", 0)
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrTenderStatusReasonRequired)
+	require.Equal(t, models.Tender{}, tender)
+	mockTenderRepo.AssertNotCalled(t, "EditTender")
+}
+
+// TestSetTenderStatus_FailReopenDeadlinePassed проверяет, что закрытый
+// тендер с прошедшим дедлайном нельзя открыть повторно, иначе
+// планировщик закроет его снова без голосования.
+func TestSetTenderStatus_FailReopenDeadlinePassed(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	now := time.Date(2024, 9, 10, 12, 0, 0, 0, time.UTC)
+	deadline := now.Add(-time.Hour)
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler, tender.WithClock(func() time.Time { return now }))
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{Status: models.TenderClosedStatus, CreatorUsername: "qwe", Deadline: &deadline}, nil)
+
+	// Act
+	tender, err := tenderService.SetTenderStatus(ctx, 1, models.TenderPublishedStatus, "deadline extended", "qwe", 0)
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrTenderReopenDeadlinePassed)
+	require.Equal(t, models.Tender{}, tender)
+	mockTenderRepo.AssertNotCalled(t, "EditTender")
+}
+
+// TestSetTenderStatus_SuccessReopenFutureDeadline проверяет, что закрытый
+// тендер с дедлайном в будущем можно открыть повторно.
+func TestSetTenderStatus_SuccessReopenFutureDeadline(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	now := time.Date(2024, 9, 10, 12, 0, 0, 0, time.UTC)
+	deadline := now.Add(24 * time.Hour)
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler, tender.WithClock(func() time.Time { return now }))
+	currTender := models.Tender{ID: 1, Version: 3, Status: models.TenderClosedStatus, CreatorUsername: "qwe", Deadline: &deadline}
+	updatedTender := models.Tender{ID: 1, Version: 4, Status: models.TenderPublishedStatus, CreatorUsername: "qwe", Deadline: &deadline}
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(currTender, nil)
+	mockTenderRepo.On("EditTender", ctx, currTender, 1, models.TenderToUpdate{Status: &models.TenderPublishedStatus}, "qwe").Return(updatedTender, nil)
+
+	// Act
+	tender, err := tenderService.SetTenderStatus(ctx, 1, models.TenderPublishedStatus, "deadline extended", "qwe", 0)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, updatedTender, tender)
+}
+
+// TestSetTenderStatus_FailTransitionNotAllowed проверяет, что
+// опубликованный тендер нельзя вернуть в статус CREATED.
+func TestSetTenderStatus_FailTransitionNotAllowed(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{Status: models.TenderPublishedStatus, CreatorUsername: "qwe"}, nil)
+
+	// Act
+	tender, err := tenderService.SetTenderStatus(ctx, 1, models.TenderCreatedStatus, "why not", "qwe", 0)
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrCannotSetThisTenderStatus)
+	require.Equal(t, models.Tender{}, tender)
+}
+
+// TestSetTenderStatus_FailUnknownStatus проверяет, что
+// неизвестный статус установить нельзя.
+func TestSetTenderStatus_FailUnknownStatus(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+
+	// Act
+	tender, err := tenderService.SetTenderStatus(ctx, 1, "ARCHIVED", "", "qwe", 0)
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrUnknownTenderStatus)
+	require.Equal(t, models.Tender{}, tender)
+	mockTenderRepo.AssertNotCalled(t, "GetTenderById")
+}
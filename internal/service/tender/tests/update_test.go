@@ -3,6 +3,7 @@ package tests
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/sariya23/tender/internal/domain/models"
 	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
@@ -49,10 +50,10 @@ func TestUpdateTender_SuccessChangeDesc(t *testing.T) {
 
 	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
 	mockTenderRepo.On("GetTenderById", ctx, 1).Return(currTender, nil)
-	mockTenderRepo.On("EditTender", ctx, currTender, 1, updateTender).Return(exptectedTender, nil)
+	mockTenderRepo.On("EditTender", ctx, currTender, 1, updateTender, "test").Return(exptectedTender, nil)
 
 	// Act
-	tender, err := tenderService.EditTender(ctx, 1, updateTender, "test")
+	tender, err := tenderService.EditTender(ctx, 1, updateTender, "test", 0)
 
 	// Assert
 	require.NoError(t, err)
@@ -99,10 +100,10 @@ func TestUpdateTender_SuccessChangeOnlyUser(t *testing.T) {
 	mockTenderRepo.On("GetTenderById", ctx, 1).Return(currTender, nil)
 	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, user).Return(models.Employee{ID: 2, Username: user}, nil)
 	mockResponsibler.On("CheckResponsibility", ctx, 2, 1).Return(nil)
-	mockTenderRepo.On("EditTender", ctx, currTender, 1, updateTender).Return(exptectedTender, nil)
+	mockTenderRepo.On("EditTender", ctx, currTender, 1, updateTender, "zxc").Return(exptectedTender, nil)
 
 	// Act
-	tender, err := tenderService.EditTender(ctx, 1, updateTender, "zxc")
+	tender, err := tenderService.EditTender(ctx, 1, updateTender, "zxc", 0)
 
 	// Assert
 	require.NoError(t, err)
@@ -150,10 +151,10 @@ func TestUpdateTender_SuccessChangeOnlyOrg(t *testing.T) {
 	mockOrgRepo.On("GetOrganizationById", ctx, orgId).Return(models.Organization{ID: 2}, nil)
 	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, user).Return(models.Employee{ID: 2, Username: "qwe"}, nil)
 	mockResponsibler.On("CheckResponsibility", ctx, 2, 2).Return(nil)
-	mockTenderRepo.On("EditTender", ctx, currTender, 1, updateTender).Return(exptectedTender, nil)
+	mockTenderRepo.On("EditTender", ctx, currTender, 1, updateTender, user).Return(exptectedTender, nil)
 
 	// Act
-	tender, err := tenderService.EditTender(ctx, 1, updateTender, user)
+	tender, err := tenderService.EditTender(ctx, 1, updateTender, user, 0)
 
 	// Assert
 	require.NoError(t, err)
@@ -201,10 +202,10 @@ func TestUpdateTender_SuccessChangeOrgAndUser(t *testing.T) {
 	mockOrgRepo.On("GetOrganizationById", ctx, orgId).Return(models.Organization{ID: 1}, nil)
 	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, user).Return(models.Employee{ID: 2, Username: user}, nil)
 	mockResponsibler.On("CheckResponsibility", ctx, 2, 1).Return(nil)
-	mockTenderRepo.On("EditTender", ctx, currTender, 1, updateTender).Return(exptectedTender, nil)
+	mockTenderRepo.On("EditTender", ctx, currTender, 1, updateTender, "zxc").Return(exptectedTender, nil)
 
 	// Act
-	tender, err := tenderService.EditTender(ctx, 1, updateTender, "zxc")
+	tender, err := tenderService.EditTender(ctx, 1, updateTender, "zxc", 0)
 
 	// Assert
 	require.NoError(t, err)
@@ -228,7 +229,7 @@ func TestUpdateTender_FailTenderNotFound(t *testing.T) {
 	mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{}, outerror.ErrTenderNotFound)
 
 	// Act
-	tender, err := tenderService.EditTender(ctx, 1, tenderToUpdate, "qwe")
+	tender, err := tenderService.EditTender(ctx, 1, tenderToUpdate, "qwe", 0)
 
 	// Assert
 	require.ErrorIs(t, err, outerror.ErrTenderNotFound)
@@ -252,7 +253,7 @@ func TestUpdateTender_FailNewUserNotFound(t *testing.T) {
 	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, user).Return(models.Employee{}, outerror.ErrEmployeeNotFound)
 
 	// Act
-	tender, err := tenderService.EditTender(ctx, 1, tenderToUpdate, "zxc")
+	tender, err := tenderService.EditTender(ctx, 1, tenderToUpdate, "zxc", 0)
 
 	// Assert
 	require.ErrorIs(t, err, outerror.ErrEmployeeNotFound)
@@ -276,7 +277,7 @@ func TestUpdateTender_FailNewOrgNotFound(t *testing.T) {
 	mockOrgRepo.On("GetOrganizationById", ctx, orgId).Return(models.Organization{}, outerror.ErrOrganizationNotFound)
 
 	// Act
-	tender, err := tenderService.EditTender(ctx, 1, tenderToUpdate, "qwe")
+	tender, err := tenderService.EditTender(ctx, 1, tenderToUpdate, "qwe", 0)
 
 	// Assert
 	require.ErrorIs(t, err, outerror.ErrOrganizationNotFound)
@@ -304,7 +305,7 @@ func TestUpdateTender_FailNewEmployeeNotResponsibleForNewOrg(t *testing.T) {
 	mockResponsibler.On("CheckResponsibility", ctx, 0, 1).Return(outerror.ErrEmployeeNotResponsibleForOrganization)
 
 	// Act
-	tender, err := tenderService.EditTender(ctx, 1, tenderToUpdate, "qwe")
+	tender, err := tenderService.EditTender(ctx, 1, tenderToUpdate, "qwe", 0)
 
 	// Assert
 	require.ErrorIs(t, err, outerror.ErrUpdatedEmployeeNotResponsibleForUpdatedOrg)
@@ -330,7 +331,7 @@ func TestUpdateTender_FailNewNewEmployeeNotResponsibleForCurrOrg(t *testing.T) {
 	mockResponsibler.On("CheckResponsibility", ctx, 0, 0).Return(outerror.ErrEmployeeNotResponsibleForOrganization)
 
 	// Act
-	tender, err := tenderService.EditTender(ctx, 1, tenderToUpdate, "qwe")
+	tender, err := tenderService.EditTender(ctx, 1, tenderToUpdate, "qwe", 0)
 
 	// Assert
 	require.ErrorIs(t, err, outerror.ErrUpdatedEmployeeNotResponsibleForCurrentOrg)
@@ -357,7 +358,7 @@ func TestUpdateTender_FailCurrUserNotResponsibleForNewOrg(t *testing.T) {
 	mockResponsibler.On("CheckResponsibility", ctx, 0, 1).Return(outerror.ErrEmployeeNotResponsibleForOrganization)
 
 	// Act
-	tender, err := tenderService.EditTender(ctx, 1, tenderToUpdate, "qwe")
+	tender, err := tenderService.EditTender(ctx, 1, tenderToUpdate, "qwe", 0)
 
 	// Assert
 	require.ErrorIs(t, err, outerror.ErrCurrentEmployeeNotResponsibleForUpdatedOrg)
@@ -377,7 +378,7 @@ func TestUpdateTender_FailUnknownTenderStatus(t *testing.T) {
 	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
 
 	// Act
-	tender, err := tenderService.EditTender(ctx, 2, tenderToUpdate, "qwe")
+	tender, err := tenderService.EditTender(ctx, 2, tenderToUpdate, "qwe", 0)
 
 	// Assert
 	require.ErrorIs(t, err, outerror.ErrUnknownTenderStatus)
@@ -398,7 +399,7 @@ func TestUpdateTender_FailCannotSetStatusFromPUBLISEDToCREATED(t *testing.T) {
 	mockTenderRepo.On("GetTenderById", ctx, 2).Return(models.Tender{Status: models.TenderPublishedStatus, CreatorUsername: "qwe"}, nil)
 
 	// Act
-	tender, err := tenderService.EditTender(ctx, 2, tenderToUpdate, "qwe")
+	tender, err := tenderService.EditTender(ctx, 2, tenderToUpdate, "qwe", 0)
 
 	// Assert
 	require.ErrorIs(t, err, outerror.ErrCannotSetThisTenderStatus)
@@ -419,7 +420,7 @@ func TestUpdateTender_FailCannotSetStatusFromCLOSEDToCREATED(t *testing.T) {
 	mockTenderRepo.On("GetTenderById", ctx, 2).Return(models.Tender{Status: models.TenderClosedStatus, CreatorUsername: "qwe"}, nil)
 
 	// Act
-	tender, err := tenderService.EditTender(ctx, 2, tenderToUpdate, "qwe")
+	tender, err := tenderService.EditTender(ctx, 2, tenderToUpdate, "qwe", 0)
 
 	// Assert
 	require.ErrorIs(t, err, outerror.ErrCannotSetThisTenderStatus)
@@ -461,10 +462,10 @@ func TestUpdateTender_CanSetStatusFromCREATEDToPUBLISED(t *testing.T) {
 
 	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
 	mockTenderRepo.On("GetTenderById", ctx, 1).Return(currTender, nil)
-	mockTenderRepo.On("EditTender", ctx, currTender, 1, updateTender).Return(exptectedTender, nil)
+	mockTenderRepo.On("EditTender", ctx, currTender, 1, updateTender, user).Return(exptectedTender, nil)
 
 	// Act
-	tender, err := tenderService.EditTender(ctx, 1, updateTender, user)
+	tender, err := tenderService.EditTender(ctx, 1, updateTender, user, 0)
 
 	// Assert
 	require.NoError(t, err)
@@ -488,9 +489,132 @@ func TestUpdateTender_FailEmployeeNotRespobsibleForThisTender(t *testing.T) {
 	mockTenderRepo.On("GetTenderById", ctx, 2).Return(models.Tender{CreatorUsername: "qwe"}, nil)
 
 	// Act
-	tender, err := tenderService.EditTender(ctx, 2, tenderToUpdate, "zxc")
+	tender, err := tenderService.EditTender(ctx, 2, tenderToUpdate, "zxc", 0)
 
 	// Assert
 	require.ErrorIs(t, err, outerror.ErrEmployeeNotResponsibleForTender)
 	require.Equal(t, tender, models.Tender{})
 }
+
+// TestUpdateTender_FailClosePublishedTender проверяет, что
+// опубликованный тендер нельзя закрыть через редактирование.
+func TestUpdateTender_FailClosePublishedTender(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	status := models.TenderClosedStatus
+	tenderToUpdate := models.TenderToUpdate{Status: &status}
+	logger := slogdiscard.NewDiscardLogger()
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{Status: models.TenderPublishedStatus, CreatorUsername: "qwe"}, nil)
+
+	// Act
+	tender, err := tenderService.EditTender(ctx, 1, tenderToUpdate, "qwe", 0)
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrTenderCloseRequiresQuorum)
+	require.Equal(t, tender, models.Tender{})
+}
+
+// TestUpdateTender_FailExpectedVersionConflict проверяет, что
+// если текущая версия тендера не равна ожидаемой, то тендер не обновляется.
+func TestUpdateTender_FailExpectedVersionConflict(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	desc := "qwe"
+	tenderToUpdate := models.TenderToUpdate{Description: &desc}
+	logger := slogdiscard.NewDiscardLogger()
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{Version: 4, CreatorUsername: "qwe"}, nil)
+
+	// Act
+	tender, err := tenderService.EditTender(ctx, 1, tenderToUpdate, "qwe", 3)
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrTenderVersionConflict)
+	require.Equal(t, tender, models.Tender{})
+	mockTenderRepo.AssertNotCalled(t, "EditTender")
+}
+
+// TestUpdateTender_FailConcurrentEdit проверяет, что если тендер
+// изменили между чтением и записью, то возвращается ErrTenderVersionConflict.
+func TestUpdateTender_FailConcurrentEdit(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	desc := "qwe"
+	tenderToUpdate := models.TenderToUpdate{Description: &desc}
+	currTender := models.Tender{Version: 3, CreatorUsername: "qwe"}
+	logger := slogdiscard.NewDiscardLogger()
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(currTender, nil)
+	mockTenderRepo.On("EditTender", ctx, currTender, 1, tenderToUpdate, "qwe").Return(models.Tender{}, outerror.ErrTenderVersionConflict)
+
+	// Act
+	tender, err := tenderService.EditTender(ctx, 1, tenderToUpdate, "qwe", 3)
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrTenderVersionConflict)
+	require.Equal(t, tender, models.Tender{})
+}
+
+// TestUpdateTender_FailDeadlineBeforePublishAt проверяет, что нельзя
+// задать дедлайн раньше уже установленного времени публикации.
+func TestUpdateTender_FailDeadlineBeforePublishAt(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	publishAt := time.Date(2024, 12, 20, 10, 0, 0, 0, time.UTC)
+	deadline := publishAt.Add(-time.Minute)
+	tenderToUpdate := models.TenderToUpdate{Deadline: &deadline}
+	logger := slogdiscard.NewDiscardLogger()
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{Status: models.TenderCreatedStatus, CreatorUsername: "qwe", PublishAt: &publishAt}, nil)
+
+	// Act
+	tender, err := tenderService.EditTender(ctx, 1, tenderToUpdate, "qwe", 0)
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrTenderDeadlineBeforePublishAt)
+	require.Equal(t, models.Tender{}, tender)
+	mockTenderRepo.AssertNotCalled(t, "EditTender")
+}
+
+// TestUpdateTender_FailUnknownServiceType проверяет, что тендеру
+// нельзя указать тип услуг не из справочника.
+func TestUpdateTender_FailUnknownServiceType(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	mockServiceTypeRepo := new(mocks.MockServiceTypeRepo)
+	srvType := "Painting"
+	tenderToUpdate := models.TenderToUpdate{ServiceType: &srvType}
+	logger := slogdiscard.NewDiscardLogger()
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler, tender.WithServiceTypes(mockServiceTypeRepo))
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{Status: models.TenderCreatedStatus, CreatorUsername: "qwe"}, nil)
+	mockServiceTypeRepo.On("GetServiceTypeByName", ctx, "Painting").Return(models.ServiceType{}, outerror.ErrUnknownServiceType)
+
+	// Act
+	tender, err := tenderService.EditTender(ctx, 1, tenderToUpdate, "qwe", 0)
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrUnknownServiceType)
+	require.Equal(t, models.Tender{}, tender)
+	mockTenderRepo.AssertNotCalled(t, "EditTender")
+}
@@ -0,0 +1,127 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/sariya23/tender/internal/service/tender"
+	"github.com/sariya23/tender/internal/service/tender/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetTenderVersions_Success проверяет, что
+// создатель тендера получает все его версии.
+func TestGetTenderVersions_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	expectedVersions := []models.TenderVersion{
+		{Tender: models.Tender{ID: 1, Version: 1, TenderName: "qwe", CreatorUsername: "qwe"}, ModifiedBy: "qwe"},
+		{Tender: models.Tender{ID: 1, Version: 2, TenderName: "zxc", CreatorUsername: "qwe"}, IsActiveVersion: true, ModifiedBy: "qwe"},
+	}
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{CreatorUsername: "qwe"}, nil)
+	mockTenderRepo.On("GetTenderVersions", ctx, 1).Return(expectedVersions, nil)
+
+	// Act
+	versions, err := tenderService.GetTenderVersions(ctx, 1, "qwe")
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, expectedVersions, versions)
+}
+
+// TestGetTenderVersions_FailTenderNotFound проверяет, что
+// для несуществующего тендера возвращается ошибка.
+func TestGetTenderVersions_FailTenderNotFound(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{}, outerror.ErrTenderNotFound)
+
+	// Act
+	versions, err := tenderService.GetTenderVersions(ctx, 1, "qwe")
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrTenderNotFound)
+	require.Equal(t, []models.TenderVersion{}, versions)
+}
+
+// TestGetTenderVersions_FailNotCreator проверяет, что
+// историю тендера не может смотреть не его создатель.
+func TestGetTenderVersions_FailNotCreator(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{CreatorUsername: "qwe"}, nil)
+
+	// Act
+	versions, err := tenderService.GetTenderVersions(ctx, 1, "zxc")
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrEmployeeNotResponsibleForTender)
+	require.Equal(t, []models.TenderVersion{}, versions)
+	mockTenderRepo.AssertNotCalled(t, "GetTenderVersions", ctx, 1)
+}
+
+// TestGetTenderVersion_Success проверяет, что
+// создатель тендера получает конкретную версию.
+func TestGetTenderVersion_Success(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	expectedVersion := models.TenderVersion{Tender: models.Tender{ID: 1, Version: 1, CreatorUsername: "qwe"}, ModifiedBy: "qwe"}
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{CreatorUsername: "qwe"}, nil)
+	mockTenderRepo.On("GetTenderVersion", ctx, 1, 1).Return(expectedVersion, nil)
+
+	// Act
+	version, err := tenderService.GetTenderVersion(ctx, 1, 1, "qwe")
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, expectedVersion, version)
+}
+
+// TestGetTenderVersion_FailVersionNotFound проверяет, что
+// если версии нет, то возвращается ошибка.
+func TestGetTenderVersion_FailVersionNotFound(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{CreatorUsername: "qwe"}, nil)
+	mockTenderRepo.On("GetTenderVersion", ctx, 1, 5).Return(models.TenderVersion{}, outerror.ErrTenderVersionNotFound)
+
+	// Act
+	version, err := tenderService.GetTenderVersion(ctx, 1, 5, "qwe")
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrTenderVersionNotFound)
+	require.Equal(t, models.TenderVersion{}, version)
+}
@@ -0,0 +1,155 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/lib/logger/slogdiscard"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/sariya23/tender/internal/service/tender"
+	"github.com/sariya23/tender/internal/service/tender/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+// TestVoteCloseTender_SuccessPending проверяет, что
+// голос учитывается, а кворум равен min(closeQuorum, количество ответственных).
+func TestVoteCloseTender_SuccessPending(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	expectedVoting := models.TenderCloseVoting{Status: models.CloseVotingPending, Approvals: 1, Quorum: 2}
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler, tender.WithCloseQuorum(3))
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{Status: models.TenderPublishedStatus, OrganizationId: 2}, nil)
+	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, "qwe").Return(models.Employee{ID: 5, Username: "qwe"}, nil)
+	mockResponsibler.On("CheckResponsibility", ctx, 5, 2).Return(nil)
+	mockResponsibler.On("GetOrganizationResponsibles", ctx, 2).Return([]models.Employee{{ID: 5}, {ID: 6}}, nil)
+	mockTenderRepo.On("VoteTenderClose", ctx, 1, models.TenderCloseVote{EmployeeId: 5, Decision: models.CloseVoteApprove}, 2).Return(expectedVoting, nil)
+
+	// Act
+	voting, err := tenderService.VoteCloseTender(ctx, 1, "qwe", models.CloseVoteApprove)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, expectedVoting, voting)
+}
+
+// TestVoteCloseTender_SuccessDefaultQuorum проверяет, что
+// без опции WithCloseQuorum используется кворум по умолчанию.
+func TestVoteCloseTender_SuccessDefaultQuorum(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	closedTender := models.Tender{Status: models.TenderClosedStatus, OrganizationId: 2}
+	expectedVoting := models.TenderCloseVoting{Status: models.CloseVotingClosed, Approvals: 3, Quorum: 3, Tender: &closedTender}
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{Status: models.TenderPublishedStatus, OrganizationId: 2}, nil)
+	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, "qwe").Return(models.Employee{ID: 5, Username: "qwe"}, nil)
+	mockResponsibler.On("CheckResponsibility", ctx, 5, 2).Return(nil)
+	mockResponsibler.On("GetOrganizationResponsibles", ctx, 2).Return([]models.Employee{{ID: 1}, {ID: 2}, {ID: 3}, {ID: 5}}, nil)
+	mockTenderRepo.On("VoteTenderClose", ctx, 1, models.TenderCloseVote{EmployeeId: 5, Decision: models.CloseVoteApprove}, tender.DefaultCloseQuorum).Return(expectedVoting, nil)
+
+	// Act
+	voting, err := tenderService.VoteCloseTender(ctx, 1, "qwe", models.CloseVoteApprove)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, expectedVoting, voting)
+}
+
+// TestVoteCloseTender_FailUnknownDecision проверяет, что
+// нельзя проголосовать с неизвестным решением.
+func TestVoteCloseTender_FailUnknownDecision(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+
+	// Act
+	voting, err := tenderService.VoteCloseTender(ctx, 1, "qwe", "MAYBE")
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrUnknownCloseVoteDecision)
+	require.Equal(t, models.TenderCloseVoting{}, voting)
+}
+
+// TestVoteCloseTender_FailTenderNotPublished проверяет, что
+// голосовать можно только за закрытие опубликованного тендера.
+func TestVoteCloseTender_FailTenderNotPublished(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{Status: models.TenderCreatedStatus}, nil)
+
+	// Act
+	voting, err := tenderService.VoteCloseTender(ctx, 1, "qwe", models.CloseVoteApprove)
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrTenderNotPublished)
+	require.Equal(t, models.TenderCloseVoting{}, voting)
+}
+
+// TestVoteCloseTender_FailEmployeeNotResponsible проверяет, что
+// голосовать могут только ответственные за организацию тендера.
+func TestVoteCloseTender_FailEmployeeNotResponsible(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{Status: models.TenderPublishedStatus, OrganizationId: 2}, nil)
+	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, "qwe").Return(models.Employee{ID: 5}, nil)
+	mockResponsibler.On("CheckResponsibility", ctx, 5, 2).Return(outerror.ErrEmployeeNotResponsibleForOrganization)
+
+	// Act
+	voting, err := tenderService.VoteCloseTender(ctx, 1, "qwe", models.CloseVoteApprove)
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrEmployeeNotResponsibleForOrganization)
+	require.Equal(t, models.TenderCloseVoting{}, voting)
+}
+
+// TestVoteCloseTender_FailAlreadyVoted проверяет, что
+// сотрудник не может проголосовать дважды в одном голосовании.
+func TestVoteCloseTender_FailAlreadyVoted(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	mockTenderRepo := new(mocks.MockTenderRepo)
+	mockEmployeeRepo := new(mocks.MockEmployeeRepo)
+	mockOrgRepo := new(mocks.MockOrgRepo)
+	mockResponsibler := new(mocks.MockEmployeeResponsibler)
+	logger := slogdiscard.NewDiscardLogger()
+	tenderService := tender.New(logger, mockTenderRepo, mockEmployeeRepo, mockOrgRepo, mockResponsibler)
+	mockTenderRepo.On("GetTenderById", ctx, 1).Return(models.Tender{Status: models.TenderPublishedStatus, OrganizationId: 2}, nil)
+	mockEmployeeRepo.On("GetEmployeeByUsername", ctx, "qwe").Return(models.Employee{ID: 5}, nil)
+	mockResponsibler.On("CheckResponsibility", ctx, 5, 2).Return(nil)
+	mockResponsibler.On("GetOrganizationResponsibles", ctx, 2).Return([]models.Employee{{ID: 5}}, nil)
+	mockTenderRepo.On("VoteTenderClose", ctx, 1, models.TenderCloseVote{EmployeeId: 5, Decision: models.CloseVoteReject}, 1).Return(models.TenderCloseVoting{}, outerror.ErrEmployeeAlreadyVoted)
+
+	// Act
+	voting, err := tenderService.VoteCloseTender(ctx, 1, "qwe", models.CloseVoteReject)
+
+	// Assert
+	require.ErrorIs(t, err, outerror.ErrEmployeeAlreadyVoted)
+	require.Equal(t, models.TenderCloseVoting{}, voting)
+}
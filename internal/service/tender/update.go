@@ -7,6 +7,8 @@ import (
 	"log/slog"
 
 	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/domain/tenderpolicy"
+	"github.com/sariya23/tender/internal/domain/tenderstatus"
 	outerror "github.com/sariya23/tender/internal/out_error"
 )
 
@@ -19,15 +21,31 @@ import (
 // - Поля организации без поля юзера (и другие поля), то проверяется существует ли эта организация и ответсвенный ли за него текущий юзер
 //
 // - И оля юзера, и поля организации (и другие поля), то проверяется существует ли этот юзер и организация и ответсвенный ли этот юзер за новую организацию.
-func (tenderSrv *TenderService) EditTender(ctx context.Context, tenderId int, updateTender models.TenderToUpdate, username string) (models.Tender, error) {
+//
+// Тип услуг должен быть в справочнике (см. WithServiceTypes).
+//
+// Статус меняется только по переходам автомата статусов (см. tenderstatus).
+// Закрыть опубликованный тендер через EditTender нельзя, для этого есть VoteCloseTender.
+// Переходы, для которых нужна причина, доступны только через SetTenderStatus.
+// Открыть закрытый тендер заново можно только с дедлайном в будущем.
+//
+// Изменять тендер может тот, кому это разрешает политика доступа (см. WithPolicy).
+// Для смены создателя или организации нужно право на передачу тендера.
+//
+// Если expectedVersion не 0, то тендер обновится, только если его текущая версия
+// равна expectedVersion, иначе вернется ErrTenderVersionConflict.
+func (tenderSrv *TenderService) EditTender(ctx context.Context, tenderId int, updateTender models.TenderToUpdate, username string, expectedVersion int) (models.Tender, error) {
+	return tenderSrv.editTender(ctx, tenderId, updateTender, username, expectedVersion, "")
+}
+
+// editTender обновляет тендер, reason - причина смены статуса.
+func (tenderSrv *TenderService) editTender(ctx context.Context, tenderId int, updateTender models.TenderToUpdate, username string, expectedVersion int, reason string) (models.Tender, error) {
 	const operationPlace = "internal.service.tender.update.Edit"
 	logger := tenderSrv.logger.With("op", operationPlace)
 
-	var updatedEmpl models.Employee
-	var updatedOrg models.Organization
 	var err error
 
-	if !updateTender.IsTenderStatusKnown() {
+	if updateTender.Status != nil && !tenderSrv.statuses.IsKnown(*updateTender.Status) {
 		logger.Error(fmt.Sprintf("tender status \"%s\" unknown", *updateTender.Status))
 		return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrUnknownTenderStatus)
 	}
@@ -45,16 +63,106 @@ func (tenderSrv *TenderService) EditTender(ctx context.Context, tenderId int, up
 		return models.Tender{}, fmt.Errorf("cannot get tender by id: %w", err)
 	}
 
-	if currTender.CreatorUsername != username {
-		logger.Warn(fmt.Sprintf("employee with username \"%s\" not creator of tender with id \"%d\"", username, tenderId))
-		return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeNotResponsibleForTender)
+	action := tenderpolicy.ActionEdit
+	if updateTender.CreatorUsername != nil || updateTender.OrganizationId != nil {
+		action = tenderpolicy.ActionReassign
+	}
+	err = tenderSrv.authorize(ctx, username, action, currTender)
+	if err != nil {
+		if errors.Is(err, outerror.ErrEmployeeNotResponsibleForTender) {
+			logger.Warn(fmt.Sprintf("employee with username \"%s\" cannot %s tender with id \"%d\"", username, action, tenderId))
+			return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeNotResponsibleForTender)
+		}
+		logger.Error("cannot authorize employee", slog.String("err", err.Error()))
+		return models.Tender{}, err
+	}
+
+	if expectedVersion != 0 && currTender.Version != expectedVersion {
+		logger.Warn("tender version conflict", slog.Int("expected version", expectedVersion), slog.Int("current version", currTender.Version))
+		return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTenderVersionConflict)
+	}
+
+	if updateTender.Status != nil {
+		transition, ok := tenderSrv.statuses.Transition(currTender.Status, *updateTender.Status)
+		if !ok {
+			logger.Warn(fmt.Sprintf("cannot set status \"%s\" to tender with status \"%s\"", *updateTender.Status, currTender.Status))
+			return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrCannotSetThisTenderStatus)
+		}
+		if transition.RequiresReason && reason == "" {
+			logger.Warn(fmt.Sprintf("reason required to set status \"%s\" to tender with status \"%s\"", *updateTender.Status, currTender.Status))
+			return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTenderStatusReasonRequired)
+		}
+	}
+
+	if updateTender.ServiceType != nil {
+		serviceType, err := tenderSrv.serviceType(ctx, *updateTender.ServiceType)
+		if err != nil {
+			if errors.Is(err, outerror.ErrUnknownServiceType) {
+				logger.Warn("unknown service type", slog.String("service type", *updateTender.ServiceType))
+				return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrUnknownServiceType)
+			}
+			logger.Error("cannot check service type", slog.String("err", err.Error()))
+			return models.Tender{}, err
+		}
+		updateTender.ServiceType = &serviceType
+	}
+
+	updatedFields := currTender.Apply(updateTender)
+	if !updatedFields.IsDeadlineAfterPublishAt() {
+		logger.Warn("tender deadline before publish_at", slog.Int("tender id", tenderId))
+		return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTenderDeadlineBeforePublishAt)
+	}
+
+	// Иначе планировщик закроет открытый заново тендер при следующем
+	// запуске, и закрытие обойдет голосование.
+	if isReopen(currTender.Status, updateTender.Status) && updatedFields.Deadline != nil && !updatedFields.Deadline.After(tenderSrv.now()) {
+		logger.Warn("reopened tender deadline already passed", slog.Int("tender id", tenderId), slog.Time("deadline", *updatedFields.Deadline))
+		return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTenderReopenDeadlinePassed)
+	}
+
+	if updateTender.Status != nil && requiresQuorum(currTender.Status, *updateTender.Status) {
+		logger.Warn("published tender can be closed only by quorum", slog.Int("tender id", tenderId))
+		return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTenderCloseRequiresQuorum)
 	}
 
-	if !updateTender.CanSetThisTenderStatus(currTender.Status) {
-		logger.Error(fmt.Sprintf("cannot set status \"%s\" to tender with status \"%s\"", *updateTender.Status, currTender.Status))
-		return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrCannotSetThisTenderStatus)
+	err = tenderSrv.checkReassign(ctx, currTender, updateTender)
+	if err != nil {
+		return models.Tender{}, err
 	}
 
+	updatedTender, err := tenderSrv.tenderRepo.EditTender(ctx, currTender, tenderId, updateTender, username)
+
+	if err != nil {
+		if errors.Is(err, outerror.ErrTenderVersionConflict) {
+			logger.Warn("tender was changed concurrently", slog.Int("tender id", tenderId))
+			return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTenderVersionConflict)
+		}
+		logger.Error("cannot update tender", slog.String("err", err.Error()))
+		return models.Tender{}, err
+	}
+
+	tenderSrv.statuses.Fire(ctx, tenderstatus.Event{
+		From:     currTender.Status,
+		To:       updatedTender.Status,
+		Reason:   reason,
+		Username: username,
+		Tender:   updatedTender,
+	})
+
+	return updatedTender, nil
+}
+
+// checkReassign проверяет, что новый создатель и новая организация тендера
+// из updateTender существуют и создатель ответственный за организацию.
+// Если создатель или организация не меняются, то берутся текущие из currTender.
+func (tenderSrv *TenderService) checkReassign(ctx context.Context, currTender models.Tender, updateTender models.TenderToUpdate) error {
+	const operationPlace = "internal.service.tender.update.checkReassign"
+	logger := tenderSrv.logger.With("op", operationPlace)
+
+	var updatedEmpl models.Employee
+	var updatedOrg models.Organization
+	var err error
+
 	updatedUsername := updateTender.CreatorUsername
 	updatedOrgId := updateTender.OrganizationId
 
@@ -65,14 +173,14 @@ func (tenderSrv *TenderService) EditTender(ctx context.Context, tenderId int, up
 		if err != nil {
 			if errors.Is(err, outerror.ErrEmployeeNotFound) {
 				logger.Warn("employee to update not found", slog.String("username", *updatedUsername))
-				return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeNotFound)
+				return fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeNotFound)
 			}
 			logger.Error(
 				"cannot get employee by username",
 				slog.String("username", *updatedUsername),
 				slog.String("err", err.Error()),
 			)
-			return models.Tender{}, fmt.Errorf("cannot get employee by username: %w", err)
+			return fmt.Errorf("cannot get employee by username: %w", err)
 		}
 	}
 
@@ -83,14 +191,14 @@ func (tenderSrv *TenderService) EditTender(ctx context.Context, tenderId int, up
 		if err != nil {
 			if errors.Is(err, outerror.ErrOrganizationNotFound) {
 				logger.Warn("organization to update not found", slog.Int("org id", *updatedOrgId))
-				return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrOrganizationNotFound)
+				return fmt.Errorf("%s: %w", operationPlace, outerror.ErrOrganizationNotFound)
 			}
 			logger.Error(
 				"cannot get org by id",
 				slog.Int("org id", *updatedOrgId),
 				slog.String("err", err.Error()),
 			)
-			return models.Tender{}, fmt.Errorf("cannot get organization by id: %w", err)
+			return fmt.Errorf("cannot get organization by id: %w", err)
 		}
 	}
 
@@ -101,14 +209,14 @@ func (tenderSrv *TenderService) EditTender(ctx context.Context, tenderId int, up
 		if err != nil {
 			if errors.Is(err, outerror.ErrEmployeeNotResponsibleForOrganization) {
 				logger.Warn("updated employee not responsible for updated organization", slog.Int("employee id", updatedEmpl.ID), slog.Int("org id", updatedOrg.ID))
-				return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrUpdatedEmployeeNotResponsibleForUpdatedOrg)
+				return fmt.Errorf("%s: %w", operationPlace, outerror.ErrUpdatedEmployeeNotResponsibleForUpdatedOrg)
 			}
 			logger.Error(
 				"cannot check new employee responsibility to new org",
 				slog.Int("employee id", updatedEmpl.ID),
 				slog.String("err", err.Error()),
 			)
-			return models.Tender{}, fmt.Errorf("cannot check new employee responsibility to new org: %w", err)
+			return fmt.Errorf("cannot check new employee responsibility to new org: %w", err)
 		}
 	}
 
@@ -119,14 +227,14 @@ func (tenderSrv *TenderService) EditTender(ctx context.Context, tenderId int, up
 		if err != nil {
 			if errors.Is(err, outerror.ErrEmployeeNotResponsibleForOrganization) {
 				logger.Warn("updated employee not responsible for current organization", slog.Int("employee id", updatedEmpl.ID), slog.Int("org id", currTender.OrganizationId))
-				return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrUpdatedEmployeeNotResponsibleForCurrentOrg)
+				return fmt.Errorf("%s: %w", operationPlace, outerror.ErrUpdatedEmployeeNotResponsibleForCurrentOrg)
 			}
 			logger.Error(
 				"cannot check new employee responsibility to current org",
 				slog.Int("employee id", updatedEmpl.ID),
 				slog.String("err", err.Error()),
 			)
-			return models.Tender{}, fmt.Errorf("cannot check new employee responsibility to current org: %w", err)
+			return fmt.Errorf("cannot check new employee responsibility to current org: %w", err)
 		}
 	}
 
@@ -138,29 +246,27 @@ func (tenderSrv *TenderService) EditTender(ctx context.Context, tenderId int, up
 		currEmpl, err = tenderSrv.employeeRepo.GetEmployeeByUsername(ctx, currTender.CreatorUsername)
 		if err != nil {
 			logger.Error("cannot get employee by id", slog.String("err", err.Error()))
-			return models.Tender{}, err
+			return err
 		}
 		err = tenderSrv.employeeResponsibler.CheckResponsibility(ctx, currEmpl.ID, *updatedOrgId)
 		if err != nil {
 			if errors.Is(err, outerror.ErrEmployeeNotResponsibleForOrganization) {
 				logger.Warn("current employee not responsible for updated organization", slog.Int("employee id", updatedEmpl.ID), slog.Int("org id", currTender.OrganizationId))
-				return models.Tender{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrCurrentEmployeeNotResponsibleForUpdatedOrg)
+				return fmt.Errorf("%s: %w", operationPlace, outerror.ErrCurrentEmployeeNotResponsibleForUpdatedOrg)
 			}
 			logger.Error(
 				"cannot check current employee responsobility for updated org",
 				slog.String("curr employee", currEmpl.Username),
 				slog.Int("new org id", *updatedOrgId),
 			)
-			return models.Tender{}, err
+			return err
 		}
 	}
 
-	updatedTender, err := tenderSrv.tenderRepo.EditTender(ctx, currTender, tenderId, updateTender)
-
-	if err != nil {
-		logger.Error("cannot update tender", slog.String("err", err.Error()))
-		return models.Tender{}, err
-	}
+	return nil
+}
 
-	return updatedTender, nil
+// isReopen сообщает, что тендер со статусом from открывается заново.
+func isReopen(from string, to *string) bool {
+	return from == models.TenderClosedStatus && to != nil && *to == models.TenderPublishedStatus
 }
@@ -0,0 +1,58 @@
+package tender
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/domain/tenderpolicy"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+// GetTenderVersions возвращает все сохраненные версии тендера
+// в порядке возрастания номера версии. Смотреть историю может тот,
+// кому политика доступа разрешает читать тендер.
+func (tenderSrv *TenderService) GetTenderVersions(ctx context.Context, tenderId int, username string) ([]models.TenderVersion, error) {
+	const operationPlace = "internal.service.tender.versions.GetTenderVersions"
+	logger := tenderSrv.logger.With("op", operationPlace)
+
+	err := tenderSrv.authorizeTender(ctx, tenderId, username, tenderpolicy.ActionRead)
+	if err != nil {
+		logger.Warn("cannot show tender versions", slog.Int("tender id", tenderId), slog.String("err", err.Error()))
+		return []models.TenderVersion{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	versions, err := tenderSrv.tenderRepo.GetTenderVersions(ctx, tenderId)
+	if err != nil {
+		logger.Error("cannot get tender versions", slog.Int("tender id", tenderId), slog.String("err", err.Error()))
+		return []models.TenderVersion{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	logger.Info("success get tender versions", slog.Int("count", len(versions)))
+	return versions, nil
+}
+
+// GetTenderVersion возвращает одну версию тендера. Права те же, что и у GetTenderVersions.
+func (tenderSrv *TenderService) GetTenderVersion(ctx context.Context, tenderId int, version int, username string) (models.TenderVersion, error) {
+	const operationPlace = "internal.service.tender.versions.GetTenderVersion"
+	logger := tenderSrv.logger.With("op", operationPlace)
+
+	err := tenderSrv.authorizeTender(ctx, tenderId, username, tenderpolicy.ActionRead)
+	if err != nil {
+		logger.Warn("cannot show tender version", slog.Int("tender id", tenderId), slog.String("err", err.Error()))
+		return models.TenderVersion{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+
+	tenderVersion, err := tenderSrv.tenderRepo.GetTenderVersion(ctx, tenderId, version)
+	if err != nil {
+		if errors.Is(err, outerror.ErrTenderVersionNotFound) {
+			logger.Warn(fmt.Sprintf("tender version=\"%d\" not found", version))
+			return models.TenderVersion{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTenderVersionNotFound)
+		}
+		logger.Error("cannot get tender version", slog.String("err", err.Error()))
+		return models.TenderVersion{}, fmt.Errorf("%s: %w", operationPlace, err)
+	}
+	logger.Info("success get tender version")
+	return tenderVersion, nil
+}
@@ -0,0 +1,99 @@
+package tender
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/sariya23/tender/internal/domain/models"
+	"github.com/sariya23/tender/internal/domain/tenderstatus"
+	outerror "github.com/sariya23/tender/internal/out_error"
+)
+
+// VoteCloseTender учитывает голос сотрудника за закрытие опубликованного тендера.
+//
+// Голосовать могут только сотрудники, ответственные за организацию тендера.
+// Кворум равен min(closeQuorum, количество ответственных за организацию).
+// Одно вето (REJECT) отменяет текущее голосование.
+func (tenderSrv *TenderService) VoteCloseTender(ctx context.Context, tenderId int, username string, decision string) (models.TenderCloseVoting, error) {
+	const operationPlace = "internal.service.tender.vote.VoteCloseTender"
+	logger := tenderSrv.logger.With("op", operationPlace)
+
+	vote := models.TenderCloseVote{Decision: decision}
+	if !vote.IsDecisionKnown() {
+		logger.Warn(fmt.Sprintf("close vote decision \"%s\" unknown", decision))
+		return models.TenderCloseVoting{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrUnknownCloseVoteDecision)
+	}
+
+	tender, err := tenderSrv.tenderRepo.GetTenderById(ctx, tenderId)
+	if err != nil {
+		if errors.Is(err, outerror.ErrTenderNotFound) {
+			logger.Warn("tender not found", slog.Int("tender id", tenderId))
+			return models.TenderCloseVoting{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTenderNotFound)
+		}
+		logger.Error("cannot get tender by id", slog.Int("tender id", tenderId), slog.String("err", err.Error()))
+		return models.TenderCloseVoting{}, fmt.Errorf("cannot get tender by id: %w", err)
+	}
+	if tender.Status != models.TenderPublishedStatus {
+		logger.Warn("tender not published", slog.Int("tender id", tenderId), slog.String("status", tender.Status))
+		return models.TenderCloseVoting{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTenderNotPublished)
+	}
+
+	empl, err := tenderSrv.employeeRepo.GetEmployeeByUsername(ctx, username)
+	if err != nil {
+		if errors.Is(err, outerror.ErrEmployeeNotFound) {
+			logger.Warn("employee not found", slog.String("username", username))
+			return models.TenderCloseVoting{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeNotFound)
+		}
+		logger.Error("cannot get employee", slog.String("username", username), slog.String("err", err.Error()))
+		return models.TenderCloseVoting{}, fmt.Errorf("cannot get employee: %w", err)
+	}
+
+	err = tenderSrv.employeeResponsibler.CheckResponsibility(ctx, empl.ID, tender.OrganizationId)
+	if err != nil {
+		if errors.Is(err, outerror.ErrEmployeeNotResponsibleForOrganization) {
+			logger.Warn("employee not responsible for organization", slog.Int("empl id", empl.ID), slog.Int("org id", tender.OrganizationId))
+			return models.TenderCloseVoting{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeNotResponsibleForOrganization)
+		}
+		logger.Error("cannot check employee responsibility", slog.String("err", err.Error()))
+		return models.TenderCloseVoting{}, fmt.Errorf("cannot check employee responsibility: %w", err)
+	}
+
+	responsibles, err := tenderSrv.employeeResponsibler.GetOrganizationResponsibles(ctx, tender.OrganizationId)
+	if err != nil {
+		logger.Error("cannot get organization responsibles", slog.Int("org id", tender.OrganizationId), slog.String("err", err.Error()))
+		return models.TenderCloseVoting{}, fmt.Errorf("cannot get organization responsibles: %w", err)
+	}
+	quorum := min(tenderSrv.closeQuorum, len(responsibles))
+
+	vote.EmployeeId = empl.ID
+	voting, err := tenderSrv.tenderRepo.VoteTenderClose(ctx, tenderId, vote, quorum)
+	if err != nil {
+		if errors.Is(err, outerror.ErrEmployeeAlreadyVoted) {
+			logger.Warn("employee already voted", slog.String("username", username), slog.Int("tender id", tenderId))
+			return models.TenderCloseVoting{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrEmployeeAlreadyVoted)
+		} else if errors.Is(err, outerror.ErrTenderNotPublished) {
+			logger.Warn("tender not published anymore", slog.Int("tender id", tenderId))
+			return models.TenderCloseVoting{}, fmt.Errorf("%s: %w", operationPlace, outerror.ErrTenderNotPublished)
+		}
+		logger.Error("cannot vote for tender close", slog.String("err", err.Error()))
+		return models.TenderCloseVoting{}, fmt.Errorf("cannot vote for tender close: %w", err)
+	}
+	if voting.Status == models.CloseVotingClosed && voting.Tender != nil {
+		tenderSrv.statuses.Fire(ctx, tenderstatus.Event{
+			From:     models.TenderPublishedStatus,
+			To:       models.TenderClosedStatus,
+			Reason:   "closed by responsibles vote",
+			Username: username,
+			Tender:   *voting.Tender,
+		})
+	}
+	logger.Info(
+		"success vote for tender close",
+		slog.String("voting status", voting.Status),
+		slog.Int("approvals", voting.Approvals),
+		slog.Int("quorum", voting.Quorum),
+	)
+	return voting, nil
+}
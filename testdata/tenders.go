@@ -19,7 +19,7 @@ var TestEmployee = models.Employee{
 var TestTender = models.Tender{
 	TenderName:      "Test Tender",
 	Description:     "Test Tender",
-	ServiceType:     "testing",
+	ServiceType:     "Construction",
 	Status:          "CREATED",
 	OrganizationId:  TestOrganization.ID,
 	CreatorUsername: TestEmployee.Username,
@@ -0,0 +1,62 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/sariya23/tender/internal/config"
+	"github.com/sariya23/tender/internal/domain/models"
+	outerror "github.com/sariya23/tender/internal/out_error"
+	"github.com/sariya23/tender/internal/repository/postgres"
+	"github.com/sariya23/tender/testdata"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCreateTender_Parallel проверяет, что при параллельном создании
+// тендеров все они создаются и получают разные id.
+func TestCreateTender_Parallel(t *testing.T) {
+	const tendersCount = 300
+	ctx := context.Background()
+	cfg := config.MustLoadByPath("../docker.env")
+	db := postgres.MustNewConnection(ctx, cfg.PostgresConnOutside)
+	// Создать тендер может только ответственный за организацию.
+	// Если тест запущен вместе с остальными, то TestMain уже
+	// сделал сотрудника ответственным.
+	employee, err := db.GetEmployeeByUsername(ctx, testdata.TestEmployee.Username)
+	require.NoError(t, err)
+	err = db.AddOrganizationResponsible(ctx, testdata.TestOrganization.ID, employee.ID, models.OrganizationRoleMember)
+	if !errors.Is(err, outerror.ErrEmployeeAlreadyResponsible) {
+		require.NoError(t, err)
+	}
+
+	var wg sync.WaitGroup
+	created := make(chan models.Tender, tendersCount)
+	errs := make(chan error, tendersCount)
+	for i := 0; i < tendersCount; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tender, err := db.CreateTender(ctx, testdata.TestTender)
+			if err != nil {
+				errs <- err
+				return
+			}
+			created <- tender
+		}()
+	}
+	wg.Wait()
+	close(created)
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	ids := make(map[int]struct{}, tendersCount)
+	for tender := range created {
+		require.Equal(t, 1, tender.Version)
+		ids[tender.ID] = struct{}{}
+	}
+	require.Len(t, ids, tendersCount)
+}
@@ -25,11 +25,16 @@ func TestMain(m *testing.M) {
 	defer cancel()
 	app := dockercompose.StartComposeApp(ctx, "../docker-compose.yaml", cfg)
 	db := postgres.MustNewConnection(ctx, cfg.PostgresConnOutside)
-	err := db.CreateEmployee(ctx, testdata.TestEmployee)
+	employee, err := db.CreateEmployee(ctx, testdata.TestEmployee)
 	if err != nil {
 		panic(err)
 	}
-	err = db.CreateOrganization(ctx, testdata.TestOrganization)
+	organization, err := db.CreateOrganization(ctx, testdata.TestOrganization)
+	if err != nil {
+		panic(err)
+	}
+	// Создать тендер может только ответственный за организацию.
+	err = db.AddOrganizationResponsible(ctx, organization.ID, employee.ID, models.OrganizationRoleMember)
 	if err != nil {
 		panic(err)
 	}
@@ -37,7 +42,7 @@ func TestMain(m *testing.M) {
 	if err != nil {
 		panic(err)
 	}
-	_, err = db.EditTender(ctx, tender, 1, models.TenderToUpdate{Status: &models.TenderPublishedStatus})
+	_, err = db.EditTender(ctx, tender, 1, models.TenderToUpdate{Status: &models.TenderPublishedStatus}, testdata.TestEmployee.Username)
 	if err != nil {
 		panic(err)
 	}